@@ -33,15 +33,47 @@ type Aligner interface {
 // It is a square scoring matrix with the first column and first row specifying gap penalties.
 type Linear [][]int
 
-// An Affine is a basic affine gap penalty alignment description.
+// An Affine is a basic affine gap penalty alignment description. A gap of length
+// n is scored as GapOpen plus the sum of the n per-letter gap penalties given in
+// the first row and column of Matrix. Aligners using an Affine description
+// implement the three-state dynamic programming described by Gotoh (1982) J Mol
+// Biol 162:705-708.
 type Affine struct {
 	Matrix  Linear
 	GapOpen int
 }
 
+// A Band is a linear gap penalty alignment description restricted to a band of
+// diagonals of the dynamic programming table. Width specifies the number of
+// diagonals either side of the main diagonal that are considered.
+type Band struct {
+	Matrix Linear
+	Width  int
+}
+
+// Indices into the EndGaps field of a SemiGlobal.
+const (
+	RefStart   = iota // Leading reference letters aligned against gaps.
+	RefEnd            // Trailing reference letters aligned against gaps.
+	QueryStart        // Leading query letters aligned against gaps.
+	QueryEnd          // Trailing query letters aligned against gaps.
+)
+
 var (
 	_ Aligner = SW{}
 	_ Aligner = NW{}
+	_ Aligner = Fitted{}
+	_ Aligner = SWAffine{}
+	_ Aligner = NWAffine{}
+	_ Aligner = FittedAffine{}
+	_ Aligner = SWBanded{}
+	_ Aligner = NWBanded{}
+	_ Aligner = Hirschberg{}
+	_ Aligner = SemiGlobal{}
+	_ Aligner = Seeded{}
+	_ Aligner = Codon{}
+	_ Aligner = XDrop{}
+	_ Aligner = QualityNWAffine{}
 )
 
 const (
@@ -61,6 +93,7 @@ var (
 	ErrNotGappedAlphabet   = errors.New("align: alphabet does not have gap at position 0")
 	ErrTypeNotHandled      = errors.New("align: sequence type not handled")
 	ErrMatrixNotSquare     = errors.New("align: scoring matrix is not square")
+	ErrNegativeBandWidth   = errors.New("align: negative band width")
 )
 
 type ErrMatrixWrongSize struct {
@@ -96,6 +129,59 @@ func add(a, b int) int {
 	return a + b
 }
 
+// band describes the diagonals of an r by c dynamic programming table that are
+// stored by a banded aligner. Cell (i, j) is within the band if lo <= j-i <= hi.
+type band struct {
+	r, c   int
+	lo, hi int
+}
+
+// globalBand returns a band of w diagonals either side of the main diagonal,
+// widened so that both the first and last cells of an r by c table are included.
+func globalBand(r, c, w int) band {
+	d := (c - 1) - (r - 1)
+	lo, hi := -w, w
+	if d < 0 {
+		lo += d
+	} else {
+		hi += d
+	}
+	return band{r: r, c: c, lo: lo, hi: hi}
+}
+
+// width returns the number of cells stored for each row of the table.
+func (b band) width() int { return b.hi - b.lo + 1 }
+
+// cols returns the half-open range of columns within the band for row i.
+func (b band) cols(i int) (start, end int) {
+	start, end = i+b.lo, i+b.hi+1
+	if start < 0 {
+		start = 0
+	}
+	if end > b.c {
+		end = b.c
+	}
+	return start, end
+}
+
+// in returns whether cell (i, j) is within the table and the band.
+func (b band) in(i, j int) bool {
+	d := j - i
+	return 0 <= i && i < b.r && 0 <= j && j < b.c && b.lo <= d && d <= b.hi
+}
+
+// index returns the index into the banded table for cell (i, j).
+func (b band) index(i, j int) int { return i*b.width() + j - i - b.lo }
+
+// at returns the value of cell (i, j) in table or minInt if the cell
+// is outside the band.
+func (b band) at(table []int, i, j int) int {
+	if !b.in(i, j) {
+		return minInt
+	}
+	return table[b.index(i, j)]
+}
+
 type feature struct {
 	start, end int
 	loc        feat.Feature
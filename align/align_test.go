@@ -6,11 +6,17 @@ package align
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"strings"
 	"testing"
 
+	"github.com/biogo/biogo/align/matrix"
 	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/index/kmerindex"
 	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq"
 	"github.com/biogo/biogo/seq/linear"
 	"gopkg.in/check.v1"
 )
@@ -50,6 +56,603 @@ func (s *S) TestIssue58(c *check.C) {
 	c.Check(fmt.Sprint(aln), check.Equals, "[[0,4)/-=-5 [4,7)/[0,3)=3 [7,32)/-=-26 [32,34)/[3,5)=2 [34,43)/-=-10 [43,46)/[5,8)=3 [46,60)/-=-15]")
 }
 
+func (s *S) TestBandedMatchesFull(c *check.C) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	sa, _ := r.Read()
+	sb, _ := r.Read()
+
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	width := sa.Len() + sb.Len()
+
+	for _, test := range []struct {
+		full, banded Aligner
+	}{
+		{full: NW(m), banded: NWBanded{Matrix: m, Width: width}},
+		{full: SW(m), banded: SWBanded{Matrix: m, Width: width}},
+	} {
+		want, err := test.full.Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		got, err := test.banded.Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want), check.Commentf("%T", test.banded))
+	}
+
+	_, err := NWBanded{Matrix: m, Width: -1}.Align(sa, sb)
+	c.Check(err, check.Equals, ErrNegativeBandWidth)
+
+	narrow, err := NWBanded{Matrix: m, Width: 0}.Align(sa, sb)
+	c.Assert(err, check.Equals, nil)
+	var rEnd, qEnd int
+	for _, fp := range narrow {
+		f := fp.Features()
+		c.Check(f[0].Start(), check.Equals, rEnd)
+		c.Check(f[1].Start(), check.Equals, qEnd)
+		rEnd, qEnd = f[0].End(), f[1].End()
+	}
+	c.Check(rEnd, check.Equals, sa.Len())
+	c.Check(qEnd, check.Equals, sb.Len())
+}
+
+func (s *S) TestHirschbergScore(c *check.C) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	sa, _ := r.Read()
+	sb, _ := r.Read()
+
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	type scorer interface {
+		Score() int
+	}
+	total := func(aln []feat.Pair) int {
+		var t int
+		for _, fp := range aln {
+			t += fp.(scorer).Score()
+		}
+		return t
+	}
+
+	for _, pair := range [][2]seq.Sequence{{sa, sb}, {sb, sa}} {
+		want, err := NW(m).Align(pair[0], pair[1])
+		c.Assert(err, check.Equals, nil)
+		got, err := Hirschberg(m).Align(pair[0], pair[1])
+		c.Assert(err, check.Equals, nil)
+		c.Check(total(got), check.Equals, total(want))
+
+		var rEnd, qEnd int
+		for _, fp := range got {
+			f := fp.Features()
+			c.Check(f[0].Start(), check.Equals, rEnd)
+			c.Check(f[1].Start(), check.Equals, qEnd)
+			rEnd, qEnd = f[0].End(), f[1].End()
+		}
+		c.Check(rEnd, check.Equals, pair[0].Len())
+		c.Check(qEnd, check.Equals, pair[1].Len())
+	}
+}
+
+func (s *S) TestProfileMatchesNWAffine(c *check.C) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	sa, _ := r.Read()
+	sb, _ := r.Read()
+
+	m := Affine{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 2, -1, -1, -1},
+			{-1, -1, 2, -1, -1},
+			{-1, -1, -1, 2, -1},
+			{-1, -1, -1, -1, 2},
+		},
+		GapOpen: -5,
+	}
+	type scorer interface {
+		Score() int
+	}
+	total := func(aln []feat.Pair) int {
+		var t int
+		for _, fp := range aln {
+			t += fp.(scorer).Score()
+		}
+		return t
+	}
+
+	for _, pair := range [][2]seq.Sequence{{sa, sb}, {sb, sa}} {
+		want, err := NWAffine(m).Align(pair[0], pair[1])
+		c.Assert(err, check.Equals, nil)
+		p, err := SequenceProfile(pair[0])
+		c.Assert(err, check.Equals, nil)
+		got, err := ProfileNWAffine(m).AlignSequence(p, pair[1])
+		c.Assert(err, check.Equals, nil)
+		c.Check(total(got), check.Equals, total(want))
+
+		var rEnd, qEnd int
+		for _, fp := range got {
+			f := fp.Features()
+			c.Check(f[0].Start(), check.Equals, rEnd)
+			c.Check(f[1].Start(), check.Equals, qEnd)
+			rEnd, qEnd = f[0].End(), f[1].End()
+		}
+		c.Check(rEnd, check.Equals, pair[0].Len())
+		c.Check(qEnd, check.Equals, pair[1].Len())
+	}
+}
+
+func (s *S) TestRefineMatchesNWAffine(c *check.C) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	sa, _ := r.Read()
+	sb, _ := r.Read()
+
+	lin := Linear{
+		{0, -1, -1, -1, -1},
+		{-1, 2, -1, -1, -1},
+		{-1, -1, 2, -1, -1},
+		{-1, -1, -1, 2, -1},
+		{-1, -1, -1, -1, 2},
+	}
+	m := Affine{Matrix: lin, GapOpen: -5}
+	type scorer interface {
+		Score() int
+	}
+	total := func(aln []feat.Pair) int {
+		var t int
+		for _, fp := range aln {
+			t += fp.(scorer).Score()
+		}
+		return t
+	}
+
+	for _, pair := range [][2]seq.Sequence{{sa, sb}, {sb, sa}} {
+		want, err := NWAffine(m).Align(pair[0], pair[1])
+		c.Assert(err, check.Equals, nil)
+		rough, err := NW(lin).Align(pair[0], pair[1])
+		c.Assert(err, check.Equals, nil)
+
+		last := minInt
+		for _, w := range []int{0, 4, 16, pair[0].Len() + pair[1].Len()} {
+			got, err := Refiner{Affine: m, Width: w}.Refine(pair[0], pair[1], rough)
+			c.Assert(err, check.Equals, nil)
+			c.Check(total(got) >= last, check.Equals, true)
+			last = total(got)
+
+			var rEnd, qEnd int
+			for _, fp := range got {
+				f := fp.Features()
+				c.Check(f[0].Start(), check.Equals, rEnd)
+				c.Check(f[1].Start(), check.Equals, qEnd)
+				rEnd, qEnd = f[0].End(), f[1].End()
+			}
+			c.Check(rEnd, check.Equals, pair[0].Len())
+			c.Check(qEnd, check.Equals, pair[1].Len())
+		}
+		c.Check(last, check.Equals, total(want))
+	}
+
+	_, err := Refiner{Affine: m, Width: -1}.Refine(sa, sb, nil)
+	c.Check(err, check.Equals, ErrNegativeBandWidth)
+}
+
+func (s *S) TestEditOps(c *check.C) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	sa, _ := r.Read()
+	sb, _ := r.Read()
+
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	aln, err := NW(m).Align(sa, sb)
+	c.Assert(err, check.Equals, nil)
+
+	// Split aligned segments to check that runs
+	// are merged across feature pairs.
+	var split []feat.Pair
+	for _, fp := range aln {
+		f := fp.Features()
+		if n := f[0].Len(); n > 1 && f[1].Len() == n {
+			h := n / 2
+			split = append(split,
+				&featPair{a: feature{start: f[0].Start(), end: f[0].Start() + h}, b: feature{start: f[1].Start(), end: f[1].Start() + h}},
+				&featPair{a: feature{start: f[0].Start() + h, end: f[0].End()}, b: feature{start: f[1].Start() + h, end: f[1].End()}},
+			)
+			continue
+		}
+		split = append(split, fp)
+	}
+
+	want, err := ExtendedCigarFor(sa, sb, aln)
+	c.Assert(err, check.Equals, nil)
+	for _, a := range [][]feat.Pair{aln, split} {
+		ops, err := NewEditOps(sa, sb, a)
+		c.Assert(err, check.Equals, nil)
+		var (
+			got    Cigar
+			rp, qp int
+		)
+		for ops.Next() {
+			op := ops.Op()
+			c.Check(op.Ref, check.Equals, rp)
+			c.Check(op.Query, check.Equals, qp)
+			if op.Type != Insertion {
+				rp += op.Len
+			}
+			if op.Type != Deletion {
+				qp += op.Len
+			}
+			got = append(got, op.Cigar())
+		}
+		c.Check(got, check.DeepEquals, want)
+		c.Check(rp, check.Equals, sa.Len())
+		c.Check(qp, check.Equals, sb.Len())
+	}
+}
+
+func (s *S) TestPSSMMatchesSWAffine(c *check.C) {
+	m := make(Linear, len(matrix.BLOSUM62))
+	for i, row := range matrix.BLOSUM62 {
+		m[i] = append([]int(nil), row...)
+		m[i][0] = -1
+		m[0][i] = -1
+	}
+	m[0][0] = 0
+
+	rnd := rand.New(rand.NewSource(1))
+	const letters = "acdefghiklmnpqrstvwy"
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = letters[rnd.Intn(len(letters))]
+		}
+		return linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.Protein)
+	}
+	type scorer interface {
+		Score() int
+	}
+	total := func(aln []feat.Pair) int {
+		var t int
+		for _, fp := range aln {
+			t += fp.(scorer).Score()
+		}
+		return t
+	}
+
+	for i := 0; i < 20; i++ {
+		ref, query := randSeq(20+rnd.Intn(40)), randSeq(20+rnd.Intn(40))
+		want, err := SWAffine{Matrix: m, GapOpen: -10}.Align(ref, query)
+		c.Assert(err, check.Equals, nil)
+		p, err := SequencePSSM(ref, m)
+		c.Assert(err, check.Equals, nil)
+		got, err := PSSMSWAffine{GapOpen: -10, Gap: -1}.Align(p, query)
+		c.Assert(err, check.Equals, nil)
+		c.Check(total(got), check.Equals, total(want))
+	}
+}
+
+func (s *S) TestSeededAlign(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	ref := make([]byte, 2000)
+	for i := range ref {
+		ref[i] = "acgt"[rnd.Intn(4)]
+	}
+	q := append([]byte(nil), ref[500:1500]...)
+	if q[100] == 'a' {
+		q[100] = 'c'
+	} else {
+		q[100] = 'a'
+	}
+	q[600] = 'a'
+	q[601] = 'c'
+	q = append(q[:300], append([]byte("gattaca"), q[300:]...)...)
+	q = append(q[:800], q[812:]...)
+
+	sa := linear.NewSeq("ref", alphabet.BytesToLetters(ref), alphabet.DNAgapped)
+	sb := linear.NewSeq("query", alphabet.BytesToLetters(q), alphabet.DNAgapped)
+
+	m := Linear{
+		{0, -1, -1, -1, -1},
+		{-1, 1, -1, -1, -1},
+		{-1, -1, 1, -1, -1},
+		{-1, -1, -1, 1, -1},
+		{-1, -1, -1, -1, 1},
+	}
+	for _, w := range []int{0, 5} {
+		aln, err := Seeded{Matrix: m, K: 11, W: w, Width: 16}.Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		c.Assert(len(aln) > 0, check.Equals, true)
+
+		first := aln[0].Features()
+		rEnd, qEnd := first[0].Start(), first[1].Start()
+		c.Check(rEnd-qEnd, check.Equals, 500)
+		for _, fp := range aln {
+			f := fp.Features()
+			c.Check(f[0].Start(), check.Equals, rEnd)
+			c.Check(f[1].Start(), check.Equals, qEnd)
+			rEnd, qEnd = f[0].End(), f[1].End()
+		}
+		c.Check(rEnd-qEnd, check.Equals, 505)
+
+		// Equally scoring placements of the
+		// insertion may differ between seedings.
+		var ins, del int
+		for _, co := range CigarFor(aln) {
+			switch co.Type {
+			case CigarInsertion:
+				ins += co.Len
+			case CigarDeletion:
+				del += co.Len
+			}
+		}
+		c.Check(ins, check.Equals, 7, check.Commentf("w=%d", w))
+		c.Check(del, check.Equals, 12, check.Commentf("w=%d", w))
+	}
+
+	_, err := Seeded{Matrix: m, K: 0}.Align(sa, sb)
+	c.Check(err, check.Equals, ErrBadSeedLength)
+	_, err = Seeded{Matrix: m, K: 20, W: 5}.Align(sa, sb)
+	c.Check(err, check.Equals, kmerindex.ErrKTooLarge)
+
+	// Minimizer seeding is only available for nucleic acids.
+	pa := linear.NewSeq("a", alphabet.BytesToLetters([]byte("MKVLAAGGWHEREK")), alphabet.Protein)
+	pb := linear.NewSeq("b", alphabet.BytesToLetters([]byte("MKVLAAGGWHEREK")), alphabet.Protein)
+	_, err = Seeded{Matrix: matrix.BLOSUM62, K: 4}.Align(pa, pb)
+	c.Check(err, check.Equals, nil)
+	_, err = Seeded{Matrix: matrix.BLOSUM62, K: 4, W: 3}.Align(pa, pb)
+	c.Check(err, check.Equals, ErrNotNucleic)
+}
+
+func (s *S) TestUngappedKarlinAltschul(c *check.C) {
+	uniform := []float64{0, 1, 1, 1, 1}
+	nuc := func(match, mismatch int) Linear {
+		m := make(Linear, 5)
+		for i := range m {
+			m[i] = make([]int, 5)
+			for j := 1; i != 0 && j < 5; j++ {
+				if i == j {
+					m[i][j] = match
+				} else {
+					m[i][j] = mismatch
+				}
+			}
+		}
+		return m
+	}
+
+	// Robinson and Robinson amino acid frequencies.
+	robinson := make([]float64, alphabet.Protein.Len())
+	for l, f := range map[byte]float64{
+		'A': 78.05, 'C': 19.25, 'D': 53.64, 'E': 62.95, 'F': 38.56,
+		'G': 73.77, 'H': 21.99, 'I': 51.42, 'K': 57.44, 'L': 90.19,
+		'M': 22.43, 'N': 44.87, 'P': 52.03, 'Q': 42.64, 'R': 51.29,
+		'S': 71.20, 'T': 58.41, 'V': 64.41, 'W': 13.30, 'Y': 32.16,
+	} {
+		robinson[alphabet.Protein.IndexOf(alphabet.Letter(l))] = f
+	}
+
+	for _, t := range []struct {
+		m         Linear
+		freqs     []float64
+		lambda, k float64
+	}{
+		// Values from NCBI BLAST ungapped parameter tables.
+		{m: nuc(1, -3), freqs: uniform, lambda: 1.374, k: 0.711},
+		{m: nuc(1, -2), freqs: uniform, lambda: 1.33, k: 0.621},
+		{m: matrix.BLOSUM62, freqs: robinson, lambda: 0.3176, k: 0.134},
+	} {
+		p, err := UngappedKarlinAltschul(t.m, t.freqs, t.freqs)
+		c.Assert(err, check.Equals, nil)
+		c.Check(math.Abs(p.Lambda-t.lambda) < 5e-3, check.Equals, true, check.Commentf("lambda: got:%v want:%v", p.Lambda, t.lambda))
+		c.Check(math.Abs(p.K-t.k) < 5e-3, check.Equals, true, check.Commentf("K: got:%v want:%v", p.K, t.k))
+	}
+
+	_, err := UngappedKarlinAltschul(nuc(1, 1), uniform, uniform)
+	c.Check(err, check.NotNil)
+}
+
+func (s *S) TestXDropUnbounded(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "acgt"[rnd.Intn(4)]
+		}
+		return linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNAgapped)
+	}
+	m := Linear{
+		{0, -2, -2, -2, -2},
+		{-2, 2, -1, -1, -1},
+		{-2, -1, 2, -1, -1},
+		{-2, -1, -1, 2, -1},
+		{-2, -1, -1, -1, 2},
+	}
+	type scorer interface {
+		Score() int
+	}
+	total := func(aln []feat.Pair) int {
+		var t int
+		for _, fp := range aln {
+			t += fp.(scorer).Score()
+		}
+		return t
+	}
+
+	for k := 0; k < 20; k++ {
+		sa, sb := randSeq(12), randSeq(10)
+
+		// With an unbounded X, the extension score is the
+		// best global alignment score of any pair of prefixes.
+		var want int
+		for i := 1; i <= sa.Len(); i++ {
+			for j := 1; j <= sb.Len(); j++ {
+				pa := linear.NewSeq("", sa.Seq[:i], alphabet.DNAgapped)
+				pb := linear.NewSeq("", sb.Seq[:j], alphabet.DNAgapped)
+				aln, err := NW(m).Align(pa, pb)
+				c.Assert(err, check.Equals, nil)
+				if t := total(aln); t > want {
+					want = t
+				}
+			}
+		}
+		aln, err := XDrop{Matrix: m, X: 1000}.Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		c.Check(total(aln), check.Equals, want)
+	}
+}
+
+func (s *S) TestXDropZDrop(c *check.C) {
+	sa := linear.NewSeq("", alphabet.BytesToLetters([]byte("ACGTACGTACTTTTTTTTACGTACGTACGT")), alphabet.DNAgapped)
+	sb := linear.NewSeq("", alphabet.BytesToLetters([]byte("ACGTACGTACGGGGGGGGACGTACGTACGT")), alphabet.DNAgapped)
+	m := Linear{
+		{0, -2, -2, -2, -2},
+		{-2, 2, -1, -1, -1},
+		{-2, -1, 2, -1, -1},
+		{-2, -1, -1, 2, -1},
+		{-2, -1, -1, -1, 2},
+	}
+	for _, t := range []struct {
+		x, z int
+		end  int
+	}{
+		{x: 1000, z: 0, end: 30},
+		{x: 1000, z: 5, end: 10},
+		{x: 5, z: 0, end: 10},
+	} {
+		aln, err := XDrop{Matrix: m, X: t.x, Z: t.z}.Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		f := aln[len(aln)-1].Features()
+		c.Check(f[0].End(), check.Equals, t.end, check.Commentf("X=%d Z=%d", t.x, t.z))
+	}
+}
+
+func (s *S) TestBatchAlignAll(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "acgt"[rnd.Intn(4)]
+		}
+		return linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNAgapped)
+	}
+	m := NW{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+
+	pairs := make([]SeqPair, 50)
+	for i := range pairs {
+		pairs[i] = SeqPair{Reference: randSeq(30 + i), Query: randSeq(40)}
+	}
+	pairs[10].Query = linear.NewSeq("", alphabet.BytesToLetters([]byte("acgt")), alphabet.Protein)
+
+	got := make([]bool, len(pairs))
+	for r := range (Batch{Aligner: m, Workers: 4}).AlignAll(nil, pairs) {
+		c.Check(got[r.Index], check.Equals, false)
+		got[r.Index] = true
+		want, err := m.Align(pairs[r.Index].Reference, pairs[r.Index].Query)
+		c.Check(r.Err, check.Equals, err)
+		c.Check(fmt.Sprint(r.Alignment), check.Equals, fmt.Sprint(want))
+	}
+	for i, ok := range got {
+		c.Check(ok, check.Equals, true, check.Commentf("missing result %d", i))
+	}
+
+	done := make(chan struct{})
+	results := (Batch{Aligner: m, Workers: 2}).AlignAll(done, pairs)
+	<-results
+	close(done)
+	n := 1
+	for range results {
+		n++
+	}
+	c.Check(n < len(pairs), check.Equals, true)
+}
+
+func (s *S) TestSemiGlobalPenalisedEnds(c *check.C) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	sa, _ := r.Read()
+	sb, _ := r.Read()
+
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	want, err := NW(m).Align(sa, sb)
+	c.Assert(err, check.Equals, nil)
+	got, err := SemiGlobal{Matrix: m, EndGaps: [4]int{-5, -5, -5, -5}}.Align(sa, sb)
+	c.Assert(err, check.Equals, nil)
+	c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want))
+}
+
+func (s *S) TestStripedSWScore(c *check.C) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	sa, _ := r.Read()
+	sb, _ := r.Read()
+
+	type scorer interface {
+		Score() int
+	}
+	for _, test := range []struct {
+		a, b    seq.Sequence
+		match   int
+		gapOpen int
+	}{
+		{a: sa, b: sb, match: 2, gapOpen: -5},
+		{a: sb, b: sa, match: 2, gapOpen: -5},
+		{a: sa, b: sb, match: 2, gapOpen: 0},
+		{a: sa, b: sa, match: 50, gapOpen: -5}, // Saturates 16-bit lanes.
+		{a: sa, b: sb, match: 1 << 16, gapOpen: -5},
+	} {
+		m := Linear{
+			{0, -1, -1, -1, -1},
+			{-1, test.match, -1, -1, -1},
+			{-1, -1, test.match, -1, -1},
+			{-1, -1, -1, test.match, -1},
+			{-1, -1, -1, -1, test.match},
+		}
+		aln, err := SWAffine{Matrix: m, GapOpen: test.gapOpen}.Align(test.a, test.b)
+		c.Assert(err, check.Equals, nil)
+		var want int
+		for _, fp := range aln {
+			want += fp.(scorer).Score()
+		}
+		got, err := StripedSW{Matrix: m, GapOpen: test.gapOpen}.Score(test.a, test.b)
+		c.Assert(err, check.Equals, nil)
+		c.Check(got, check.Equals, want)
+	}
+}
+
 func BenchmarkSWAlign(b *testing.B) {
 	t := &linear.Seq{}
 	t.Alpha = alphabet.DNAgapped
@@ -0,0 +1,93 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"runtime"
+	"sync"
+)
+
+// A SeqPair is a pair of sequences to be aligned.
+type SeqPair struct {
+	Reference, Query AlphabetSlicer
+}
+
+// A Result holds the result of aligning a SeqPair in a batch.
+type Result struct {
+	Index     int // Index of the pair in the batch.
+	Pair      SeqPair
+	Alignment []feat.Pair
+	Err       error
+}
+
+// Batch aligns sets of sequence pairs concurrently.
+type Batch struct {
+	Aligner Aligner
+
+	// Workers is the number of concurrent alignments.
+	// If Workers is less than one, runtime.GOMAXPROCS(0)
+	// workers are used.
+	Workers int
+}
+
+// AlignAll aligns each of the sequence pairs in pairs using the receiver's Aligner and
+// sends the results on the returned channel in the order that they complete. The channel
+// is closed when all the pairs have been aligned or done is closed. When done is closed,
+// no further alignments are started and results of alignments that are in progress may
+// not be sent. A nil done channel never cancels the batch. The Aligner must be safe for
+// concurrent use; all the aligners provided by this package are.
+func (b Batch) AlignAll(done <-chan struct{}, pairs []SeqPair) <-chan Result {
+	n := b.Workers
+	if n < 1 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	if n > len(pairs) {
+		n = len(pairs)
+	}
+
+	var (
+		out  = make(chan Result)
+		jobs = make(chan int)
+		wg   sync.WaitGroup
+	)
+	go func() {
+		defer close(jobs)
+		for i := range pairs {
+			select {
+			case jobs <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+	wg.Add(n)
+	for w := 0; w < n; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				p := pairs[i]
+				aln, err := b.Aligner.Align(p.Reference, p.Query)
+				select {
+				case out <- Result{Index: i, Pair: p, Alignment: aln, Err: err}:
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
@@ -0,0 +1,271 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// A CigarOpType represents the type of operation described by a CigarOp.
+type CigarOpType byte
+
+const (
+	CigarMatch       CigarOpType = iota // Alignment match (can be a sequence match or mismatch).
+	CigarInsertion                      // Insertion to the reference.
+	CigarDeletion                       // Deletion from the reference.
+	CigarSkipped                        // Skipped region from the reference.
+	CigarSoftClipped                    // Soft clipping (clipped sequences present in query).
+	CigarHardClipped                    // Hard clipping (clipped sequences not present in query).
+	CigarPadded                         // Padding (silent deletion from padded reference).
+	CigarEqual                          // Sequence match.
+	CigarMismatch                       // Sequence mismatch.
+)
+
+var cigarOps = []byte("MIDNSHP=X")
+
+// maxCigarOpLen is the largest operation length
+// that can be represented in a BAM CIGAR.
+const maxCigarOpLen = 1<<28 - 1
+
+// String returns the single letter representation of the operation type.
+func (t CigarOpType) String() string {
+	if int(t) >= len(cigarOps) {
+		return "?"
+	}
+	return string(cigarOps[t])
+}
+
+// consumes returns whether the operation type consumes reference and query letters.
+func (t CigarOpType) consumes() (ref, query bool) {
+	switch t {
+	case CigarMatch, CigarEqual, CigarMismatch:
+		return true, true
+	case CigarInsertion, CigarSoftClipped:
+		return false, true
+	case CigarDeletion, CigarSkipped:
+		return true, false
+	}
+	return false, false
+}
+
+// A CigarOp is a single CIGAR operation.
+type CigarOp struct {
+	Type CigarOpType
+	Len  int
+}
+
+// String returns the string representation of the operation.
+func (o CigarOp) String() string { return strconv.Itoa(o.Len) + o.Type.String() }
+
+// A Cigar is a sequence of CIGAR operations describing an alignment.
+type Cigar []CigarOp
+
+// String returns the CIGAR string representation of c. An empty Cigar
+// is represented as "*".
+func (c Cigar) String() string {
+	if len(c) == 0 {
+		return "*"
+	}
+	var buf bytes.Buffer
+	for _, o := range c {
+		buf.WriteString(o.String())
+	}
+	return buf.String()
+}
+
+// Lengths returns the number of reference and query letters described by c.
+func (c Cigar) Lengths() (ref, query int) {
+	for _, o := range c {
+		r, q := o.Type.consumes()
+		if r {
+			ref += o.Len
+		}
+		if q {
+			query += o.Len
+		}
+	}
+	return ref, query
+}
+
+// append appends an operation to c, merging it with the last operation if
+// they are of the same type.
+func (c Cigar) append(t CigarOpType, n int) Cigar {
+	if n == 0 {
+		return c
+	}
+	if len(c) != 0 && c[len(c)-1].Type == t {
+		c[len(c)-1].Len += n
+		return c
+	}
+	return append(c, CigarOp{Type: t, Len: n})
+}
+
+// A Cigarer is a feature pair able to describe itself as a CIGAR. The feature pairs
+// returned by the aligners in this package satisfy Cigarer.
+type Cigarer interface {
+	feat.Pair
+	Cigar() Cigar
+}
+
+// Cigar returns the CIGAR description of the feature pair.
+func (fp *featPair) Cigar() Cigar {
+	return CigarFor([]feat.Pair{fp})
+}
+
+// CigarFor returns the CIGAR description of the alignment described by aln. Aligned
+// segments are described as alignment matches, reference segments aligned against
+// gaps as deletions and query segments aligned against gaps as insertions. The
+// feature pairs of aln need not satisfy Cigarer.
+func CigarFor(aln []feat.Pair) Cigar {
+	var c Cigar
+	for _, fp := range aln {
+		f := fp.Features()
+		switch rl, ql := f[0].Len(), f[1].Len(); {
+		case rl != 0 && ql != 0:
+			c = c.append(CigarMatch, rl)
+		case rl != 0:
+			c = c.append(CigarDeletion, rl)
+		case ql != 0:
+			c = c.append(CigarInsertion, ql)
+		}
+	}
+	return c
+}
+
+// ExtendedCigarFor returns the CIGAR description of the alignment of reference and
+// query described by aln, with aligned segments described as sequence matches and
+// mismatches. Letters are compared according to their alphabet index. It returns an
+// error if the alphabets do not match or a letter is not valid.
+func ExtendedCigarFor(reference, query AlphabetSlicer, aln []feat.Pair) (Cigar, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	index := alpha.LetterIndex()
+	rVals, err := letterIndices(reference.Slice(), index, "rSeq")
+	if err != nil {
+		return nil, err
+	}
+	qVals, err := letterIndices(query.Slice(), index, "qSeq")
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cigar
+	for _, fp := range aln {
+		f := fp.Features()
+		switch rl, ql := f[0].Len(), f[1].Len(); {
+		case rl != 0 && ql != 0:
+			if rl != ql {
+				return nil, fmt.Errorf("align: aligned segment lengths differ: %d != %d", rl, ql)
+			}
+			for i, j := f[0].Start(), f[1].Start(); i < f[0].End(); i, j = i+1, j+1 {
+				if rVals[i] == qVals[j] {
+					c = c.append(CigarEqual, 1)
+				} else {
+					c = c.append(CigarMismatch, 1)
+				}
+			}
+		case rl != 0:
+			c = c.append(CigarDeletion, rl)
+		case ql != 0:
+			c = c.append(CigarInsertion, ql)
+		}
+	}
+	return c, nil
+}
+
+// ParseCigar parses a CIGAR string. The string "*" is parsed as an empty Cigar.
+func ParseCigar(s string) (Cigar, error) {
+	if s == "*" {
+		return nil, nil
+	}
+	if len(s) == 0 {
+		return nil, errors.New("align: empty cigar string")
+	}
+	var (
+		c Cigar
+		n = -1
+	)
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		if '0' <= b && b <= '9' {
+			if n < 0 {
+				n = 0
+			}
+			n = n*10 + int(b-'0')
+			if n > maxCigarOpLen {
+				return nil, fmt.Errorf("align: cigar operation length too large at position %d", i)
+			}
+			continue
+		}
+		t := bytes.IndexByte(cigarOps, b)
+		if t < 0 {
+			return nil, fmt.Errorf("align: invalid cigar operation %q at position %d", b, i)
+		}
+		if n < 0 {
+			return nil, fmt.Errorf("align: missing cigar operation length at position %d", i)
+		}
+		c = append(c, CigarOp{Type: CigarOpType(t), Len: n})
+		n = -1
+	}
+	if n >= 0 {
+		return nil, errors.New("align: cigar string ends with length")
+	}
+	return c, nil
+}
+
+// Alignment returns an alignment description corresponding to c with the first
+// aligned letter of the reference at refStart and the first letter of the query,
+// including any leading soft clipped letters, at queryStart. Adjacent alignment
+// match, sequence match and sequence mismatch operations are described by a single
+// feature pair. Skipped regions are described in the same way as deletions, soft
+// clipped query letters are omitted, and hard clipping and padding operations are
+// ignored. Scores of the returned feature pairs are zero.
+func (c Cigar) Alignment(refStart, queryStart int) []feat.Pair {
+	var (
+		aln  []feat.Pair
+		last *featPair
+		i, j = refStart, queryStart
+	)
+	for _, o := range c {
+		cr, cq := o.Type.consumes()
+		if o.Type == CigarSoftClipped {
+			j += o.Len
+			continue
+		}
+		if !cr && !cq {
+			continue
+		}
+		di, dj := 0, 0
+		if cr {
+			di = o.Len
+		}
+		if cq {
+			dj = o.Len
+		}
+		if last != nil && (last.a.Len() != 0) == cr && (last.b.Len() != 0) == cq && last.a.end == i && last.b.end == j {
+			last.a.end += di
+			last.b.end += dj
+		} else {
+			last = &featPair{
+				a: feature{start: i, end: i + di},
+				b: feature{start: j, end: j + dj},
+			}
+			aln = append(aln, last)
+		}
+		i += di
+		j += dj
+	}
+	return aln
+}
@@ -0,0 +1,56 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleCigarFor() {
+	nwsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("AGACTAGTTA"))}
+	nwsa.Alpha = alphabet.DNAgapped
+	nwsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GACAGACG"))}
+	nwsb.Alpha = alphabet.DNAgapped
+
+	needle := NW{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+
+	aln, err := needle.Align(nwsa, nwsb)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fa := Format(nwsa, nwsb, aln, '-')
+	fmt.Printf("%s\n%s\n", fa[0], fa[1])
+
+	fmt.Println(CigarFor(aln))
+	ext, err := ExtendedCigarFor(nwsa, nwsb, aln)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(ext)
+
+	c, err := ParseCigar(ext.String())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(c.Alignment(0, 0))
+	// Output:
+	// AGACTAGTTA
+	// -GAC-AGACG
+	// 1D3M1D5M
+	// 1D3=1D2=3X
+	// [[0,1)/-=0 [1,4)/[0,3)=0 [4,5)/-=0 [5,10)/[3,8)=0]
+}
@@ -0,0 +1,110 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestParseCigar(c *check.C) {
+	for _, test := range []struct {
+		in       string
+		want     Cigar
+		ref, qry int
+		err      bool
+	}{
+		{in: "*"},
+		{in: "", err: true},
+		{in: "10M", want: Cigar{{CigarMatch, 10}}, ref: 10, qry: 10},
+		{
+			in: "5S3=1X2I4D10N6M2H",
+			want: Cigar{
+				{CigarSoftClipped, 5}, {CigarEqual, 3}, {CigarMismatch, 1},
+				{CigarInsertion, 2}, {CigarDeletion, 4}, {CigarSkipped, 10},
+				{CigarMatch, 6}, {CigarHardClipped, 2},
+			},
+			ref: 24, qry: 17,
+		},
+		{in: "M", err: true},
+		{in: "10", err: true},
+		{in: "3Q", err: true},
+		{in: "268435455M", want: Cigar{{CigarMatch, 268435455}}, ref: 268435455, qry: 268435455},
+		{in: "268435456M", err: true},
+		{in: "99999999999999999999M", err: true},
+	} {
+		got, err := ParseCigar(test.in)
+		if test.err {
+			c.Check(err, check.NotNil, check.Commentf("%q", test.in))
+			continue
+		}
+		c.Assert(err, check.Equals, nil)
+		c.Check(got, check.DeepEquals, test.want)
+		c.Check(got.String(), check.Equals, test.in)
+		ref, qry := got.Lengths()
+		c.Check(ref, check.Equals, test.ref)
+		c.Check(qry, check.Equals, test.qry)
+	}
+}
+
+func (s *S) TestCigarer(c *check.C) {
+	a := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("AGACTAGTTA"))}
+	a.Alpha = alphabet.DNAgapped
+	b := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GACAGACG"))}
+	b.Alpha = alphabet.DNAgapped
+	needle := NW{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	aln, err := needle.Align(a, b)
+	c.Assert(err, check.Equals, nil)
+
+	var got Cigar
+	for _, fp := range aln {
+		cp, ok := fp.(Cigarer)
+		c.Assert(ok, check.Equals, true)
+		for _, o := range cp.Cigar() {
+			got = got.append(o.Type, o.Len)
+		}
+	}
+	c.Check(got, check.DeepEquals, CigarFor(aln))
+}
+
+func (s *S) TestCigarAlignment(c *check.C) {
+	for _, test := range []struct {
+		in       string
+		ref, qry int
+		want     [][2][2]int
+	}{
+		{
+			in: "3S4M", ref: 10, qry: 0,
+			want: [][2][2]int{{{10, 14}, {3, 7}}},
+		},
+		{
+			in: "2H3S2M1I2=1X2D3M4S", ref: 0, qry: 5,
+			want: [][2][2]int{
+				{{0, 2}, {8, 10}},
+				{{2, 2}, {10, 11}},
+				{{2, 5}, {11, 14}},
+				{{5, 7}, {14, 14}},
+				{{7, 10}, {14, 17}},
+			},
+		},
+	} {
+		cig, err := ParseCigar(test.in)
+		c.Assert(err, check.Equals, nil)
+		aln := cig.Alignment(test.ref, test.qry)
+		c.Assert(len(aln), check.Equals, len(test.want), check.Commentf("%q", test.in))
+		for i, fp := range aln {
+			f := fp.Features()
+			c.Check([2][2]int{{f[0].Start(), f[0].End()}, {f[1].Start(), f[1].End()}}, check.Equals, test.want[i], check.Commentf("%q pair %d", test.in, i))
+		}
+	}
+}
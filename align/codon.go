@@ -0,0 +1,107 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/translate"
+
+	"errors"
+)
+
+var (
+	ErrNotNucleic      = errors.New("align: sequence is not nucleic acid")
+	ErrNotCodonAligned = errors.New("align: sequence length is not a multiple of three")
+	ErrNoAligner       = errors.New("align: no aligner")
+)
+
+// Codon is the codon-aware aligner type. Nucleotide coding sequences are translated to
+// protein and aligned by Aligner, which should be configured with a protein scoring
+// matrix that is indexed by alphabet.Protein. Alignment segment coordinates are reported
+// in nucleotide space, so gaps are always a multiple of three letters long and reading
+// frames are preserved.
+type Codon struct {
+	Aligner Aligner
+
+	// Translate returns the amino acid encoded by a codon. If
+	// Translate is nil, the standard genetic code is used.
+	Translate func(codon []alphabet.Letter) alphabet.Letter
+}
+
+// Align translates and aligns two nucleotide coding sequences. It returns an alignment
+// description in nucleotide coordinates or an error if the sequences are not nucleic acid,
+// their lengths are not a multiple of three, the alphabets do not match, no protein
+// aligner is provided or the protein alignment fails.
+func (a Codon) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if m := alpha.Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, ErrNotNucleic
+	}
+	if a.Aligner == nil {
+		return nil, ErrNoAligner
+	}
+	trans := a.Translate
+	if trans == nil {
+		trans = translate.Standard.Translate
+	}
+	rProt, err := translateSlice(reference.Slice(), trans)
+	if err != nil {
+		return nil, err
+	}
+	qProt, err := translateSlice(query.Slice(), trans)
+	if err != nil {
+		return nil, err
+	}
+
+	aln, err := a.Aligner.Align(
+		slicer{alpha: alphabet.Protein, s: rProt},
+		slicer{alpha: alphabet.Protein, s: qProt},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, fp := range aln {
+		fp, ok := fp.(*featPair)
+		if !ok {
+			return nil, ErrTypeNotHandled
+		}
+		fp.a.start *= 3
+		fp.a.end *= 3
+		fp.b.start *= 3
+		fp.b.end *= 3
+	}
+	return aln, nil
+}
+
+// translateSlice returns the conceptual translation of s.
+func translateSlice(s alphabet.Slice, translate func([]alphabet.Letter) alphabet.Letter) (alphabet.Letters, error) {
+	var l []alphabet.Letter
+	switch s := s.(type) {
+	case alphabet.Letters:
+		l = s
+	case alphabet.QLetters:
+		l = make([]alphabet.Letter, len(s))
+		for i, ql := range s {
+			l[i] = ql.L
+		}
+	default:
+		return nil, ErrTypeNotHandled
+	}
+	if len(l)%3 != 0 {
+		return nil, ErrNotCodonAligned
+	}
+	p := make(alphabet.Letters, len(l)/3)
+	for i := range p {
+		p[i] = translate(l[i*3 : i*3+3])
+	}
+	return p, nil
+}
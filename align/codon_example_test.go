@@ -0,0 +1,34 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleCodon_Align() {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("ATGGCTAAAGGTTGGCTGTAA")), alphabet.DNAgapped)
+	cds := linear.NewSeq("cds", alphabet.BytesToLetters([]byte("ATGGCAAAGCATGGCTGGTTGTAA")), alphabet.DNAgapped)
+
+	codon := Codon{
+		Aligner: NWAffine{Matrix: matrix.BLOSUM62, GapOpen: -10},
+	}
+	aln, err := codon.Align(ref, cds)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(aln)
+	fa := Format(ref, cds, aln, '-')
+	fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	// Output:
+	// [[0,9)/[0,9)=14 -/[9,12)=-10 [9,21)/[12,24)=22]
+	// ATGGCTAAA---GGTTGGCTGTAA
+	// ATGGCAAAGCATGGCTGGTTGTAA
+}
@@ -0,0 +1,117 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"errors"
+	"sort"
+)
+
+// A Dot is an occupied cell of a dot plot. I and J are the positions of the
+// start of the matching words in the reference and query, and Matches is the
+// number of identical letters in the words.
+type Dot struct {
+	I, J    int
+	Matches int
+}
+
+// A DotPlot is a sparse dot plot occupancy matrix.
+type DotPlot struct {
+	rows, cols int
+
+	// Dots holds the occupied cells of the dot plot,
+	// sorted by reference and then query position.
+	Dots []Dot
+}
+
+// NewDotPlot returns a dot plot of reference against query. A cell (i, j) of the dot plot
+// is occupied if the words of length k starting at position i of reference and position
+// j of query have at least threshold identical letters. Letters are compared according
+// to their alphabet index. It returns an error if k is not positive, the alphabets do not
+// match or a letter is not valid.
+func NewDotPlot(reference, query AlphabetSlicer, k, threshold int) (*DotPlot, error) {
+	if k <= 0 {
+		return nil, errors.New("align: word size must be positive")
+	}
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	index := alpha.LetterIndex()
+	rVals, err := letterIndices(reference.Slice(), index, "rSeq")
+	if err != nil {
+		return nil, err
+	}
+	qVals, err := letterIndices(query.Slice(), index, "qSeq")
+	if err != nil {
+		return nil, err
+	}
+
+	d := &DotPlot{rows: len(rVals) - k + 1, cols: len(qVals) - k + 1}
+	if d.rows <= 0 || d.cols <= 0 {
+		d.rows, d.cols = 0, 0
+		return d, nil
+	}
+
+	// Slide a window along each diagonal, counting
+	// identical letters within the window.
+	for diag := -(d.rows - 1); diag < d.cols; diag++ {
+		i, j := 0, diag
+		if diag < 0 {
+			i, j = -diag, 0
+		}
+		var n int
+		for o := 0; i+o < len(rVals) && j+o < len(qVals); o++ {
+			if rVals[i+o] == qVals[j+o] {
+				n++
+			}
+			if o >= k && rVals[i+o-k] == qVals[j+o-k] {
+				n--
+			}
+			if o >= k-1 && n >= threshold {
+				d.Dots = append(d.Dots, Dot{I: i + o - k + 1, J: j + o - k + 1, Matches: n})
+			}
+		}
+	}
+	sort.Sort(dots(d.Dots))
+
+	return d, nil
+}
+
+// Dims returns the dimensions of the dot plot matrix, the number of words in the
+// reference and query.
+func (d *DotPlot) Dims() (r, c int) { return d.rows, d.cols }
+
+// At returns the number of identical letters in the words starting at positions i
+// and j of the reference and query if the cell is occupied, and zero otherwise. At
+// will panic if i or j are out of range.
+func (d *DotPlot) At(i, j int) float64 {
+	if i < 0 || i >= d.rows || j < 0 || j >= d.cols {
+		panic("align: index out of range")
+	}
+	k := sort.Search(len(d.Dots), func(k int) bool {
+		dk := d.Dots[k]
+		return dk.I > i || (dk.I == i && dk.J >= j)
+	})
+	if k < len(d.Dots) && d.Dots[k].I == i && d.Dots[k].J == j {
+		return float64(d.Dots[k].Matches)
+	}
+	return 0
+}
+
+// dots sorts a slice of Dot by reference and then query position.
+type dots []Dot
+
+func (d dots) Len() int { return len(d) }
+func (d dots) Less(i, j int) bool {
+	if d[i].I != d[j].I {
+		return d[i].I < d[j].I
+	}
+	return d[i].J < d[j].J
+}
+func (d dots) Swap(i, j int) { d[i], d[j] = d[j], d[i] }
@@ -0,0 +1,48 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleNewDotPlot() {
+	a := linear.NewSeq("a", alphabet.BytesToLetters([]byte("GATTACAGATTACA")), alphabet.DNAgapped)
+	b := linear.NewSeq("b", alphabet.BytesToLetters([]byte("CCGATTACATT")), alphabet.DNAgapped)
+
+	d, err := NewDotPlot(a, b, 4, 4)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	r, c := d.Dims()
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			if d.At(i, j) != 0 {
+				fmt.Print("\\")
+			} else {
+				fmt.Print(".")
+			}
+		}
+		fmt.Println()
+	}
+	fmt.Println(d.Dots)
+	// Output:
+	// ..\.....
+	// ...\....
+	// ....\...
+	// .....\..
+	// ........
+	// ........
+	// ........
+	// ..\.....
+	// ...\....
+	// ....\...
+	// .....\..
+	// [{0 2 4} {1 3 4} {2 4 4} {3 5 4} {7 2 4} {8 3 4} {9 4 4} {10 5 4}]
+}
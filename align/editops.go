@@ -0,0 +1,170 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"fmt"
+)
+
+// An EditOpType represents the type of operation described by an EditOp.
+type EditOpType byte
+
+const (
+	Match     EditOpType = iota // Aligned identical letters.
+	Mismatch                    // Aligned differing letters.
+	Insertion                   // Query letters aligned against gaps.
+	Deletion                    // Reference letters aligned against gaps.
+)
+
+var editOpNames = [...]string{
+	Match:     "match",
+	Mismatch:  "mismatch",
+	Insertion: "insertion",
+	Deletion:  "deletion",
+}
+
+// String returns the name of the operation type.
+func (t EditOpType) String() string {
+	if int(t) >= len(editOpNames) {
+		return fmt.Sprintf("EditOpType(%d)", t)
+	}
+	return editOpNames[t]
+}
+
+// An EditOp is a run of a single type of edit operation. Ref and Query are the positions
+// of the start of the run in the reference and query. Len is the number of alignment
+// columns in the run.
+type EditOp struct {
+	Type       EditOpType
+	Ref, Query int
+	Len        int
+}
+
+// String returns a string representation of the operation.
+func (o EditOp) String() string {
+	return fmt.Sprintf("%v(%d/%d,%d)", o.Type, o.Ref, o.Query, o.Len)
+}
+
+// Cigar returns the extended CIGAR operation equivalent to o.
+func (o EditOp) Cigar() CigarOp {
+	return CigarOp{Type: [...]CigarOpType{CigarEqual, CigarMismatch, CigarInsertion, CigarDeletion}[o.Type], Len: o.Len}
+}
+
+// EditOps is an iterator over the edit operations of an alignment. Consecutive operations
+// of the same type are reported as a single operation.
+type EditOps struct {
+	aln          []feat.Pair
+	rVals, qVals []int
+
+	seg, off int
+	next     EditOp
+	hasNext  bool
+	op       EditOp
+}
+
+// NewEditOps returns an EditOps iterating over the alignment of reference and query
+// described by aln. Letters are compared according to their alphabet index. It returns an
+// error if the alphabets do not match, a letter is not valid or the lengths of an aligned
+// segment differ.
+func NewEditOps(reference, query AlphabetSlicer, aln []feat.Pair) (*EditOps, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	index := alpha.LetterIndex()
+	rVals, err := letterIndices(reference.Slice(), index, "rSeq")
+	if err != nil {
+		return nil, err
+	}
+	qVals, err := letterIndices(query.Slice(), index, "qSeq")
+	if err != nil {
+		return nil, err
+	}
+	for _, fp := range aln {
+		f := fp.Features()
+		if rl, ql := f[0].Len(), f[1].Len(); rl != 0 && ql != 0 && rl != ql {
+			return nil, fmt.Errorf("align: aligned segment lengths differ: %d != %d", rl, ql)
+		}
+	}
+	it := &EditOps{aln: aln, rVals: rVals, qVals: qVals}
+	it.next, it.hasNext = it.step()
+	return it, nil
+}
+
+// Next advances the iterator to the next edit operation, which will then be available
+// through the Op method. It returns false when there are no more operations.
+func (it *EditOps) Next() bool {
+	if !it.hasNext {
+		return false
+	}
+	it.op = it.next
+	for {
+		it.next, it.hasNext = it.step()
+		if !it.hasNext || it.next.Type != it.op.Type {
+			break
+		}
+		rl, ql := it.op.Len, it.op.Len
+		switch it.op.Type {
+		case Insertion:
+			rl = 0
+		case Deletion:
+			ql = 0
+		}
+		if it.next.Ref != it.op.Ref+rl || it.next.Query != it.op.Query+ql {
+			break
+		}
+		it.op.Len += it.next.Len
+	}
+	return true
+}
+
+// Op returns the most recent edit operation found by a call to Next.
+func (it *EditOps) Op() EditOp { return it.op }
+
+// step returns the next operation within a single feature pair of the alignment.
+func (it *EditOps) step() (EditOp, bool) {
+	for ; it.seg < len(it.aln); it.seg, it.off = it.seg+1, 0 {
+		f := it.aln[it.seg].Features()
+		rl, ql := f[0].Len(), f[1].Len()
+		switch {
+		case rl != 0 && ql != 0:
+			if it.off >= rl {
+				continue
+			}
+			r, q := f[0].Start()+it.off, f[1].Start()+it.off
+			typ := Mismatch
+			if it.rVals[r] == it.qVals[q] {
+				typ = Match
+			}
+			n := 1
+			for ; it.off+n < rl; n++ {
+				same := it.rVals[r+n] == it.qVals[q+n]
+				if same != (typ == Match) {
+					break
+				}
+			}
+			it.off += n
+			return EditOp{Type: typ, Ref: r, Query: q, Len: n}, true
+		case rl != 0:
+			if it.off != 0 {
+				continue
+			}
+			it.off = rl
+			return EditOp{Type: Deletion, Ref: f[0].Start(), Query: f[1].Start(), Len: rl}, true
+		case ql != 0:
+			if it.off != 0 {
+				continue
+			}
+			it.off = ql
+			return EditOp{Type: Insertion, Ref: f[0].Start(), Query: f[1].Start(), Len: ql}, true
+		}
+	}
+	return EditOp{}, false
+}
@@ -0,0 +1,59 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleEditOps() {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("ACGTTACGGTACCATTT")), alphabet.DNAgapped)
+	query := linear.NewSeq("query", alphabet.BytesToLetters([]byte("ACGTACGGTACTATTTGG")), alphabet.DNAgapped)
+
+	needle := NWAffine{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 1, -1, -1, -1},
+			{-1, -1, 1, -1, -1},
+			{-1, -1, -1, 1, -1},
+			{-1, -1, -1, -1, 1},
+		},
+		GapOpen: -2,
+	}
+	aln, err := needle.Align(ref, query)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fa := Format(ref, query, aln, '-')
+	fmt.Printf("%s\n%s\n\n", fa[0], fa[1])
+
+	ops, err := NewEditOps(ref, query, aln)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	var c Cigar
+	for ops.Next() {
+		op := ops.Op()
+		fmt.Println(op)
+		c = append(c, op.Cigar())
+	}
+	fmt.Println(c)
+	// Output:
+	// ACGTTACGGTACCATTT--
+	// ACGT-ACGGTACTATTTGG
+	//
+	// match(0/0,4)
+	// deletion(4/4,1)
+	// match(5/4,7)
+	// mismatch(12/11,1)
+	// match(13/12,4)
+	// insertion(17/16,2)
+	// 4=1D7=1X4=2I
+}
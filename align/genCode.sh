@@ -125,3 +125,57 @@ cat < nw_affine_type.got \
 | gofmt -r 'rSeq[i] -> rSeq[i].L' \
 | gofmt -r 'qSeq[i] -> qSeq[i].L' \
 >> nw_affine_qletters.go
+
+echo -e $WARNING\
+> sw_banded_letters.go
+cat < sw_banded_type.got \
+| gofmt -r 'alignType -> alignLetters' \
+| gofmt -r 'Type -> alphabet.Letters' \
+| gofmt -r 'drawSWBandedTableType -> drawSWBandedTableLetters' \
+>> sw_banded_letters.go
+
+echo -e $WARNING\
+> sw_banded_qletters.go
+cat < sw_banded_type.got \
+| gofmt -r 'alignType -> alignQLetters' \
+| gofmt -r 'Type -> alphabet.QLetters' \
+| gofmt -r 'drawSWBandedTableType -> drawSWBandedTableQLetters' \
+| gofmt -r 'rSeq[i] -> rSeq[i].L' \
+| gofmt -r 'qSeq[i] -> qSeq[i].L' \
+>> sw_banded_qletters.go
+
+echo -e $WARNING\
+> nw_banded_letters.go
+cat < nw_banded_type.got \
+| gofmt -r 'alignType -> alignLetters' \
+| gofmt -r 'Type -> alphabet.Letters' \
+| gofmt -r 'drawNWBandedTableType -> drawNWBandedTableLetters' \
+>> nw_banded_letters.go
+
+echo -e $WARNING\
+> nw_banded_qletters.go
+cat < nw_banded_type.got \
+| gofmt -r 'alignType -> alignQLetters' \
+| gofmt -r 'Type -> alphabet.QLetters' \
+| gofmt -r 'drawNWBandedTableType -> drawNWBandedTableQLetters' \
+| gofmt -r 'rSeq[i] -> rSeq[i].L' \
+| gofmt -r 'qSeq[i] -> qSeq[i].L' \
+>> nw_banded_qletters.go
+
+echo -e $WARNING\
+> semi_letters.go
+cat < semi_type.got \
+| gofmt -r 'alignType -> alignLetters' \
+| gofmt -r 'Type -> alphabet.Letters' \
+| gofmt -r 'drawSemiGlobalTableType -> drawSemiGlobalTableLetters' \
+>> semi_letters.go
+
+echo -e $WARNING\
+> semi_qletters.go
+cat < semi_type.got \
+| gofmt -r 'alignType -> alignQLetters' \
+| gofmt -r 'Type -> alphabet.QLetters' \
+| gofmt -r 'drawSemiGlobalTableType -> drawSemiGlobalTableQLetters' \
+| gofmt -r 'rSeq[i] -> rSeq[i].L' \
+| gofmt -r 'qSeq[i] -> qSeq[i].L' \
+>> semi_qletters.go
@@ -0,0 +1,304 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"fmt"
+)
+
+// Hirschberg is the linear gap penalty, linear memory Needleman-Wunsch aligner type.
+type Hirschberg Linear
+
+// Align aligns two sequences using Hirschberg's divide and conquer formulation of the
+// Needleman-Wunsch algorithm. The dynamic programming score rows require O(min(n,m))
+// memory, but the letter indices of both sequences and the alignment description are
+// also held, so total memory use is O(n+m). The score of the returned alignment is the
+// same as that of the alignment returned by NW, though where there is more than one
+// optimal alignment, the alignment chosen may differ. It returns an alignment description
+// or an error if the scoring matrix is not square, or the sequence data types or
+// alphabets do not match.
+func (a Hirschberg) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	let := len(a)
+	if let < alpha.Len() {
+		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
+	}
+	la := make([]int, 0, let*let)
+	for _, row := range a {
+		if len(row) != let {
+			return nil, ErrMatrixNotSquare
+		}
+		la = append(la, row...)
+	}
+
+	rSl, qSl := reference.Slice(), query.Slice()
+	switch rSl.(type) {
+	case alphabet.Letters:
+		if _, ok := qSl.(alphabet.Letters); !ok {
+			return nil, ErrMismatchedTypes
+		}
+	case alphabet.QLetters:
+		if _, ok := qSl.(alphabet.QLetters); !ok {
+			return nil, ErrMismatchedTypes
+		}
+	default:
+		return nil, ErrTypeNotHandled
+	}
+	index := alpha.LetterIndex()
+	rVals, err := letterIndices(rSl, index, "rSeq")
+	if err != nil {
+		return nil, err
+	}
+	qVals, err := letterIndices(qSl, index, "qSeq")
+	if err != nil {
+		return nil, err
+	}
+
+	h := hirschberg{la: la, let: let, r: rVals, q: qVals}
+	if len(qVals) > len(rVals) {
+		// Keep the score rows as short as possible.
+		h.r, h.q = h.q, h.r
+		h.transposed = true
+	}
+	ops := h.align(0, len(h.r), 0, len(h.q), nil)
+	if h.transposed {
+		for i, op := range ops {
+			switch op {
+			case up:
+				ops[i] = left
+			case left:
+				ops[i] = up
+			}
+		}
+	}
+
+	var (
+		aln  []feat.Pair
+		i, j int
+	)
+	for p := 0; p < len(ops); {
+		op := ops[p]
+		si, sj, score := i, j, 0
+		for ; p < len(ops) && ops[p] == op; p++ {
+			switch op {
+			case diag:
+				score += la[rVals[i]*let+qVals[j]]
+				i++
+				j++
+			case up:
+				score += la[rVals[i]*let]
+				i++
+			case left:
+				score += la[qVals[j]]
+				j++
+			}
+		}
+		aln = append(aln, &featPair{
+			a:     feature{start: si, end: i},
+			b:     feature{start: sj, end: j},
+			score: score,
+		})
+	}
+
+	return aln, nil
+}
+
+// letterIndices returns the alphabet indices of the letters in s.
+func letterIndices(s alphabet.Slice, index alphabet.Index, name string) ([]int, error) {
+	var vals []int
+	switch s := s.(type) {
+	case alphabet.Letters:
+		vals = make([]int, len(s))
+		for i, l := range s {
+			if vals[i] = index[l]; vals[i] < 0 {
+				return nil, fmt.Errorf("align: illegal letter %q at position %d in %s", l, i, name)
+			}
+		}
+	case alphabet.QLetters:
+		vals = make([]int, len(s))
+		for i, ql := range s {
+			if vals[i] = index[ql.L]; vals[i] < 0 {
+				return nil, fmt.Errorf("align: illegal letter %q at position %d in %s", ql.L, i, name)
+			}
+		}
+	default:
+		return nil, ErrTypeNotHandled
+	}
+	return vals, nil
+}
+
+// hirschberg holds the state for a Hirschberg alignment. Rows of the
+// conceptual dynamic programming table correspond to r and columns to q.
+// If transposed is true, r holds the query and q holds the reference.
+type hirschberg struct {
+	la  []int
+	let int
+
+	r, q       []int
+	transposed bool
+}
+
+func (h *hirschberg) sub(x, y int) int {
+	if h.transposed {
+		return h.la[y*h.let+x]
+	}
+	return h.la[x*h.let+y]
+}
+
+// gapRow returns the score for aligning row letter x against a gap.
+func (h *hirschberg) gapRow(x int) int {
+	if h.transposed {
+		return h.la[x]
+	}
+	return h.la[x*h.let]
+}
+
+// gapCol returns the score for aligning column letter y against a gap.
+func (h *hirschberg) gapCol(y int) int {
+	if h.transposed {
+		return h.la[y*h.let]
+	}
+	return h.la[y]
+}
+
+// align appends the operations describing an optimal alignment of r[r0:r1]
+// with q[q0:q1] to ops and returns the result.
+func (h *hirschberg) align(r0, r1, q0, q1 int, ops []byte) []byte {
+	switch {
+	case r0 == r1:
+		for j := q0; j < q1; j++ {
+			ops = append(ops, left)
+		}
+		return ops
+	case q0 == q1:
+		for i := r0; i < r1; i++ {
+			ops = append(ops, up)
+		}
+		return ops
+	case r1-r0 == 1 || q1-q0 == 1:
+		return h.full(r0, r1, q0, q1, ops)
+	}
+
+	mid := r0 + (r1-r0)/2
+	fwd := h.forward(r0, mid, q0, q1)
+	rev := h.reverse(mid, r1, q0, q1)
+	split, best := q0, minInt
+	for k := range fwd {
+		if s := fwd[k] + rev[k]; s > best {
+			split, best = q0+k, s
+		}
+	}
+
+	ops = h.align(r0, mid, q0, split, ops)
+	return h.align(mid, r1, split, q1, ops)
+}
+
+// forward returns the last row of the Needleman-Wunsch score table for r[r0:r1]
+// and q[q0:q1].
+func (h *hirschberg) forward(r0, r1, q0, q1 int) []int {
+	c := q1 - q0 + 1
+	prev, cur := make([]int, c), make([]int, c)
+	for j := 1; j < c; j++ {
+		prev[j] = prev[j-1] + h.gapCol(h.q[q0+j-1])
+	}
+	for i := r0; i < r1; i++ {
+		x := h.r[i]
+		cur[0] = prev[0] + h.gapRow(x)
+		for j := 1; j < c; j++ {
+			y := h.q[q0+j-1]
+			cur[j] = max3(
+				prev[j-1]+h.sub(x, y),
+				prev[j]+h.gapRow(x),
+				cur[j-1]+h.gapCol(y),
+			)
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+// reverse returns the scores of optimal alignments of r[r0:r1] with each suffix of
+// q[q0:q1], indexed by the start of the suffix relative to q0.
+func (h *hirschberg) reverse(r0, r1, q0, q1 int) []int {
+	c := q1 - q0 + 1
+	prev, cur := make([]int, c), make([]int, c)
+	for j := c - 2; j >= 0; j-- {
+		prev[j] = prev[j+1] + h.gapCol(h.q[q0+j])
+	}
+	for i := r1 - 1; i >= r0; i-- {
+		x := h.r[i]
+		cur[c-1] = prev[c-1] + h.gapRow(x)
+		for j := c - 2; j >= 0; j-- {
+			y := h.q[q0+j]
+			cur[j] = max3(
+				prev[j+1]+h.sub(x, y),
+				prev[j]+h.gapRow(x),
+				cur[j+1]+h.gapCol(y),
+			)
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+// full appends the operations describing an optimal alignment of r[r0:r1] with
+// q[q0:q1] to ops using a complete score table. It is only used when one of the
+// sequence segments is a single letter long, so memory use is linear.
+func (h *hirschberg) full(r0, r1, q0, q1 int, ops []byte) []byte {
+	r, c := r1-r0+1, q1-q0+1
+	table := make([]int, r*c)
+	for j := 1; j < c; j++ {
+		table[j] = table[j-1] + h.gapCol(h.q[q0+j-1])
+	}
+	for i := 1; i < r; i++ {
+		x := h.r[r0+i-1]
+		table[i*c] = table[(i-1)*c] + h.gapRow(x)
+		for j := 1; j < c; j++ {
+			y := h.q[q0+j-1]
+			p := i*c + j
+			table[p] = max3(
+				table[p-c-1]+h.sub(x, y),
+				table[p-c]+h.gapRow(x),
+				table[p-1]+h.gapCol(y),
+			)
+		}
+	}
+
+	n := len(ops)
+	i, j := r-1, c-1
+	for i > 0 || j > 0 {
+		p := i*c + j
+		switch {
+		case i > 0 && j > 0 && table[p] == table[p-c-1]+h.sub(h.r[r0+i-1], h.q[q0+j-1]):
+			ops = append(ops, diag)
+			i--
+			j--
+		case i > 0 && table[p] == table[p-c]+h.gapRow(h.r[r0+i-1]):
+			ops = append(ops, up)
+			i--
+		case j > 0:
+			ops = append(ops, left)
+			j--
+		default:
+			panic(fmt.Sprintf("align: hirschberg internal error: no path at row: %d col:%d\n", i, j))
+		}
+	}
+	for i, j := n, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
@@ -0,0 +1,210 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// KarlinAltschul holds the Karlin-Altschul statistical parameters of a local alignment
+// scoring system.
+//
+// Parameters for ungapped alignment may be calculated from a scoring matrix and letter
+// frequencies by UngappedKarlinAltschul. Parameters for gapped alignment cannot be
+// calculated analytically and should be taken from published tables for the scoring
+// system in use.
+type KarlinAltschul struct {
+	Lambda float64 // Scale parameter in nats per score unit.
+	K      float64 // Search space scale parameter.
+	H      float64 // Relative entropy of the scoring system in nats per aligned pair.
+}
+
+const (
+	karlinIterLimit = 100
+	karlinSumLimit  = 1e-10
+)
+
+// UngappedKarlinAltschul returns the Karlin-Altschul parameters for ungapped local
+// alignment using the scoring matrix m and the reference and query letter frequencies,
+// indexed by alphabet letter index. Frequencies need not be normalised. It returns an
+// error if the scoring matrix is not square, the frequencies do not match the matrix, or
+// the scoring system does not have a negative expected score and a positive maximum score.
+func UngappedKarlinAltschul(m Linear, rFreqs, qFreqs []float64) (KarlinAltschul, error) {
+	for _, row := range m {
+		if len(row) != len(m) {
+			return KarlinAltschul{}, ErrMatrixNotSquare
+		}
+	}
+	if len(rFreqs) > len(m) || len(qFreqs) > len(m) {
+		return KarlinAltschul{}, fmt.Errorf("align: too many letter frequencies for %d letter matrix", len(m))
+	}
+	rSum, qSum := sum(rFreqs), sum(qFreqs)
+	if rSum <= 0 || qSum <= 0 {
+		return KarlinAltschul{}, errors.New("align: no letter frequencies")
+	}
+
+	// Find the range and greatest common divisor of the scores.
+	low, high, d := 0, 0, 0
+	for i, fr := range rFreqs {
+		for j, fq := range qFreqs {
+			if fr == 0 || fq == 0 {
+				continue
+			}
+			s := m[i][j]
+			if s < low {
+				low = s
+			}
+			if s > high {
+				high = s
+			}
+			d = gcd(d, s)
+		}
+	}
+	if low >= 0 || high <= 0 {
+		return KarlinAltschul{}, errors.New("align: scoring system must have positive and negative scores")
+	}
+	low /= d
+	high /= d
+
+	// Calculate the probability of each score.
+	prob := make([]float64, high-low+1)
+	for i, fr := range rFreqs {
+		for j, fq := range qFreqs {
+			if fr == 0 || fq == 0 {
+				continue
+			}
+			prob[m[i][j]/d-low] += fr / rSum * fq / qSum
+		}
+	}
+	var mean float64
+	for i, p := range prob {
+		mean += float64(i+low) * p
+	}
+	if mean >= 0 {
+		return KarlinAltschul{}, errors.New("align: scoring system must have a negative expected score")
+	}
+
+	lambda := karlinLambda(prob, low)
+	var h float64
+	for i, p := range prob {
+		s := float64(i + low)
+		h += s * p * math.Exp(lambda*s)
+	}
+	k := karlinK(prob, low, lambda, h)
+
+	// Scale lambda back to the units of the scoring matrix.
+	return KarlinAltschul{
+		Lambda: lambda / float64(d),
+		K:      k,
+		H:      lambda * h,
+	}, nil
+}
+
+// karlinLambda returns the unique positive solution for λ of sum_s prob[s]·exp(λs) = 1
+// where s ranges from low.
+func karlinLambda(prob []float64, low int) float64 {
+	f := func(lambda float64) (v, dv float64) {
+		for i, p := range prob {
+			s := float64(i + low)
+			e := p * math.Exp(lambda*s)
+			v += e
+			dv += s * e
+		}
+		return v - 1, dv
+	}
+
+	// The function is convex with a negative gradient
+	// at zero, so Newton's method converges monotonically
+	// from any point to the right of the positive root.
+	lambda := 0.5
+	for v, _ := f(lambda); v <= 0; v, _ = f(lambda) {
+		lambda *= 2
+	}
+	for i := 0; i < 100; i++ {
+		v, dv := f(lambda)
+		next := lambda - v/dv
+		if math.Abs(next-lambda) < 1e-12*lambda {
+			return next
+		}
+		lambda = next
+	}
+	return lambda
+}
+
+// karlinK returns the Karlin-Altschul K parameter for the score probabilities prob with
+// scores ranging from low, the scale parameter lambda and h, the expected value of
+// s·exp(λs). The scores described by prob must have a greatest common divisor of one.
+func karlinK(prob []float64, low int, lambda, h float64) float64 {
+	// σ = sum_k 1/k (E[exp(λS_k); S_k < 0] + P(S_k >= 0))
+	// where S_k is the sum of k independent scores.
+	var (
+		sigma float64
+		dist  = []float64{1}
+		dLow  = 0
+	)
+	for k := 1; k <= karlinIterLimit; k++ {
+		next := make([]float64, len(dist)+len(prob)-1)
+		for i, p := range dist {
+			if p == 0 {
+				continue
+			}
+			for j, q := range prob {
+				next[i+j] += p * q
+			}
+		}
+		dist = next
+		dLow += low
+
+		var inner float64
+		for i, p := range dist {
+			if s := i + dLow; s < 0 {
+				inner += p * math.Exp(lambda*float64(s))
+			} else {
+				inner += p
+			}
+		}
+		inner /= float64(k)
+		sigma += inner
+		if inner < karlinSumLimit {
+			break
+		}
+	}
+	return math.Exp(-2*sigma) / (h * -math.Expm1(-lambda))
+}
+
+// BitScore returns the normalised bit score corresponding to the raw score.
+func (p KarlinAltschul) BitScore(score int) float64 {
+	return (p.Lambda*float64(score) - math.Log(p.K)) / math.Ln2
+}
+
+// EValue returns the expected number of local alignments with at least the given raw score
+// in a search of sequences with m and n letters. Effective lengths corrected for edge
+// effects may be used for m and n.
+func (p KarlinAltschul) EValue(score, m, n int) float64 {
+	return p.K * float64(m) * float64(n) * math.Exp(-p.Lambda*float64(score))
+}
+
+func sum(f []float64) float64 {
+	var s float64
+	for _, v := range f {
+		s += v
+	}
+	return s
+}
+
+func gcd(a, b int) int {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
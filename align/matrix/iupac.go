@@ -0,0 +1,61 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"math"
+)
+
+// IUPAC returns a scoring matrix for alphabet.DNAredundant derived from m, a scoring
+// matrix organised for lookup using alphabet.DNAgapped. The score for a pair of letters
+// is the mean of the scores in m for the pairs of concrete bases represented by the
+// letters, rounded to the nearest integer, and the score for a letter against a gap is
+// the mean of the gap scores of its concrete bases. If neutralN is true, the score for
+// an N against any letter other than a gap is zero.
+func IUPAC(m [][]int, neutralN bool) [][]int {
+	var (
+		a   = alphabet.DNAredundant
+		b   = alphabet.DNAgapped
+		ind = b.LetterIndex()
+		l   = a.Len()
+	)
+	// expand returns the indices of the concrete bases
+	// for the letter at index i of alphabet.DNAredundant.
+	expand := func(i int) []int {
+		if i == 0 {
+			return []int{0}
+		}
+		bases := alphabet.Expand(a.Letter(i))
+		idx := make([]int, len(bases))
+		for k, l := range bases {
+			idx[k] = ind[l]
+		}
+		return idx
+	}
+
+	x := make([][]int, l)
+	for i := range x {
+		x[i] = make([]int, l)
+		for j := range x[i] {
+			if neutralN && i != 0 && j != 0 && (a.Letter(i) == 'n' || a.Letter(j) == 'n') {
+				continue
+			}
+			var (
+				sum float64
+				n   int
+			)
+			for _, p := range expand(i) {
+				for _, q := range expand(j) {
+					sum += float64(m[p][q])
+					n++
+				}
+			}
+			x[i][j] = int(math.Floor(sum/float64(n) + 0.5))
+		}
+	}
+	return x
+}
@@ -0,0 +1,39 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestIUPAC(c *check.C) {
+	base := Match(alphabet.DNAgapped, -2, 5, -4)
+	for _, neutralN := range []bool{false, true} {
+		m := IUPAC(base, neutralN)
+		c.Assert(len(m), check.Equals, alphabet.DNAredundant.Len())
+		idx := alphabet.DNAredundant.LetterIndex()
+		score := func(a, b byte) int { return m[idx[a]][idx[b]] }
+
+		for _, l := range "acgt" {
+			c.Check(score(byte(l), byte(l)), check.Equals, 5)
+			c.Check(score(byte(l), '-'), check.Equals, -2)
+		}
+		c.Check(score('a', 'c'), check.Equals, -4)
+		c.Check(score('a', 'r'), check.Equals, 1)  // (5-4)/2
+		c.Check(score('r', 'a'), check.Equals, 1)  // (5-4)/2
+		c.Check(score('r', 'y'), check.Equals, -4) // No shared bases.
+		c.Check(score('b', 'v'), check.Equals, -2) // (2*5-7*4)/9
+		c.Check(score('n', '-'), check.Equals, -2)
+		if neutralN {
+			c.Check(score('n', 'a'), check.Equals, 0)
+			c.Check(score('n', 'n'), check.Equals, 0)
+		} else {
+			c.Check(score('n', 'a'), check.Equals, -2) // (5-3*4)/4
+			c.Check(score('n', 'n'), check.Equals, -2)
+		}
+	}
+}
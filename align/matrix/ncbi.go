@@ -0,0 +1,86 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Read reads an NCBI format scoring matrix from r and returns it organised to allow
+// direct lookup using the alphabet a, in the same way as the matrices provided by the
+// package. Lines beginning with '#' are comments. The first non-comment line lists the
+// column letters and each following line holds a row letter and the scores for that
+// row. Letters that are not valid in a are ignored and scores for letters of a that
+// are not described by the matrix, including the gap, are zero.
+func Read(r io.Reader, a alphabet.Alphabet) ([][]int, error) {
+	var (
+		ind  = a.LetterIndex()
+		cols []int
+		m    [][]int
+		seen = make([]bool, a.Len())
+	)
+
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		l := strings.TrimSpace(sc.Text())
+		if len(l) == 0 || l[0] == '#' {
+			continue
+		}
+		f := strings.Fields(l)
+		if cols == nil {
+			cols = make([]int, len(f))
+			for i, c := range f {
+				if len(c) != 1 {
+					return nil, fmt.Errorf("matrix: invalid column letter %q at line %d", c, line)
+				}
+				cols[i] = ind[c[0]]
+			}
+			m = make([][]int, a.Len())
+			for i := range m {
+				m[i] = make([]int, a.Len())
+			}
+			continue
+		}
+
+		if len(f[0]) != 1 {
+			return nil, fmt.Errorf("matrix: invalid row letter %q at line %d", f[0], line)
+		}
+		if len(f)-1 != len(cols) {
+			return nil, fmt.Errorf("matrix: row length mismatch at line %d: %d != %d", line, len(f)-1, len(cols))
+		}
+		row := ind[f[0][0]]
+		if row >= 0 {
+			if seen[row] {
+				return nil, fmt.Errorf("matrix: duplicate row %q at line %d", f[0], line)
+			}
+			seen[row] = true
+		}
+		for i, s := range f[1:] {
+			v, err := strconv.Atoi(s)
+			if err != nil {
+				return nil, fmt.Errorf("matrix: invalid score %q at line %d", s, line)
+			}
+			if row < 0 || cols[i] < 0 {
+				continue
+			}
+			m[row][cols[i]] = v
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if cols == nil {
+		return nil, errors.New("matrix: no matrix data")
+	}
+
+	return m, nil
+}
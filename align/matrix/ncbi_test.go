@@ -0,0 +1,62 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestRead(c *check.C) {
+	for _, t := range []struct {
+		file  string
+		alpha alphabet.Alphabet
+		want  [][]int
+	}{
+		{"NUC.4.4", alphabet.DNAredundant, NUC_4_4},
+		{"BLOSUM45", alphabet.Protein, BLOSUM45},
+		{"BLOSUM62", alphabet.Protein, BLOSUM62},
+		{"BLOSUM80", alphabet.Protein, BLOSUM80},
+		{"PAM30", alphabet.Protein, PAM30},
+		{"PAM70", alphabet.Protein, PAM70},
+		{"PAM250", alphabet.Protein, PAM250},
+	} {
+		f, err := os.Open(filepath.Join("matrices", t.file))
+		c.Assert(err, check.Equals, nil)
+		m, err := Read(f, t.alpha)
+		f.Close()
+		c.Check(err, check.Equals, nil, check.Commentf("Test: %s", t.file))
+		c.Check(m, check.DeepEquals, t.want, check.Commentf("Test: %s", t.file))
+	}
+}
+
+func (s *S) TestReadErrors(c *check.C) {
+	for _, t := range []struct {
+		in  string
+		err string
+	}{
+		{"# only comments\n", "matrix: no matrix data"},
+		{"  A  C\nA 1 -1\nC -1\n", "matrix: row length mismatch at line 3: 1 != 2"},
+		{"  A  C\nA 1 x\n", `matrix: invalid score "x" at line 2`},
+		{"  A  C\nA 1 -1\nA 1 -1\n", `matrix: duplicate row "A" at line 3`},
+		{"  AC\nA 1\n", `matrix: invalid column letter "AC" at line 1`},
+	} {
+		_, err := Read(strings.NewReader(t.in), alphabet.DNA)
+		c.Check(err, check.ErrorMatches, t.err)
+	}
+}
@@ -81,3 +81,74 @@ func ExampleNWAffine_Align() {
 	// ATAGGAA--G
 	// ATTGGCAATG
 }
+
+func ExampleNWBanded_Align() {
+	nwsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("AGACTAGTTA"))}
+	nwsa.Alpha = alphabet.DNAgapped
+	nwsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GACAGACG"))}
+	nwsb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-5	-5	-5	-5
+	// A	-5	10	-3	-1	-4
+	// C	-5	-3	 9	-5	 0
+	// G	-5	-1	-5	 7	-3
+	// T	-5	-4	 0	-3	 8
+	//
+	// Band width: 1
+	needle := NWBanded{
+		Matrix: Linear{
+			{0, -5, -5, -5, -5},
+			{-5, 10, -3, -1, -4},
+			{-5, -3, 9, -5, 0},
+			{-5, -1, -5, 7, -3},
+			{-5, -4, 0, -3, 8},
+		},
+		Width: 1,
+	}
+
+	aln, err := needle.Align(nwsa, nwsb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(nwsa, nwsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	//[[0,1)/-=-5 [1,4)/[0,3)=26 [4,5)/-=-5 [5,10)/[3,8)=12]
+	// AGACTAGTTA
+	// -GAC-AGACG
+}
+
+func ExampleHirschberg_Align() {
+	nwsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("AGACTAGTTA"))}
+	nwsa.Alpha = alphabet.DNAgapped
+	nwsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GACAGACG"))}
+	nwsb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-5	-5	-5	-5
+	// A	-5	10	-3	-1	-4
+	// C	-5	-3	 9	-5	 0
+	// G	-5	-1	-5	 7	-3
+	// T	-5	-4	 0	-3	 8
+	needle := Hirschberg{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+
+	aln, err := needle.Align(nwsa, nwsb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(nwsa, nwsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	//[[0,1)/-=-5 [1,4)/[0,3)=26 [4,5)/-=-5 [5,10)/[3,8)=12]
+	// AGACTAGTTA
+	// -GAC-AGACG
+}
@@ -11,7 +11,6 @@ import (
 
 	"errors"
 	"sort"
-	"sync"
 )
 
 // A Params holds dynamic programming alignment parameters.
@@ -54,39 +53,63 @@ func NewAligner(target, query *linear.Seq, k, minLength int, minId float64) *Ali
 // Align pairs of sequence segments defined by trapezoids.
 // Returns aligning segment pairs satisfying length and identity requirements.
 func (a *Aligner) AlignTraps(trapezoids filter.Trapezoids) Hits {
-	covered := make([]bool, len(trapezoids))
+	var segs Hits
+	dp := a.newKernel(trapezoids, func(h Hit) { segs = append(segs, h) })
+	for i, t := range trapezoids {
+		if !dp.covered[i] && t.Top-t.Bottom >= a.k {
+			dp.slot = i
+			dp.alignRecursion(t)
+		}
+	}
+
+	return removeRedundant(segs)
+}
+
+// AlignTrapsFunc aligns pairs of sequence segments defined by trapezoids, calling fn with
+// each aligning segment pair satisfying length and identity requirements when alignment of
+// the trapezoid it was found in is complete. Only the segment pairs of a single trapezoid
+// are held in memory, so lower scoring segments are removed only if they begin or end at the
+// same point as a higher scoring segment found from the same trapezoid. If fn returns a
+// non-nil error, AlignTrapsFunc stops and returns the error.
+func (a *Aligner) AlignTrapsFunc(trapezoids filter.Trapezoids, fn func(Hit) error) error {
+	var segs Hits
+	dp := a.newKernel(trapezoids, func(h Hit) { segs = append(segs, h) })
+	for i, t := range trapezoids {
+		if !dp.covered[i] && t.Top-t.Bottom >= a.k {
+			segs = segs[:0]
+			dp.slot = i
+			dp.alignRecursion(t)
+			for _, h := range removeRedundant(segs) {
+				if err := fn(h); err != nil {
+					return err
+				}
+			}
+		}
+	}
 
-	dp := &kernel{
+	return nil
+}
+
+// newKernel returns a kernel for alignment of the segments defined by trapezoids,
+// passing each found segment pair to emit.
+func (a *Aligner) newKernel(trapezoids filter.Trapezoids, emit func(Hit)) *kernel {
+	return &kernel{
 		target:      a.target,
 		query:       a.query,
 		valueToCode: a.target.Alpha.LetterIndex(),
 		trapezoids:  trapezoids,
-		covered:     covered,
+		covered:     make([]bool, len(trapezoids)),
 		minLen:      a.minHitLength,
 		maxDiff:     1 - a.minId,
 
 		Costs: *a.Costs,
 
-		result: make(chan Hit),
+		emit: emit,
 	}
-	wg := &sync.WaitGroup{}
-	wg.Add(1)
-	var segs Hits
-	go func() {
-		defer wg.Done()
-		for h := range dp.result {
-			segs = append(segs, h)
-		}
-	}()
-	for i, t := range trapezoids {
-		if !dp.covered[i] && t.Top-t.Bottom >= a.k {
-			dp.slot = i
-			dp.alignRecursion(t)
-		}
-	}
-	close(dp.result)
-	wg.Wait()
+}
 
+// removeRedundant filters segs in place, returning the retained segments.
+func removeRedundant(segs Hits) Hits {
 	/* Remove lower scoring segments that begin or end at
 	   the same point as a higher scoring segment.       */
 
@@ -5,6 +5,7 @@
 package dp
 
 import (
+	"errors"
 	"testing"
 
 	"gopkg.in/check.v1"
@@ -97,3 +98,45 @@ func (s *S) TestAlignment(c *check.C) {
 		c.Logf("%s\n%s\n", fa[0], fa[1])
 	}
 }
+
+func (s *S) TestAlignTrapsFunc(c *check.C) {
+	l := [...]byte{'A', 'C', 'G', 'T'}
+	Q := len(l)
+	a := &linear.Seq{Seq: make(alphabet.Letters, 0, util.Pow(Q, k))}
+	a.Alpha = alphabet.DNA
+	for _, i := range util.DeBruijn(byte(Q), k) {
+		a.Seq = append(a.Seq, alphabet.Letter(l[i]))
+	}
+	b := &linear.Seq{Seq: make(alphabet.Letters, 0, util.Pow(Q, k-1))}
+	b.Alpha = alphabet.DNA
+	for _, i := range util.DeBruijn(byte(Q), k-1) {
+		b.Seq = append(b.Seq, alphabet.Letter(l[i]))
+	}
+	aligner := NewAligner(a, b, int(k), 50, 0.80)
+	aligner.Costs = &Costs{
+		MaxIGap:    maxIGap,
+		DiffCost:   diffCost,
+		SameCost:   sameCost,
+		MatchCost:  matchCost,
+		BlockCost:  blockCost,
+		RMatchCost: rMatchCost,
+	}
+	got := make(map[Hit]bool)
+	err := aligner.AlignTrapsFunc(T, func(h Hit) error {
+		got[h] = true
+		return nil
+	})
+	c.Check(err, check.Equals, nil)
+	for _, h := range H {
+		c.Check(got[h], check.Equals, true, check.Commentf("missing %+v", h))
+	}
+
+	stop := errors.New("stop")
+	var n int
+	err = aligner.AlignTrapsFunc(T, func(h Hit) error {
+		n++
+		return stop
+	})
+	c.Check(err, check.Equals, stop)
+	c.Check(n, check.Equals, 1)
+}
@@ -27,7 +27,7 @@ type kernel struct {
 	trapezoids []filter.Trapezoid
 	covered    []bool
 	slot       int
-	result     chan Hit
+	emit       func(Hit)
 }
 
 // An offset slice seems to be the easiest way to implement the C idiom used in PALS to implement
@@ -116,7 +116,7 @@ func (k *kernel) alignRecursion(t filter.Trapezoid) {
 			// Diagonals to this point are query-target, not target-query.
 			k.highEnd.LowDiagonal, k.highEnd.HighDiagonal = -k.highEnd.HighDiagonal, -k.highEnd.LowDiagonal
 
-			k.result <- k.highEnd
+			k.emit(k.highEnd)
 		}
 	}
 
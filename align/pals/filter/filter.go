@@ -20,12 +20,30 @@ func MinWordsPerFilterHit(hitLength, wordLength, maxErrors int) int {
 	return hitLength + 1 - wordLength*(maxErrors+1)
 }
 
+// Minimum number of shared (w,k)-minimizers guaranteed in a hit, obtained by extending Ukonnen's
+// Lemma to minimizer sampling: each error free segment of length l of a hit holds at least
+// ⌊(l-k+1)/w⌋ shared minimizers. When w is 1 this is equal to MinWordsPerFilterHit.
+func MinMinimizersPerFilterHit(hitLength, wordLength, window, maxErrors int) int {
+	n := hitLength - maxErrors - (maxErrors+1)*(wordLength+window-2)
+	if n <= 0 {
+		return n
+	}
+	return (n + window - 1) / window
+}
+
 // Type for passing filter parameters.
 type Params struct {
 	WordSize   int
 	MinMatch   int
 	MaxError   int
 	TubeOffset int
+
+	// Window is the number of consecutive words from
+	// which a minimizer is chosen. If Window is greater
+	// than 1, only minimizers are used for seeding and
+	// the index must have been built with
+	// kmerindex.(*Index).BuildMinimizers(Window).
+	Window int
 }
 
 // Filter implements a q-gram filter similar to that described in Rassmussen 2005.
@@ -41,6 +59,7 @@ type Filter struct {
 	maxKmerDist    int
 	minKmersPerHit int
 	tubeOffset     int
+	window         int
 	selfAlign      bool
 	complement     bool
 }
@@ -54,6 +73,7 @@ func New(ki *kmerindex.Index, params *Params) (f *Filter) {
 		minMatch:   params.MinMatch,
 		maxError:   params.MaxError,
 		tubeOffset: params.TubeOffset,
+		window:     params.Window,
 	}
 
 	return
@@ -69,7 +89,11 @@ func (f *Filter) Filter(query *linear.Seq, selfAlign, complement bool, morass *m
 	f.k = f.ki.K()
 
 	// Ukonnen's Lemma
-	f.minKmersPerHit = MinWordsPerFilterHit(f.minMatch, f.k, f.maxError)
+	if f.window > 1 {
+		f.minKmersPerHit = MinMinimizersPerFilterHit(f.minMatch, f.k, f.window, f.maxError)
+	} else {
+		f.minKmersPerHit = MinWordsPerFilterHit(f.minMatch, f.k, f.maxError)
+	}
 
 	// Maximum distance between SeqQ positions of two k-mers in a match
 	// (More stringent bounds may be possible, but not a big problem
@@ -85,11 +109,7 @@ func (f *Filter) Filter(query *linear.Seq, selfAlign, complement bool, morass *m
 	maxActiveTubes := (f.target.Len()+tubeWidth-1)/f.tubeOffset + 1
 	f.tubes = make([]tubeState, maxActiveTubes)
 
-	// Ticker tracks cycling of circular list of active tubes.
-	ticker := tubeWidth
-
-	var err error
-	err = f.ki.ForEachKmerOf(query, 0, query.Len(), func(ki *kmerindex.Index, position, kmer int) {
+	hits := func(ki *kmerindex.Index, position, kmer int) {
 		from := 0
 		if kmer > 0 {
 			from = ki.FingerAt(kmer - 1)
@@ -98,14 +118,42 @@ func (f *Filter) Filter(query *linear.Seq, selfAlign, complement bool, morass *m
 		for i := from; i < to; i++ {
 			f.commonKmer(ki.PosAt(i), position)
 		}
+	}
 
-		if ticker--; ticker == 0 {
-			if e := f.tubeEnd(position); e != nil {
-				panic(e) // Caught by fastkmerindex.ForEachKmerOf and returned
+	var err error
+	if f.window > 1 {
+		// Minimizers do not visit every query position, so tube
+		// ends are tracked by position rather than by count.
+		next := tubeWidth - 1
+		err = f.ki.ForEachMinimizerOf(query, 0, query.Len(), f.window, func(ki *kmerindex.Index, position, kmer int) {
+			for ; next < position; next += f.tubeOffset {
+				if e := f.tubeEnd(next); e != nil {
+					panic(e) // Caught by fastkmerindex.ForEachKmerOf and returned
+				}
 			}
-			ticker = f.tubeOffset
-		}
-	})
+			hits(ki, position, kmer)
+			if next == position {
+				if e := f.tubeEnd(position); e != nil {
+					panic(e) // Caught by fastkmerindex.ForEachKmerOf and returned
+				}
+				next += f.tubeOffset
+			}
+		})
+	} else {
+		// Ticker tracks cycling of circular list of active tubes.
+		ticker := tubeWidth
+
+		err = f.ki.ForEachKmerOf(query, 0, query.Len(), func(ki *kmerindex.Index, position, kmer int) {
+			hits(ki, position, kmer)
+
+			if ticker--; ticker == 0 {
+				if e := f.tubeEnd(position); e != nil {
+					panic(e) // Caught by fastkmerindex.ForEachKmerOf and returned
+				}
+				ticker = f.tubeOffset
+			}
+		})
+	}
 	if err != nil {
 		return err
 	}
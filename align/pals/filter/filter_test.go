@@ -5,7 +5,9 @@
 package filter
 
 import (
+	"math/rand"
 	"sort"
+	"strings"
 	"testing"
 
 	"gopkg.in/check.v1"
@@ -106,3 +108,59 @@ type hits []Hit
 func (h hits) Len() int           { return len(h) }
 func (h hits) Less(i, j int) bool { return h[i].Less(h[j]) }
 func (h hits) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (s *S) TestMinMinimizersPerFilterHit(c *check.C) {
+	for n := 20; n < 200; n += 10 {
+		for k := 4; k < 12; k++ {
+			for e := 0; e < 5; e++ {
+				c.Check(MinMinimizersPerFilterHit(n, k, 1, e), check.Equals, MinWordsPerFilterHit(n, k, e))
+			}
+		}
+	}
+}
+
+func (s *S) TestFilterMinimizers(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	l := [...]byte{'A', 'C', 'G', 'T'}
+	a := &linear.Seq{Seq: make(alphabet.Letters, 5000)}
+	a.Alpha = alphabet.DNA
+	for i := range a.Seq {
+		a.Seq[i] = alphabet.Letter(l[rnd.Intn(len(l))])
+	}
+	b := &linear.Seq{Seq: append(alphabet.Letters(nil), a.Seq[2000:2600]...)}
+	b.Alpha = alphabet.DNA
+	for _, p := range []int{100, 250, 400} {
+		b.Seq[p] = alphabet.Letter(l[(strings.IndexByte("ACGT", byte(b.Seq[p]))+1)%len(l)])
+	}
+
+	const w = 8
+	i, err := kmerindex.New(int(k), a)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(i.BuildMinimizers(w), check.Equals, nil)
+	p := &Params{WordSize: int(k), MinMatch: 100, MaxError: 2, TubeOffset: 32, Window: w}
+	c.Assert(MinMinimizersPerFilterHit(p.MinMatch, p.WordSize, p.Window, p.MaxError) > 0, check.Equals, true)
+	f := New(i, p)
+	sorter, err := morass.New(Hit{}, "", "", 2<<20, false)
+	c.Assert(err, check.Equals, nil)
+	defer sorter.CleanUp()
+	c.Assert(f.Filter(b, false, false, sorter), check.Equals, nil)
+
+	var (
+		h        Hit
+		from, to = b.Len(), 0
+	)
+	for sorter.Pull(&h) == nil {
+		// The match lies on the target-query diagonal 2000.
+		if h.Diagonal < 2000-p.MaxError || h.Diagonal > 2000+p.TubeOffset+p.MaxError {
+			continue
+		}
+		if h.From < from {
+			from = h.From
+		}
+		if h.To > to {
+			to = h.To
+		}
+	}
+	c.Check(from < p.MinMatch, check.Equals, true)
+	c.Check(to > b.Len()-p.MinMatch, check.Equals, true)
+}
@@ -0,0 +1,42 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pals
+
+import (
+	"github.com/biogo/biogo/align/pals/dp"
+)
+
+// A HitFilter returns whether a hit should be retained.
+type HitFilter func(dp.Hit) bool
+
+// MinHitLength returns a HitFilter that retains hits with target and query segments of at
+// least n bases.
+func MinHitLength(n int) HitFilter {
+	return func(h dp.Hit) bool {
+		return h.Aepos-h.Abpos >= n && h.Bepos-h.Bbpos >= n
+	}
+}
+
+// MinHitIdentity returns a HitFilter that retains hits with an identity, one minus the hit's
+// lower bound on error rate, of at least id.
+func MinHitIdentity(id float64) HitFilter {
+	return func(h dp.Hit) bool {
+		return 1-h.Error >= id
+	}
+}
+
+// ExcludeTandem returns a HitFilter that removes hits between segments of the same strand
+// of a sequence that overlap or are separated by fewer than minGap bases. These hits arise
+// from tandem repeats. ExcludeTandem is only meaningful for self comparisons of the forward
+// strand.
+func ExcludeTandem(minGap int) HitFilter {
+	return func(h dp.Hit) bool {
+		gap := h.Bbpos - h.Aepos
+		if h.Abpos > h.Bbpos {
+			gap = h.Abpos - h.Bepos
+		}
+		return gap >= minGap
+	}
+}
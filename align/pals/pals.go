@@ -65,6 +65,12 @@ type PALS struct {
 	DPParams      *dp.Params
 	dp.Costs
 
+	// MinimizerWindow specifies the minimizer window used
+	// for seeding by Optimise. If MinimizerWindow is greater
+	// than 1, the index holds only (w,k)-minimizers, reducing
+	// its size and the number of word lookups during filtering.
+	MinimizerWindow int
+
 	log        Logger
 	timer      *util.Timer
 	tubeOffset int
@@ -104,7 +110,7 @@ func (p *PALS) Optimise(minHitLen int, minId float64) error {
 		p.log.Print("Optimising filter parameters")
 	}
 
-	filterParams := &filter.Params{}
+	filterParams := &filter.Params{Window: p.MinimizerWindow}
 
 	// Lower bound on word length k by requiring manageable index.
 	// Given kmer occurs once every 4^k positions.
@@ -150,7 +156,11 @@ func (p *PALS) Optimise(minHitLen int, minId float64) error {
 				continue
 			}
 
-			minWords = filter.MinWordsPerFilterHit(seedLength, wordSize, seedDiffs)
+			if filterParams.Window > 1 {
+				minWords = filter.MinMinimizersPerFilterHit(seedLength, wordSize, filterParams.Window, seedDiffs)
+			} else {
+				minWords = filter.MinWordsPerFilterHit(seedLength, wordSize, seedDiffs)
+			}
 			if minWords <= 0 {
 				if p.log != nil {
 					p.log.Printf("Parameters n=%d k=%d e=%d, B=%d\n",
@@ -210,7 +220,16 @@ func (p *PALS) Optimise(minHitLen int, minId float64) error {
 // Return an estimate of the average number of hits for any given kmer.
 func (p *PALS) AvgIndexListLength(filterParams *filter.Params) float64 {
 	d := int(1) << (uint(filterParams.WordSize) * 2)
-	return float64(p.target.Len()) / float64(d)
+	return float64(p.indexedLen(filterParams)) / float64(d)
+}
+
+// Return an estimate of the number of target positions held by the index. The
+// expected density of (w,k)-minimizers in a random sequence is 2/(w+1).
+func (p *PALS) indexedLen(filterParams *filter.Params) int {
+	if filterParams.Window > 1 {
+		return 2 * p.target.Len() / (filterParams.Window + 1)
+	}
+	return p.target.Len()
 }
 
 // Return an estimate of the amount of memory required for the filter.
@@ -220,7 +239,7 @@ func (p *PALS) filterMemRequired(filterParams *filter.Params) uintptr {
 	maxActiveTubes := (p.target.Len()+tubeWidth-1)/filterParams.TubeOffset + 1
 	tubes := uintptr(maxActiveTubes) * unsafe.Sizeof(tubeState{})
 	finger := unsafe.Sizeof(uint32(0)) * uintptr(words)
-	pos := unsafe.Sizeof(0) * uintptr(p.target.Len())
+	pos := unsafe.Sizeof(0) * uintptr(p.indexedLen(filterParams))
 
 	return finger + pos + tubes
 }
@@ -251,7 +270,14 @@ func (p *PALS) BuildIndex() error {
 	if err != nil {
 		return err
 	} else {
-		ki.Build()
+		if p.FilterParams.Window > 1 {
+			err = ki.BuildMinimizers(p.FilterParams.Window)
+			if err != nil {
+				return err
+			}
+		} else {
+			ki.Build()
+		}
 		p.notify("Indexed")
 	}
 	p.index = ki
@@ -270,6 +296,65 @@ func (p *PALS) Share(m *PALS) {
 
 // Align performs filtering and alignment for one strand of query.
 func (p *PALS) Align(complement bool) (dp.Hits, error) {
+	working, err := p.filterAndMerge(complement)
+	if err != nil {
+		return nil, err
+	}
+
+	p.notify("Aligning")
+	aligner := dp.NewAligner(
+		p.target, working,
+		p.FilterParams.WordSize, p.DPParams.MinHitLength, p.DPParams.MinId,
+	)
+	aligner.Costs = &p.Costs
+	hits := aligner.AlignTraps(p.trapezoids)
+	hitCoverageA, hitCoverageB, err := hits.Sum()
+	if err != nil {
+		return nil, err
+	}
+	p.notifyf("Aligned %d hits covering %d x %d", len(hits), hitCoverageA, hitCoverageB)
+
+	return hits, nil
+}
+
+// AlignFunc performs filtering and alignment for one strand of query, calling fn with each
+// hit that is retained by all of the provided hit filters as alignment proceeds. Hits are not
+// accumulated, so hits are made non-redundant only within each merged filter trapezoid as
+// described for dp.(*Aligner).AlignTrapsFunc. If fn returns a non-nil error, AlignFunc stops
+// and returns the error.
+func (p *PALS) AlignFunc(complement bool, fn func(dp.Hit) error, filters ...HitFilter) error {
+	working, err := p.filterAndMerge(complement)
+	if err != nil {
+		return err
+	}
+
+	p.notify("Aligning")
+	aligner := dp.NewAligner(
+		p.target, working,
+		p.FilterParams.WordSize, p.DPParams.MinHitLength, p.DPParams.MinId,
+	)
+	aligner.Costs = &p.Costs
+	var n int
+	err = aligner.AlignTrapsFunc(p.trapezoids, func(h dp.Hit) error {
+		for _, keep := range filters {
+			if !keep(h) {
+				return nil
+			}
+		}
+		n++
+		return fn(h)
+	})
+	if err != nil {
+		return err
+	}
+	p.notifyf("Aligned %d hits", n)
+
+	return nil
+}
+
+// filterAndMerge performs filtering and filter hit merging for one strand of query,
+// returning the query strand used.
+func (p *PALS) filterAndMerge(complement bool) (*linear.Seq, error) {
 	if p.err != nil {
 		return nil, p.err
 	}
@@ -310,20 +395,7 @@ func (p *PALS) Align(complement bool) (dp.Hits, error) {
 	lt, lq := p.trapezoids.Sum()
 	p.notifyf("Merged %d trapezoids covering %d x %d", len(p.trapezoids), lt, lq)
 
-	p.notify("Aligning")
-	aligner := dp.NewAligner(
-		p.target, working,
-		p.FilterParams.WordSize, p.DPParams.MinHitLength, p.DPParams.MinId,
-	)
-	aligner.Costs = &p.Costs
-	hits := aligner.AlignTraps(p.trapezoids)
-	hitCoverageA, hitCoverageB, err := hits.Sum()
-	if err != nil {
-		return nil, err
-	}
-	p.notifyf("Aligned %d hits covering %d x %d", len(hits), hitCoverageA, hitCoverageB)
-
-	return hits, nil
+	return working, nil
 }
 
 // Trapezoids returns the filter trapezoids identified during a call to Align.
@@ -8,6 +8,7 @@ import (
 	"github.com/biogo/biogo/align/pals/dp"
 	"github.com/biogo/biogo/align/pals/filter"
 	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/morass"
 	"github.com/biogo/biogo/seq"
 	"github.com/biogo/biogo/seq/linear"
 	"github.com/biogo/biogo/util"
@@ -15,6 +16,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"math/rand"
 	"testing"
 
 	"gopkg.in/check.v1"
@@ -185,3 +187,160 @@ deBruijn5	pals	hit	1	904	0.0000	.	.	Target deBruijn5 1 904; maxe 0
 deBruijn8	pals	hit	1025	4095	0.0000	.	.	Target deBruijn8 1025 4095; maxe 0
 `)
 }
+
+func (s *S) TestOptimiseMinimizers(c *check.C) {
+	t := &linear.Seq{Seq: make(alphabet.Letters, 29940)}
+	for _, p := range P {
+		pa := New(t, t, true, nil, 0, nil, nil)
+		pa.MinimizerWindow = 5
+		err := pa.Optimise(p.l, p.id)
+		if err != nil {
+			continue
+		}
+		fp := pa.FilterParams
+		c.Check(fp.Window, check.Equals, 5)
+		c.Check(filter.MinMinimizersPerFilterHit(fp.MinMatch, fp.WordSize, fp.Window, fp.MaxError) > 0, check.Equals, true)
+		c.Check(pa.AvgIndexListLength(fp) <= MaxAvgIndexListLen, check.Equals, true)
+		full := *fp
+		full.Window = 0
+		c.Check(pa.MemRequired(fp) < pa.MemRequired(&full), check.Equals, true)
+	}
+}
+
+func (s *S) TestAlignMinimizers(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	t := &linear.Seq{Seq: make(alphabet.Letters, 20000)}
+	t.Alpha = alphabet.DNA
+	for i := range t.Seq {
+		t.Seq[i] = alphabet.Letter(l[rnd.Intn(Q)])
+	}
+	// Plant an approximate repeat of 1000 bases.
+	copy(t.Seq[15000:16000], t.Seq[3000:4000])
+	for _, p := range []int{15100, 15400, 15700} {
+		t.Seq[p] = alphabet.Letter(l[(bytes.IndexByte(l[:], byte(t.Seq[p]))+1)%Q])
+	}
+
+	for _, w := range []int{0, 6} {
+		m, err := morass.New(filter.Hit{}, "", "", 2<<20, false)
+		c.Assert(err, check.Equals, nil)
+		pa := New(t, t, true, m, 0, nil, nil)
+		pa.MinimizerWindow = w
+		c.Assert(pa.Optimise(400, 0.9), check.Equals, nil)
+		c.Assert(pa.BuildIndex(), check.Equals, nil)
+		hits, err := pa.Align(false)
+		c.Assert(err, check.Equals, nil)
+		var found bool
+		for _, h := range hits {
+			if h.Abpos <= 3050 && h.Aepos >= 3950 && h.Bbpos <= 15050 && h.Bepos >= 15950 {
+				found = true
+			}
+		}
+		c.Check(found, check.Equals, true, check.Commentf("window %d: %v", w, hits))
+		c.Check(pa.CleanUp(), check.Equals, nil)
+	}
+}
+
+func (s *S) TestAlignFunc(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	t := &linear.Seq{Seq: make(alphabet.Letters, 20000)}
+	t.Alpha = alphabet.DNA
+	for i := range t.Seq {
+		t.Seq[i] = alphabet.Letter(l[rnd.Intn(Q)])
+	}
+	// Plant an approximate repeat of 1000 bases and a tandem repeat.
+	copy(t.Seq[15000:16000], t.Seq[3000:4000])
+	for _, p := range []int{15100, 15400, 15700} {
+		t.Seq[p] = alphabet.Letter(l[(bytes.IndexByte(l[:], byte(t.Seq[p]))+1)%Q])
+	}
+	copy(t.Seq[8500:9000], t.Seq[8000:8500])
+
+	for _, test := range []struct {
+		filters []HitFilter
+		tandem  bool
+	}{
+		{tandem: true},
+		{filters: []HitFilter{MinHitLength(400), MinHitIdentity(0.9), ExcludeTandem(100)}},
+		{filters: []HitFilter{MinHitLength(2000)}},
+	} {
+		m, err := morass.New(filter.Hit{}, "", "", 2<<20, false)
+		c.Assert(err, check.Equals, nil)
+		pa := New(t, t, true, m, 0, nil, nil)
+		c.Assert(pa.Optimise(400, 0.9), check.Equals, nil)
+		c.Assert(pa.BuildIndex(), check.Equals, nil)
+		var repeat, tandem, n int
+		err = pa.AlignFunc(false, func(h dp.Hit) error {
+			n++
+			for _, f := range test.filters {
+				c.Check(f(h), check.Equals, true)
+			}
+			if h.Abpos <= 3050 && h.Aepos >= 3950 && h.Bbpos <= 15050 && h.Bepos >= 15950 {
+				repeat++
+			}
+			if h.Abpos < 8500 && h.Bbpos >= 8400 && h.Bbpos < 8600 {
+				tandem++
+			}
+			return nil
+		}, test.filters...)
+		c.Check(err, check.Equals, nil)
+		c.Check(pa.CleanUp(), check.Equals, nil)
+		if len(test.filters) == 1 {
+			c.Check(n, check.Equals, 0)
+			continue
+		}
+		c.Check(repeat, check.Equals, 1)
+		c.Check(tandem > 0, check.Equals, test.tandem)
+	}
+}
+
+func (s *S) TestChainerBlocks(c *check.C) {
+	pair := func(a, b int, strand seq.Strand) *Pair {
+		p := &Pair{
+			A:      &Feature{ID: "a", Loc: Contig("chr1"), From: a, To: a + 100},
+			B:      &Feature{ID: "b", Loc: Contig("chr2"), From: b, To: b + 100},
+			Score:  100,
+			Error:  0.1,
+			Strand: strand,
+		}
+		p.A.Pair, p.B.Pair = p, p
+		return p
+	}
+	pairs := []*Pair{
+		// Forward block.
+		pair(1000, 5000, seq.Plus),
+		pair(1200, 5150, seq.Plus),
+		pair(1500, 5500, seq.Plus),
+		pair(1700, 5800, seq.Plus),
+		// Out of order pair.
+		pair(1600, 4000, seq.Plus),
+		// Reverse block.
+		pair(8000, 20000, seq.Minus),
+		pair(8300, 19700, seq.Minus),
+		pair(8500, 19500, seq.Minus),
+		// Distant pair.
+		pair(50000, 90000, seq.Plus),
+	}
+	blocks := Chainer{MaxGap: 500, GapCost: 0.1, MinScore: 200, MinPairs: 2}.Blocks(pairs)
+	c.Assert(blocks, check.HasLen, 2)
+
+	c.Check(blocks[0].Chain, check.DeepEquals, pairs[:4])
+	c.Check(blocks[0].Score, floatApprox, 400-0.1*(100+250+200), 1e-9)
+	c.Check(blocks[0].Span.A.String(), check.Equals, "chr1[1000,1800)")
+	c.Check(blocks[0].Span.B.String(), check.Equals, "chr2[5000,5900)")
+	c.Check(blocks[0].Span.Score, check.Equals, 400)
+	c.Check(blocks[0].Span.Error, floatApprox, 0.1, 1e-9)
+	c.Check(blocks[0].Span.Strand, check.Equals, seq.Plus)
+
+	c.Check(blocks[1].Chain, check.DeepEquals, pairs[5:8])
+	c.Check(blocks[1].Span.A.String(), check.Equals, "chr1[8000,8600)")
+	c.Check(blocks[1].Span.B.String(), check.Equals, "chr2[19500,20100)")
+	c.Check(blocks[1].Span.Strand, check.Equals, seq.Minus)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, 2, 60, false)
+	for _, b := range blocks {
+		_, err := w.Write(b.Span)
+		c.Check(err, check.Equals, nil)
+	}
+	c.Check(buf.String(), check.Equals, "chr2\tpals\thit\t5001\t5900\t400.00\t+\t.\tTarget chr1 1001 1800; maxe 0.1\n"+
+		"chr2\tpals\thit\t19501\t20100\t300.00\t-\t.\tTarget chr1 8001 8600; maxe 0.1\n")
+}
@@ -0,0 +1,246 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pals
+
+import (
+	"github.com/biogo/biogo/seq"
+
+	"fmt"
+	"math"
+	"sort"
+)
+
+// A Chainer chains co-linear feature pairs into syntenic blocks using a dynamic programming
+// approach similar to that of DAGchainer:
+//  Computational identification of segmental duplications and syntenic regions.
+//   Brian J. Haas, Arthur L. Delcher, Jennifer R. Wortman and Steven L. Salzberg. Bioinformatics 20:3643–3646 (2004).
+type Chainer struct {
+	// MaxGap is the maximum distance between
+	// consecutive pairs of a block in either of
+	// the sequences.
+	MaxGap int
+
+	// GapCost is the cost per base of the larger
+	// of the distances between consecutive pairs
+	// of a block in the two sequences.
+	GapCost float64
+
+	// MinScore and MinPairs are the minimum
+	// score and number of pairs of a block.
+	MinScore float64
+	MinPairs int
+}
+
+// A Block is a syntenic block of co-linear feature pairs.
+type Block struct {
+	// Span is a feature pair spanning the block.
+	// The Score of Span is the sum of the scores
+	// of the chained pairs and its Error is the
+	// length weighted mean of their errors. Span
+	// may be written using a Writer.
+	Span *Pair
+
+	// Chain holds the chained pairs in order of
+	// their position in the A sequence.
+	Chain []*Pair
+
+	// Score is the score of the chain, the sum of
+	// the scores of the chained pairs less the
+	// gap costs.
+	Score float64
+}
+
+func (b *Block) String() string {
+	if b == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%v:%d", b.Span, len(b.Chain))
+}
+
+// chainKey groups pairs that may be chained.
+type chainKey struct {
+	a, b   string
+	strand seq.Strand
+}
+
+// Blocks returns the syntenic blocks found by chaining pairs. Pairs are chained only with
+// pairs relating the same A and B locations with the same strand relationship. For pairs
+// with opposite strands, the B features of a chain are in descending order. Blocks are
+// extracted greedily by descending score and each pair is included in at most one block.
+// The returned blocks are sorted by descending score.
+func (c Chainer) Blocks(pairs []*Pair) []*Block {
+	groups := make(map[chainKey][]*Pair)
+	for _, p := range pairs {
+		k := chainKey{a: p.A.Location().Name(), b: p.B.Location().Name(), strand: p.Strand}
+		groups[k] = append(groups[k], p)
+	}
+	keys := make(chainKeys, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Sort(keys)
+
+	var blocks []*Block
+	for _, k := range keys {
+		blocks = append(blocks, c.chain(groups[k])...)
+	}
+	sort.Stable(blocksByScore(blocks))
+
+	return blocks
+}
+
+// chain returns the blocks found in a group of pairs with the same locations and strand.
+func (c Chainer) chain(pairs []*Pair) []*Block {
+	sort.Sort(pairsByA(pairs))
+
+	var (
+		blocks []*Block
+		used   = make([]bool, len(pairs))
+		score  = make([]float64, len(pairs))
+		prev   = make([]int, len(pairs))
+	)
+	for {
+		best := -1
+		for j, pj := range pairs {
+			if used[j] {
+				continue
+			}
+			score[j], prev[j] = float64(pj.Score), -1
+			for i, pi := range pairs[:j] {
+				if used[i] {
+					continue
+				}
+				d, ok := c.gap(pi, pj)
+				if !ok {
+					continue
+				}
+				if s := score[i] + float64(pj.Score) - c.GapCost*float64(d); s > score[j] {
+					score[j], prev[j] = s, i
+				}
+			}
+			if best < 0 || score[j] > score[best] {
+				best = j
+			}
+		}
+		if best < 0 || score[best] < c.MinScore {
+			break
+		}
+
+		var chain []*Pair
+		for i := best; i >= 0; i = prev[i] {
+			chain = append(chain, pairs[i])
+			used[i] = true
+		}
+		if len(chain) < c.MinPairs {
+			continue
+		}
+		for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+			chain[i], chain[j] = chain[j], chain[i]
+		}
+		blocks = append(blocks, &Block{Span: span(chain), Chain: chain, Score: score[best]})
+	}
+
+	return blocks
+}
+
+// gap returns the larger of the distances between pairs a and b in the A and B sequences
+// and whether b may follow a in a chain.
+func (c Chainer) gap(a, b *Pair) (int, bool) {
+	if b.A.Start() <= a.A.Start() || b.A.End() <= a.A.End() {
+		return 0, false
+	}
+	dA := b.A.Start() - a.A.End()
+	var dB int
+	if a.Strand == seq.Minus {
+		if b.B.Start() >= a.B.Start() || b.B.End() >= a.B.End() {
+			return 0, false
+		}
+		dB = a.B.Start() - b.B.End()
+	} else {
+		if b.B.Start() <= a.B.Start() || b.B.End() <= a.B.End() {
+			return 0, false
+		}
+		dB = b.B.Start() - a.B.End()
+	}
+	d := dA
+	if dB > d {
+		d = dB
+	}
+	if d > c.MaxGap {
+		return 0, false
+	}
+	if d < 0 {
+		d = 0
+	}
+	return d, true
+}
+
+// span returns a Pair spanning the pairs in chain.
+func span(chain []*Pair) *Pair {
+	first := chain[0]
+	a := &Feature{Loc: first.A.Location(), From: math.MaxInt32, To: math.MinInt32}
+	b := &Feature{Loc: first.B.Location(), From: math.MaxInt32, To: math.MinInt32}
+	var (
+		score     int
+		err, span float64
+	)
+	for _, p := range chain {
+		for _, f := range [...][2]*Feature{{a, p.A}, {b, p.B}} {
+			if f[1].Start() < f[0].From {
+				f[0].From = f[1].Start()
+			}
+			if f[1].End() > f[0].To {
+				f[0].To = f[1].End()
+			}
+		}
+		score += p.Score
+		err += p.Error * float64(p.A.Len())
+		span += float64(p.A.Len())
+	}
+	if span > 0 {
+		err /= span
+	}
+	a.ID = fmt.Sprintf("%s:%d..%d", a.Loc.Name(), a.From, a.To)
+	b.ID = fmt.Sprintf("%s:%d..%d", b.Loc.Name(), b.From, b.To)
+
+	p := &Pair{A: a, B: b, Score: score, Error: err, Strand: first.Strand}
+	a.Pair = p
+	b.Pair = p
+	return p
+}
+
+// chainKeys sorts chain keys by A name, B name and strand.
+type chainKeys []chainKey
+
+func (k chainKeys) Len() int { return len(k) }
+func (k chainKeys) Less(i, j int) bool {
+	if k[i].a != k[j].a {
+		return k[i].a < k[j].a
+	}
+	if k[i].b != k[j].b {
+		return k[i].b < k[j].b
+	}
+	return k[i].strand > k[j].strand
+}
+func (k chainKeys) Swap(i, j int) { k[i], k[j] = k[j], k[i] }
+
+// pairsByA sorts pairs by A start and then B start.
+type pairsByA []*Pair
+
+func (p pairsByA) Len() int { return len(p) }
+func (p pairsByA) Less(i, j int) bool {
+	if p[i].A.Start() != p[j].A.Start() {
+		return p[i].A.Start() < p[j].A.Start()
+	}
+	return p[i].B.Start() < p[j].B.Start()
+}
+func (p pairsByA) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+
+// blocksByScore sorts blocks by descending score.
+type blocksByScore []*Block
+
+func (b blocksByScore) Len() int           { return len(b) }
+func (b blocksByScore) Less(i, j int) bool { return b[i].Score > b[j].Score }
+func (b blocksByScore) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
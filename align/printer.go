@@ -0,0 +1,179 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// DefaultPrintWidth is the number of alignment columns per line used by a Printer
+// with a non-positive Width.
+const DefaultPrintWidth = 60
+
+// A Printer writes pairwise alignments as blocks of text in the style of BLAST output.
+type Printer struct {
+	// Width is the number of alignment columns per line.
+	Width int
+
+	// Matrix is used to mark similar aligned letters. If
+	// Matrix is nil, only identical letters are marked.
+	Matrix Linear
+}
+
+// Fprint writes the alignment of reference and query described by aln to w. Each block
+// holds a reference line, a match line and a query line. Sequence lines are labelled with
+// the name of the sequence if it has one, or "Ref" or "Query" otherwise, and the one-based
+// positions of the first and last letters on the line, offset by the start of the sequence
+// if it is a feat.Feature. In the match line, identical letters are marked with '|', other
+// letter pairs with a positive score in Matrix with ':', other aligned letters with '.' and
+// gaps with a space. It returns an error if the alphabets do not match, a letter is not
+// valid, or a write fails.
+func (p Printer) Fprint(w io.Writer, reference, query AlphabetSlicer, aln []feat.Pair) error {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return ErrMismatchedAlphabets
+	}
+	if p.Matrix != nil {
+		if len(p.Matrix) < alpha.Len() {
+			return ErrMatrixWrongSize{Size: len(p.Matrix), Len: alpha.Len()}
+		}
+		for _, row := range p.Matrix {
+			if len(row) != len(p.Matrix) {
+				return ErrMatrixNotSquare
+			}
+		}
+	}
+	width := p.Width
+	if width <= 0 {
+		width = DefaultPrintWidth
+	}
+
+	var (
+		seqs   = [2]AlphabetSlicer{reference, query}
+		labels = [2]string{"Ref", "Query"}
+		offset [2]int
+		lets   [2][]alphabet.Letter
+		vals   [2][]int
+		index  = alpha.LetterIndex()
+	)
+	for i, s := range seqs {
+		if f, ok := s.(feat.Feature); ok {
+			if name := f.Name(); name != "" {
+				labels[i] = name
+			}
+			offset[i] = f.Start()
+		}
+		var err error
+		vals[i], err = letterIndices(s.Slice(), index, labels[i])
+		if err != nil {
+			return err
+		}
+		switch sl := s.Slice().(type) {
+		case alphabet.Letters:
+			lets[i] = sl
+		case alphabet.QLetters:
+			lets[i] = make([]alphabet.Letter, len(sl))
+			for j, ql := range sl {
+				lets[i][j] = ql.L
+			}
+		}
+	}
+
+	// Build the alignment rows and the positions of their letters.
+	var (
+		rows  [3][]byte
+		pos   [2][]int
+		gap   = byte(alpha.Gap())
+		maxAt int
+	)
+	for _, fp := range aln {
+		fs := fp.Features()
+		rl, ql := fs[0].Len(), fs[1].Len()
+		if rl != 0 && ql != 0 && rl != ql {
+			return fmt.Errorf("align: aligned segment lengths differ: %d != %d", rl, ql)
+		}
+		n := rl
+		if ql > n {
+			n = ql
+		}
+		for k := 0; k < n; k++ {
+			var at [2]int
+			for i, f := range fs {
+				if f.Len() == 0 {
+					rows[2*i] = append(rows[2*i], gap)
+					at[i] = -1
+				} else {
+					at[i] = f.Start() + k
+					rows[2*i] = append(rows[2*i], byte(lets[i][at[i]]))
+					if o := offset[i] + at[i] + 1; o > maxAt {
+						maxAt = o
+					}
+				}
+				pos[i] = append(pos[i], at[i])
+			}
+			var m byte = ' '
+			if at[0] >= 0 && at[1] >= 0 {
+				r, q := vals[0][at[0]], vals[1][at[1]]
+				switch {
+				case r == q:
+					m = '|'
+				case p.Matrix != nil && p.Matrix[r][q] > 0:
+					m = ':'
+				default:
+					m = '.'
+				}
+			}
+			rows[1] = append(rows[1], m)
+		}
+	}
+
+	labelWidth := len(labels[0])
+	if len(labels[1]) > labelWidth {
+		labelWidth = len(labels[1])
+	}
+	posWidth := len(strconv.Itoa(maxAt))
+
+	bw := bufio.NewWriter(w)
+	last := [2]int{offset[0], offset[1]}
+	for start := 0; start < len(rows[1]); start += width {
+		end := start + width
+		if end > len(rows[1]) {
+			end = len(rows[1])
+		}
+		if start != 0 {
+			fmt.Fprintln(bw)
+		}
+		for r, row := range rows {
+			if r == 1 {
+				fmt.Fprintf(bw, "%*s %*s %s\n", labelWidth, "", posWidth, "", row[start:end])
+				continue
+			}
+			i := r / 2
+			first := -1
+			for _, at := range pos[i][start:end] {
+				if at >= 0 {
+					if first < 0 {
+						first = offset[i] + at + 1
+					}
+					last[i] = offset[i] + at + 1
+				}
+			}
+			if first < 0 {
+				first = last[i]
+			}
+			fmt.Fprintf(bw, "%-*s %*d %s %d\n", labelWidth, labels[i], posWidth, first, row[start:end], last[i])
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,43 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"os"
+)
+
+func ExamplePrinter_Fprint() {
+	ref := linear.NewSeq("chr1", alphabet.BytesToLetters([]byte("AGACTAGTTAGGCTTACCGATTAGCTAG")), alphabet.DNAgapped)
+	read := linear.NewSeq("read", alphabet.BytesToLetters([]byte("GACAGACGGCTTACGATTAGCTTG")), alphabet.DNAgapped)
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	aln, err := NW(m).Align(ref, read)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	err = Printer{Width: 15, Matrix: m}.Fprint(os.Stdout, ref, read, aln)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// chr1  1 AGACTAGTTA-GGCT 14
+	//          ||| ||  | ||||
+	// read  1 -GAC-AG--ACGGCT 11
+	//
+	// chr1 15 TACCGATTAGCTAG 28
+	//         || |||||||||.|
+	// read 12 TA-CGATTAGCTTG 24
+}
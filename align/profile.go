@@ -0,0 +1,281 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"fmt"
+	"math"
+)
+
+// A Profile describes the letter frequencies of each column of a multiple alignment.
+type Profile struct {
+	Alpha alphabet.Alphabet
+
+	// Freqs holds the per-column letter frequencies, indexed
+	// by column and then by alphabet letter index. The gap
+	// frequency of each column is held at letter index 0.
+	Freqs [][]float64
+}
+
+// NewProfile returns a Profile describing the letter frequencies of the columns of the
+// multiple alignment m. Rows not covering a column are counted as gaps, and letters that
+// are not valid in alpha are not counted. It returns an error if alpha does not have the
+// gap at position 0.
+func NewProfile(m seq.Aligned, alpha alphabet.Alphabet) (*Profile, error) {
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	p := &Profile{Alpha: alpha, Freqs: make([][]float64, 0, m.End()-m.Start())}
+	for pos := m.Start(); pos < m.End(); pos++ {
+		var (
+			f = make([]float64, alpha.Len())
+			n int
+		)
+		for _, l := range m.Column(pos, true) {
+			if !alpha.IsValid(l) {
+				continue
+			}
+			f[alpha.IndexOf(l)]++
+			n++
+		}
+		if n != 0 {
+			for i := range f {
+				f[i] /= float64(n)
+			}
+		}
+		p.Freqs = append(p.Freqs, f)
+	}
+	return p, nil
+}
+
+// SequenceProfile returns a Profile describing the single sequence s. It returns an
+// error if the alphabet of s does not have the gap at position 0 or s holds a letter
+// that is not valid in its alphabet.
+func SequenceProfile(s AlphabetSlicer) (*Profile, error) {
+	alpha := s.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	vals, err := letterIndices(s.Slice(), alpha.LetterIndex(), "sequence")
+	if err != nil {
+		return nil, err
+	}
+	p := &Profile{Alpha: alpha, Freqs: make([][]float64, len(vals))}
+	for i, v := range vals {
+		p.Freqs[i] = make([]float64, alpha.Len())
+		p.Freqs[i][v] = 1
+	}
+	return p, nil
+}
+
+// Len returns the number of columns in the profile.
+func (p *Profile) Len() int { return len(p.Freqs) }
+
+// ProfileNWAffine is the affine gap penalty Needleman-Wunsch profile aligner type. Gaps
+// are scored as described for Affine.
+//
+// Profile columns are scored as the expected score of a pair of letters drawn from each
+// column, so aligning a column against a gap is scored using the gap row and column of
+// the scoring matrix, weighted by the letter frequencies of the column. Feature pair
+// scores of returned alignments are rounded to the nearest integer.
+type ProfileNWAffine Affine
+
+// AlignProfiles aligns two profiles, returning an alignment description of the profile
+// columns or an error if the scoring matrix is not square, or the profile alphabets do
+// not match.
+func (a ProfileNWAffine) AlignProfiles(reference, query *Profile) ([]feat.Pair, error) {
+	alpha := reference.Alpha
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alpha {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	let := len(a.Matrix)
+	if let < alpha.Len() {
+		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
+	}
+	for _, row := range a.Matrix {
+		if len(row) != let {
+			return nil, ErrMatrixNotSquare
+		}
+	}
+	for _, p := range []*Profile{reference, query} {
+		for i, f := range p.Freqs {
+			if len(f) != alpha.Len() {
+				return nil, fmt.Errorf("align: profile column %d has %d letters, expected %d", i, len(f), alpha.Len())
+			}
+		}
+	}
+	return a.alignProfiles(reference, query), nil
+}
+
+// AlignSequence aligns a sequence to a profile, returning an alignment description of the
+// profile columns and the sequence letters or an error if the scoring matrix is not square,
+// or the alphabets do not match.
+func (a ProfileNWAffine) AlignSequence(reference *Profile, query AlphabetSlicer) ([]feat.Pair, error) {
+	q, err := SequenceProfile(query)
+	if err != nil {
+		return nil, err
+	}
+	return a.AlignProfiles(reference, q)
+}
+
+// pairScore returns the expected score of aligning column f with column g.
+func (a ProfileNWAffine) pairScore(f, g []float64) float64 {
+	var s float64
+	for x, fx := range f {
+		if fx == 0 {
+			continue
+		}
+		row := a.Matrix[x]
+		for y, gy := range g {
+			if gy != 0 {
+				s += fx * gy * float64(row[y])
+			}
+		}
+	}
+	return s
+}
+
+func (a ProfileNWAffine) alignProfiles(reference, query *Profile) []feat.Pair {
+	var (
+		rf, qf = reference.Freqs, query.Freqs
+		r, c   = len(rf) + 1, len(qf) + 1
+		open   = float64(a.GapOpen)
+		negInf = math.Inf(-1)
+
+		// Scores for aligning each column against a gap.
+		gr = make([]float64, r)
+		gq = make([]float64, c)
+
+		// Best scores ending in each move type.
+		table = make([][3]float64, r*c)
+	)
+	for i := 1; i < r; i++ {
+		for x, fx := range rf[i-1] {
+			gr[i] += fx * float64(a.Matrix[x][gap])
+		}
+	}
+	for j := 1; j < c; j++ {
+		for y, fy := range qf[j-1] {
+			gq[j] += fy * float64(a.Matrix[gap][y])
+		}
+	}
+
+	table[0] = [3]float64{0, negInf, negInf}
+	for i := 1; i < r; i++ {
+		p := i * c
+		table[p] = [3]float64{negInf, table[p-c][up], negInf}
+		if i == 1 {
+			table[p][up] = open
+		}
+		table[p][up] += gr[i]
+	}
+	for j := 1; j < c; j++ {
+		table[j] = [3]float64{negInf, negInf, table[j-1][left]}
+		if j == 1 {
+			table[j][left] = open
+		}
+		table[j][left] += gq[j]
+	}
+	for i := 1; i < r; i++ {
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			d, u, l := table[p-c-1], table[p-c], table[p-1]
+			table[p] = [3]float64{
+				diag: math.Max(d[diag], math.Max(d[up], d[left])) + a.pairScore(rf[i-1], qf[j-1]),
+				up:   math.Max(u[diag]+open, u[up]) + gr[i],
+				left: math.Max(l[diag]+open, l[left]) + gq[j],
+			}
+		}
+	}
+
+	var (
+		ops  []byte
+		i, j = r - 1, c - 1
+		t    = table[len(table)-1]
+		last = diag
+	)
+	if t[up] > t[last] {
+		last = up
+	}
+	if t[left] > t[last] {
+		last = left
+	}
+	for i > 0 || j > 0 {
+		ops = append(ops, byte(last))
+		p := i*c + j
+		switch last {
+		case diag:
+			prev := table[p-c-1]
+			i--
+			j--
+			last = diag
+			for _, m := range []int{up, left} {
+				if prev[m] > prev[last] {
+					last = m
+				}
+			}
+		case up:
+			if prev := table[p-c]; prev[diag]+open > prev[up] {
+				last = diag
+			}
+			i--
+		case left:
+			if prev := table[p-1]; prev[diag]+open > prev[left] {
+				last = diag
+			}
+			j--
+		}
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	var aln []feat.Pair
+	i, j = 0, 0
+	for p := 0; p < len(ops); {
+		op := ops[p]
+		si, sj, score := i, j, 0.
+		if op != diag {
+			score = open
+		}
+		for ; p < len(ops) && ops[p] == op; p++ {
+			switch op {
+			case diag:
+				score += a.pairScore(rf[i], qf[j])
+				i++
+				j++
+			case up:
+				i++
+				score += gr[i]
+			case left:
+				j++
+				score += gq[j]
+			}
+		}
+		aln = append(aln, &featPair{
+			a:     feature{start: si, end: i},
+			b:     feature{start: sj, end: j},
+			score: int(math.Floor(score + 0.5)),
+		})
+	}
+
+	return aln
+}
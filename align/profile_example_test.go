@@ -0,0 +1,53 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"fmt"
+)
+
+func ExampleProfileNWAffine_AlignSequence() {
+	m, err := multi.NewMulti("msa",
+		[]seq.Sequence{
+			linear.NewSeq("a", alphabet.BytesToLetters([]byte("ACGT-ACGTTA")), alphabet.DNAgapped),
+			linear.NewSeq("b", alphabet.BytesToLetters([]byte("ACGTGACG-TA")), alphabet.DNAgapped),
+			linear.NewSeq("c", alphabet.BytesToLetters([]byte("ACCT-ACGTTA")), alphabet.DNAgapped),
+		},
+		seq.DefaultConsensus)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	p, err := NewProfile(m, alphabet.DNAgapped)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	needle := ProfileNWAffine{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 3, -2, -2, -2},
+			{-1, -2, 3, -2, -2},
+			{-1, -2, -2, 3, -2},
+			{-1, -2, -2, -2, 3},
+		},
+		GapOpen: -4,
+	}
+	q := linear.NewSeq("q", alphabet.BytesToLetters([]byte("ACGTACGTA")), alphabet.DNAgapped)
+	aln, err := needle.AlignSequence(p, q)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(aln)
+	// Output:
+	// [[0,4)/[0,4)=10 [4,5)/-=-4 [5,8)/[4,7)=9 [8,9)/-=-5 [9,11)/[7,9)=6]
+}
@@ -0,0 +1,321 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A PSSM is a position-specific scoring matrix.
+type PSSM struct {
+	Alpha alphabet.Alphabet
+
+	// Scores holds the score for each letter at each
+	// position, indexed by position and then by alphabet
+	// letter index.
+	Scores [][]int
+
+	// Consensus holds the query letter of each position
+	// if it is known.
+	Consensus []alphabet.Letter
+
+	// Ungapped and Gapped hold Karlin-Altschul parameters
+	// for the matrix if they are known.
+	Ungapped, Gapped *KarlinAltschul
+}
+
+// Len returns the number of positions in the PSSM.
+func (p *PSSM) Len() int { return len(p.Scores) }
+
+// SequencePSSM returns a PSSM for the sequence s using the scoring matrix m. The score for
+// a letter at a position is the score in m for the letter against the letter of s at that
+// position. It returns an error if the scoring matrix is not square or does not match the
+// alphabet of s, or a letter of s is not valid.
+func SequencePSSM(s AlphabetSlicer, m Linear) (*PSSM, error) {
+	alpha := s.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if len(m) < alpha.Len() {
+		return nil, ErrMatrixWrongSize{Size: len(m), Len: alpha.Len()}
+	}
+	for _, row := range m {
+		if len(row) != len(m) {
+			return nil, ErrMatrixNotSquare
+		}
+	}
+	vals, err := letterIndices(s.Slice(), alpha.LetterIndex(), "sequence")
+	if err != nil {
+		return nil, err
+	}
+	p := &PSSM{Alpha: alpha, Scores: make([][]int, len(vals)), Consensus: make([]alphabet.Letter, len(vals))}
+	for i, v := range vals {
+		p.Scores[i] = append([]int(nil), m[v][:alpha.Len()]...)
+		p.Consensus[i] = alpha.Letter(v)
+	}
+	return p, nil
+}
+
+// ReadPSSM reads a PSSM in the ASCII format written by PSI-BLAST with the -out_ascii_pssm
+// option, using the alphabet alpha. Letters of alpha that are not included in the matrix
+// are given the lowest score of their position, and the gap letter is given a score of
+// zero. Karlin-Altschul parameters are read if they are present, with PSI-BLAST parameters
+// taking precedence over standard parameters.
+func ReadPSSM(r io.Reader, alpha alphabet.Alphabet) (*PSSM, error) {
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	const (
+		header = iota
+		body
+		trailer
+	)
+	var (
+		sc    = bufio.NewScanner(r)
+		p     = &PSSM{Alpha: alpha}
+		cols  []int
+		state = header
+		line  int
+	)
+	for sc.Scan() {
+		line++
+		f := strings.Fields(sc.Text())
+		switch state {
+		case header:
+			if len(f) < 20 {
+				continue
+			}
+			for _, h := range f {
+				if len(h) != 1 {
+					cols = nil
+					break
+				}
+				if len(cols) > 0 && h == f[0] {
+					// Skip the observed percentage columns.
+					break
+				}
+				l := alphabet.Letter(h[0])
+				if !alpha.IsValid(l) {
+					return nil, fmt.Errorf("align: invalid pssm letter %q at line %d", l, line)
+				}
+				cols = append(cols, alpha.IndexOf(l))
+			}
+			if cols != nil {
+				state = body
+			}
+		case body:
+			if len(f) == 0 {
+				if len(p.Scores) != 0 {
+					state = trailer
+				}
+				continue
+			}
+			if len(f) < len(cols)+2 {
+				return nil, fmt.Errorf("align: short pssm line %d", line)
+			}
+			pos, err := strconv.Atoi(f[0])
+			if err != nil {
+				return nil, fmt.Errorf("align: invalid pssm position at line %d: %v", line, err)
+			}
+			if pos != len(p.Scores)+1 {
+				return nil, fmt.Errorf("align: unexpected pssm position %d at line %d", pos, line)
+			}
+			if len(f[1]) != 1 {
+				return nil, fmt.Errorf("align: invalid pssm query letter %q at line %d", f[1], line)
+			}
+			var (
+				row = make([]int, alpha.Len())
+				set = make([]bool, alpha.Len())
+				min int
+			)
+			for i, c := range cols {
+				s, err := strconv.Atoi(f[i+2])
+				if err != nil {
+					return nil, fmt.Errorf("align: invalid pssm score at line %d: %v", line, err)
+				}
+				row[c], set[c] = s, true
+				if i == 0 || s < min {
+					min = s
+				}
+			}
+			for i := range row {
+				if !set[i] && alpha.Letter(i) != alpha.Gap() {
+					row[i] = min
+				}
+			}
+			p.Scores = append(p.Scores, row)
+			p.Consensus = append(p.Consensus, alphabet.Letter(f[1][0]))
+		case trailer:
+			if len(f) != 4 || (f[0] != "Standard" && f[0] != "PSI") {
+				continue
+			}
+			k, err := strconv.ParseFloat(f[2], 64)
+			if err != nil {
+				return nil, fmt.Errorf("align: invalid pssm K at line %d: %v", line, err)
+			}
+			lambda, err := strconv.ParseFloat(f[3], 64)
+			if err != nil {
+				return nil, fmt.Errorf("align: invalid pssm lambda at line %d: %v", line, err)
+			}
+			ka := &KarlinAltschul{Lambda: lambda, K: k}
+			switch f[1] {
+			case "Ungapped":
+				if f[0] == "PSI" || p.Ungapped == nil {
+					p.Ungapped = ka
+				}
+			case "Gapped":
+				if f[0] == "PSI" || p.Gapped == nil {
+					p.Gapped = ka
+				}
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if cols == nil {
+		return nil, errors.New("align: no pssm header")
+	}
+	if len(p.Scores) == 0 {
+		return nil, errors.New("align: no pssm positions")
+	}
+	return p, nil
+}
+
+// PSSMSWAffine is the affine gap penalty Smith-Waterman aligner type for aligning sequences
+// against a PSSM. The score of a gap of length n is GapOpen+n*Gap, so both GapOpen and Gap
+// should be negative.
+type PSSMSWAffine struct {
+	GapOpen int
+	Gap     int
+}
+
+// Align aligns query against the PSSM p, returning the highest scoring local alignment.
+// The first feature of each returned feature pair describes positions of p. It returns an
+// error if the alphabets do not match or a letter of query is not valid.
+func (a PSSMSWAffine) Align(p *PSSM, query AlphabetSlicer) ([]feat.Pair, error) {
+	if p.Alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if p.Alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	for _, row := range p.Scores {
+		if len(row) != p.Alpha.Len() {
+			return nil, ErrMatrixWrongSize{Size: len(row), Len: p.Alpha.Len()}
+		}
+	}
+	qVals, err := letterIndices(query.Slice(), p.Alpha.LetterIndex(), "qSeq")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		r     = p.Len() + 1
+		c     = len(qVals) + 1
+		table = make([][3]int, r*c)
+		open  = a.GapOpen + a.Gap
+
+		maxS, maxI, maxJ int
+	)
+	for j := 0; j < c; j++ {
+		table[j] = [3]int{0, minInt, minInt}
+	}
+	for i := 1; i < r; i++ {
+		table[i*c] = [3]int{0, minInt, minInt}
+		for j := 1; j < c; j++ {
+			d := table[(i-1)*c+j-1]
+			u := table[(i-1)*c+j]
+			l := table[i*c+j-1]
+			cell := &table[i*c+j]
+			cell[diag] = max2(0, max3(d[diag], d[up], d[left])) + p.Scores[i-1][qVals[j-1]]
+			cell[up] = max2(add(u[diag], open), add(u[up], a.Gap))
+			cell[left] = max2(add(l[diag], open), add(l[left], a.Gap))
+			if cell[diag] > maxS {
+				maxS, maxI, maxJ = cell[diag], i, j
+			}
+		}
+	}
+	if maxS == 0 {
+		return nil, nil
+	}
+
+	var (
+		ops  []byte
+		last = diag
+		i, j = maxI, maxJ
+	)
+trace:
+	for {
+		ops = append(ops, byte(last))
+		switch last {
+		case diag:
+			d := table[(i-1)*c+j-1]
+			i--
+			j--
+			if max3(d[diag], d[up], d[left]) <= 0 {
+				break trace
+			}
+			for _, m := range []int{up, left} {
+				if d[m] > d[last] {
+					last = m
+				}
+			}
+		case up:
+			u := table[(i-1)*c+j]
+			if add(u[diag], open) >= add(u[up], a.Gap) {
+				last = diag
+			}
+			i--
+		case left:
+			l := table[i*c+j-1]
+			if add(l[diag], open) >= add(l[left], a.Gap) {
+				last = diag
+			}
+			j--
+		}
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	var aln []feat.Pair
+	for k := 0; k < len(ops); {
+		op := ops[k]
+		si, sj, score := i, j, 0
+		if op != diag {
+			score = a.GapOpen
+		}
+		for ; k < len(ops) && ops[k] == op; k++ {
+			switch op {
+			case diag:
+				score += p.Scores[i][qVals[j]]
+				i++
+				j++
+			case up:
+				score += a.Gap
+				i++
+			case left:
+				score += a.Gap
+				j++
+			}
+		}
+		aln = append(aln, &featPair{
+			a:     feature{start: si, end: i},
+			b:     feature{start: sj, end: j},
+			score: score,
+		})
+	}
+
+	return aln, nil
+}
@@ -0,0 +1,49 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"strings"
+)
+
+const psiBlastPSSM = `
+Last position-specific scoring matrix computed, weighted observed percentages rounded down, information per position, and relative weight of gapless real matches to pseudocounts
+            A   R   N   D   C   Q   E   G   H   I   L   K   M   F   P   S   T   W   Y   V   A   R   N   D   C   Q   E   G   H   I   L   K   M   F   P   S   T   W   Y   V
+    1 M    -1  -2  -2  -3  -2  -1  -2  -3  -2   1   2  -2   6   0  -3  -2  -1  -2  -1   1     0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0  0.00 0.00
+    2 K    -1   2   0  -1  -3   1   1  -2  -1  -3  -3   5  -2  -3  -1   0  -1  -3  -2  -3     0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0  0.00 0.00
+    3 W    -3  -3  -4  -5  -3  -2  -3  -3  -3  -3  -2  -3  -2   1  -4  -3  -3  12   2  -3     0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0  0.00 0.00
+    4 V     0  -3  -3  -3  -1  -2  -2  -3  -3   3   1  -2   1  -1  -2  -2   0  -3  -1   4     0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0  0.00 0.00
+    5 C     0  -3  -3  -3   9  -3  -4  -3  -3  -1  -1  -3  -1  -2  -3  -1  -1  -2  -2  -1     0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0   0  0.00 0.00
+
+                      K         Lambda
+Standard Ungapped    0.1340     0.3170
+Standard Gapped      0.0410     0.2670
+PSI Ungapped         0.1520     0.3200
+PSI Gapped           0.0470     0.2690
+`
+
+func ExamplePSSMSWAffine_Align() {
+	pssm, err := ReadPSSM(strings.NewReader(psiBlastPSSM), alphabet.Protein)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%s %+v\n", alphabet.Letters(pssm.Consensus), *pssm.Gapped)
+
+	query := linear.NewSeq("query", alphabet.BytesToLetters([]byte("GGHMRWGVCAA")), alphabet.Protein)
+	aln, err := PSSMSWAffine{GapOpen: -10, Gap: -1}.Align(pssm, query)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(aln)
+	// Output:
+	// MKWVC {Lambda:0.269 K:0.047 H:0}
+	// [[0,3)/[3,6)=20 -/[6,7)=-11 [3,5)/[7,9)=13]
+}
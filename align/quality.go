@@ -0,0 +1,67 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+)
+
+// QualityProfile returns a Profile describing the letter probabilities of the sequence s.
+// If s holds alphabet.QLetters, the called letter at each position is given a probability
+// of one minus the error probability of its quality score, and the error probability is
+// shared equally between the other non-gap letters of the alphabet. Otherwise each letter
+// is given a probability of one. It returns an error if the alphabet of s does not have
+// the gap at position 0 or s holds a letter that is not valid in its alphabet.
+func QualityProfile(s AlphabetSlicer) (*Profile, error) {
+	p, err := SequenceProfile(s)
+	if err != nil {
+		return nil, err
+	}
+	ql, ok := s.Slice().(alphabet.QLetters)
+	if !ok {
+		return p, nil
+	}
+	others := float64(p.Alpha.Len() - 2)
+	if others < 1 {
+		return p, nil
+	}
+	for i, f := range p.Freqs {
+		e := ql[i].Q.ProbE()
+		for l := 1; l < len(f); l++ {
+			if f[l] == 1 {
+				f[l] = 1 - e
+			} else {
+				f[l] = e / others
+			}
+		}
+	}
+	return p, nil
+}
+
+// QualityNWAffine is the affine gap penalty quality-aware Needleman-Wunsch aligner type.
+// Gaps are scored as described for Affine.
+//
+// Aligned letters are scored with the expected substitution score given the error
+// probabilities of their quality scores, as described for QualityProfile and
+// ProfileNWAffine, so low quality mismatches are penalised less and low quality
+// matches are rewarded less than high quality ones. Feature pair scores of returned
+// alignments are rounded to the nearest integer.
+type QualityNWAffine Affine
+
+// Align aligns two sequences using quality-aware scoring. Sequences without quality
+// scores are treated as error free. It returns an alignment description or an error
+// if the scoring matrix is not square, or the sequence alphabets do not match.
+func (a QualityNWAffine) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	rp, err := QualityProfile(reference)
+	if err != nil {
+		return nil, err
+	}
+	qp, err := QualityProfile(query)
+	if err != nil {
+		return nil, err
+	}
+	return ProfileNWAffine(a).AlignProfiles(rp, qp)
+}
@@ -0,0 +1,47 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleQualityNWAffine_Align() {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("ACGTACGTAC")), alphabet.DNAgapped)
+
+	needle := QualityNWAffine{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 5, -4, -4, -4},
+			{-1, -4, 5, -4, -4},
+			{-1, -4, -4, 5, -4},
+			{-1, -4, -4, -4, 5},
+		},
+		GapOpen: -5,
+	}
+
+	// The mismatching base at position 4 is given
+	// a high and then a low quality score.
+	for _, q := range []alphabet.Qphred{40, 3} {
+		ql := alphabet.QLetters{
+			{L: 'A', Q: 40}, {L: 'C', Q: 40}, {L: 'G', Q: 40}, {L: 'T', Q: 40},
+			{L: 'T', Q: q}, {L: 'C', Q: 40}, {L: 'G', Q: 40}, {L: 'T', Q: 40},
+			{L: 'A', Q: 40}, {L: 'C', Q: 40},
+		}
+		read := linear.NewQSeq("read", ql, alphabet.DNAgapped, alphabet.Sanger)
+		aln, err := needle.Align(ref, read)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Q%d: %v\n", q, aln)
+	}
+	// Output:
+	// Q40: [[0,10)/[0,10)=41]
+	// Q3: [[0,10)/[0,10)=42]
+}
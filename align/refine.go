@@ -0,0 +1,248 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"fmt"
+)
+
+// Refiner is the affine gap penalty banded alignment refinement type. Gaps are scored as
+// described for Affine.
+type Refiner struct {
+	Affine
+
+	// Width is the number of cells either side of the
+	// path of the alignment being refined that are
+	// included in the band.
+	Width int
+}
+
+// refineRow is a row of a banded dynamic programming table holding
+// the cells for columns lo to lo+len(cells).
+type refineRow struct {
+	lo    int
+	cells [][3]int
+}
+
+var deadCell = [3]int{minInt, minInt, minInt}
+
+func (r refineRow) at(j int) [3]int {
+	if j < r.lo || j >= r.lo+len(r.cells) {
+		return deadCell
+	}
+	return r.cells[j-r.lo]
+}
+
+// Refine realigns the region of reference and query described by aln using affine gap
+// Needleman-Wunsch alignment restricted to a band around the path of aln, returning the
+// refined alignment description. The refined alignment starts and ends at the same
+// positions as aln. Refinement is useful for polishing gap placement of alignments
+// produced by chaining or linear gap penalty aligners. It returns an error if the scoring
+// matrix is not square, the band width is negative, aln is not contiguous, or the
+// sequence data types or alphabets do not match.
+func (a Refiner) Refine(reference, query AlphabetSlicer, aln []feat.Pair) ([]feat.Pair, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	if a.Width < 0 {
+		return nil, ErrNegativeBandWidth
+	}
+	let := len(a.Matrix)
+	if let < alpha.Len() {
+		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
+	}
+	la := make([]int, 0, let*let)
+	for _, row := range a.Matrix {
+		if len(row) != let {
+			return nil, ErrMatrixNotSquare
+		}
+		la = append(la, row...)
+	}
+
+	rSl, qSl := reference.Slice(), query.Slice()
+	switch rSl.(type) {
+	case alphabet.Letters:
+		if _, ok := qSl.(alphabet.Letters); !ok {
+			return nil, ErrMismatchedTypes
+		}
+	case alphabet.QLetters:
+		if _, ok := qSl.(alphabet.QLetters); !ok {
+			return nil, ErrMismatchedTypes
+		}
+	default:
+		return nil, ErrTypeNotHandled
+	}
+	if len(aln) == 0 {
+		return nil, nil
+	}
+
+	// Find the extent of the path in each row of the table.
+	var (
+		first  = aln[0].Features()
+		r0, q0 = first[0].Start(), first[1].Start()
+		i, j   = r0, q0
+		lo, hi = []int{0}, []int{0}
+	)
+	for _, fp := range aln {
+		f := fp.Features()
+		if f[0].Start() != i || f[1].Start() != j {
+			return nil, fmt.Errorf("align: alignment is not contiguous at %d/%d", f[0].Start(), f[1].Start())
+		}
+		rl, ql := f[0].Len(), f[1].Len()
+		switch {
+		case rl != 0 && ql != 0:
+			if rl != ql {
+				return nil, fmt.Errorf("align: aligned segment lengths differ: %d != %d", rl, ql)
+			}
+			for k := 1; k <= rl; k++ {
+				lo = append(lo, j-q0+k)
+				hi = append(hi, j-q0+k)
+			}
+		case rl != 0:
+			for k := 1; k <= rl; k++ {
+				lo = append(lo, j-q0)
+				hi = append(hi, j-q0)
+			}
+		case ql != 0:
+			hi[len(hi)-1] = j - q0 + ql
+		}
+		i, j = f[0].End(), f[1].End()
+	}
+
+	index := alpha.LetterIndex()
+	rVals, err := letterIndices(rSl.Slice(r0, i), index, "rSeq")
+	if err != nil {
+		return nil, err
+	}
+	qVals, err := letterIndices(qSl.Slice(q0, j), index, "qSeq")
+	if err != nil {
+		return nil, err
+	}
+
+	c := len(qVals) + 1
+	rows := make([]refineRow, len(rVals)+1)
+	for i := range rows {
+		l, h := lo[i]-a.Width, hi[i]+a.Width
+		if l < 0 {
+			l = 0
+		}
+		if h >= c {
+			h = c - 1
+		}
+		row := refineRow{lo: l, cells: make([][3]int, h-l+1)}
+		for j := l; j <= h; j++ {
+			if i == 0 && j == 0 {
+				row.cells[0] = [3]int{0, minInt, minInt}
+				continue
+			}
+			cell := deadCell
+			if i > 0 {
+				rVal := rVals[i-1]
+				u := rows[i-1].at(j)
+				cell[up] = max2(
+					add(u[diag], a.GapOpen+la[rVal*let]),
+					add(u[up], la[rVal*let]),
+				)
+				if j > 0 {
+					d := rows[i-1].at(j - 1)
+					cell[diag] = add(max3(d[diag], d[up], d[left]), la[rVal*let+qVals[j-1]])
+				}
+			}
+			if j > l {
+				qVal := qVals[j-1]
+				p := row.cells[j-1-l]
+				cell[left] = max2(
+					add(p[diag], a.GapOpen+la[qVal]),
+					add(p[left], la[qVal]),
+				)
+			}
+			row.cells[j-l] = cell
+		}
+		rows[i] = row
+	}
+
+	var (
+		ops  []byte
+		last = diag
+	)
+	i, j = len(rVals), len(qVals)
+	t := rows[i].at(j)
+	for _, m := range []int{up, left} {
+		if t[m] > t[last] {
+			last = m
+		}
+	}
+	for i > 0 || j > 0 {
+		ops = append(ops, byte(last))
+		switch last {
+		case diag:
+			d := rows[i-1].at(j - 1)
+			last = diag
+			for _, m := range []int{up, left} {
+				if d[m] > d[last] {
+					last = m
+				}
+			}
+			i--
+			j--
+		case up:
+			u := rows[i-1].at(j)
+			if add(u[diag], a.GapOpen+la[rVals[i-1]*let]) >= add(u[up], la[rVals[i-1]*let]) {
+				last = diag
+			}
+			i--
+		case left:
+			l := rows[i].at(j - 1)
+			if add(l[diag], a.GapOpen+la[qVals[j-1]]) >= add(l[left], la[qVals[j-1]]) {
+				last = diag
+			}
+			j--
+		}
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	var refined []feat.Pair
+	i, j = 0, 0
+	for p := 0; p < len(ops); {
+		op := ops[p]
+		si, sj, score := i, j, 0
+		if op != diag {
+			score = a.GapOpen
+		}
+		for ; p < len(ops) && ops[p] == op; p++ {
+			switch op {
+			case diag:
+				score += la[rVals[i]*let+qVals[j]]
+				i++
+				j++
+			case up:
+				score += la[rVals[i]*let]
+				i++
+			case left:
+				score += la[qVals[j]]
+				j++
+			}
+		}
+		refined = append(refined, &featPair{
+			a:     feature{start: r0 + si, end: r0 + i},
+			b:     feature{start: q0 + sj, end: q0 + j},
+			score: score,
+		})
+	}
+
+	return refined, nil
+}
@@ -0,0 +1,49 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleRefiner_Refine() {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("ACGTACGTAAACCCGGGTTTACGTACGT")), alphabet.DNAgapped)
+	query := linear.NewSeq("query", alphabet.BytesToLetters([]byte("ACGTACGTAACCGGTTTACGTACGT")), alphabet.DNAgapped)
+
+	m := Linear{
+		{0, -1, -1, -1, -1},
+		{-1, 1, -1, -1, -1},
+		{-1, -1, 1, -1, -1},
+		{-1, -1, -1, 1, -1},
+		{-1, -1, -1, -1, 1},
+	}
+	rough, err := NW(m).Align(ref, query)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fa := Format(ref, query, rough, '-')
+	fmt.Printf("%s\n%s\n\n", fa[0], fa[1])
+
+	refiner := Refiner{Affine: Affine{Matrix: m, GapOpen: -3}, Width: 3}
+	aln, err := refiner.Refine(ref, query, rough)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(aln)
+	fa = Format(ref, query, aln, '-')
+	fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	// Output:
+	// ACGTACGTAAACCCGGGTTTACGTACGT
+	// ACGTACGT-AA-CC-GGTTTACGTACGT
+	//
+	// [[0,10)/[0,10)=10 [10,13)/-=-6 [13,28)/[10,25)=13]
+	// ACGTACGTAAACCCGGGTTTACGTACGT
+	// ACGTACGTAA---CCGGTTTACGTACGT
+}
@@ -0,0 +1,203 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"bytes"
+	"io"
+	"strconv"
+)
+
+// A SAMFlag holds the bitwise flags of a SAM record.
+type SAMFlag uint16
+
+// SAM flag bits.
+const (
+	SAMPaired        SAMFlag = 1 << iota // The read is paired in sequencing.
+	SAMProperPair                        // The read is mapped in a proper pair.
+	SAMUnmapped                          // The read is not mapped.
+	SAMMateUnmapped                      // The mate is not mapped.
+	SAMReverse                           // The read is mapped to the reverse strand.
+	SAMMateReverse                       // The mate is mapped to the reverse strand.
+	SAMRead1                             // This is read 1.
+	SAMRead2                             // This is read 2.
+	SAMSecondary                         // Not the primary alignment.
+	SAMQCFail                            // QC failure.
+	SAMDuplicate                         // Optical or PCR duplicate.
+	SAMSupplementary                     // Supplementary alignment.
+)
+
+// SAMMapQUnavailable is the SAM mapping quality value indicating that
+// the mapping quality is not available.
+const SAMMapQUnavailable = 255
+
+// A SAMRecord is a SAM format alignment record describing the alignment of
+// a query sequence to a reference. SAM headers and streams of records are
+// read and written by the io/seqio/sam package.
+type SAMRecord struct {
+	Name    string
+	Flags   SAMFlag
+	Ref     string
+	Pos     int // Zero-based position of the first aligned reference letter.
+	MapQ    byte
+	Cigar   Cigar
+	MateRef string
+	MatePos int // Zero-based mate position or -1 if not available.
+	TempLen int
+	Seq     []byte
+	Qual    []byte // Phred quality scores; nil if not available.
+	Tags    []string
+}
+
+// NewSAMRecord returns a SAM record describing the alignment of query to reference
+// described by aln, such as is returned by an Aligner. Leading and trailing query
+// letters not aligned to the reference are described as soft clipped and the record
+// position is the first reference position aligned to the query, offset by the start
+// of the reference. If query has a reverse orientation, the SAMReverse flag is set. If
+// the feature pairs of aln provide a Score method, the total score is recorded in an
+// AS tag. The mapping quality of the returned record is SAMMapQUnavailable.
+func NewSAMRecord(reference, query seq.Sequence, aln []feat.Pair) (*SAMRecord, error) {
+	r := &SAMRecord{
+		Name:    query.Name(),
+		Ref:     reference.Name(),
+		MapQ:    SAMMapQUnavailable,
+		MatePos: -1,
+	}
+
+	switch s := query.Slice().(type) {
+	case alphabet.Letters:
+		r.Seq = append([]byte(nil), alphabet.LettersToBytes(s)...)
+	case alphabet.QLetters:
+		r.Seq = make([]byte, len(s))
+		r.Qual = make([]byte, len(s))
+		for i, ql := range s {
+			r.Seq[i] = byte(ql.L)
+			r.Qual[i] = byte(ql.Q)
+		}
+	default:
+		return nil, ErrTypeNotHandled
+	}
+	if o, ok := query.(feat.Orienter); ok && o.Orientation() == feat.Reverse {
+		r.Flags |= SAMReverse
+	}
+
+	type scorer interface {
+		Score() int
+	}
+	var (
+		score    int
+		hasScore = len(aln) != 0
+	)
+	for _, fp := range aln {
+		if s, ok := fp.(scorer); ok {
+			score += s.Score()
+		} else {
+			hasScore = false
+		}
+	}
+
+	// Segments at the ends of the alignment that are aligned against
+	// gaps are not valid in SAM; reference letters are dropped and
+	// query letters are soft clipped.
+	for len(aln) != 0 && !isAligned(aln[0]) {
+		aln = aln[1:]
+	}
+	for len(aln) != 0 && !isAligned(aln[len(aln)-1]) {
+		aln = aln[:len(aln)-1]
+	}
+	if len(aln) == 0 {
+		r.Flags |= SAMUnmapped
+		r.Ref = "*"
+		r.Pos = -1
+		return r, nil
+	}
+
+	var (
+		first = aln[0].Features()
+		last  = aln[len(aln)-1].Features()
+
+		qStart = first[1].Start()
+		qEnd   = last[1].End()
+	)
+	r.Pos = reference.Start() + first[0].Start()
+
+	r.Cigar = r.Cigar.append(CigarSoftClipped, qStart)
+	for _, o := range CigarFor(aln) {
+		r.Cigar = r.Cigar.append(o.Type, o.Len)
+	}
+	r.Cigar = r.Cigar.append(CigarSoftClipped, len(r.Seq)-qEnd)
+
+	if hasScore {
+		r.Tags = append(r.Tags, "AS:i:"+strconv.Itoa(score))
+	}
+
+	return r, nil
+}
+
+// isAligned returns whether fp describes letters aligned in both sequences.
+func isAligned(fp feat.Pair) bool {
+	f := fp.Features()
+	return f[0].Len() != 0 && f[1].Len() != 0
+}
+
+// String returns the SAM text representation of the record without a trailing newline.
+func (r *SAMRecord) String() string {
+	var buf bytes.Buffer
+	r.writeTo(&buf)
+	return buf.String()
+}
+
+// WriteTo writes the SAM text representation of the record followed by a newline to w.
+func (r *SAMRecord) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	r.writeTo(&buf)
+	buf.WriteByte('\n')
+	return buf.WriteTo(w)
+}
+
+func (r *SAMRecord) writeTo(buf *bytes.Buffer) {
+	field := func(s string) {
+		if s == "" {
+			s = "*"
+		}
+		buf.WriteString(s)
+		buf.WriteByte('\t')
+	}
+	field(r.Name)
+	field(strconv.Itoa(int(r.Flags)))
+	field(r.Ref)
+	field(strconv.Itoa(r.Pos + 1))
+	field(strconv.Itoa(int(r.MapQ)))
+	field(r.Cigar.String())
+	switch {
+	case r.MateRef == "":
+		field("*")
+	case r.MateRef == r.Ref:
+		field("=")
+	default:
+		field(r.MateRef)
+	}
+	field(strconv.Itoa(r.MatePos + 1))
+	field(strconv.Itoa(r.TempLen))
+	field(string(r.Seq))
+	if r.Qual == nil {
+		buf.WriteByte('*')
+	} else {
+		for _, q := range r.Qual {
+			if q > '~'-33 {
+				q = '~' - 33
+			}
+			buf.WriteByte(q + 33)
+		}
+	}
+	for _, t := range r.Tags {
+		buf.WriteByte('\t')
+		buf.WriteString(t)
+	}
+}
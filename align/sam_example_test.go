@@ -0,0 +1,44 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"os"
+)
+
+func ExampleNewSAMRecord() {
+	ref := linear.NewSeq("chr1", alphabet.BytesToLetters([]byte("AAAGGGACGTACGTACCCTTT")), alphabet.DNAgapped)
+	read := linear.NewSeq("read1", alphabet.BytesToLetters([]byte("TTACGTACGGTACAA")), alphabet.DNAgapped)
+
+	smith := SW{
+		{0, -1, -1, -1, -1},
+		{-1, 2, -1, -1, -1},
+		{-1, -1, 2, -1, -1},
+		{-1, -1, -1, 2, -1},
+		{-1, -1, -1, -1, 2},
+	}
+	aln, err := smith.Align(ref, read)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fa := Format(ref, read, aln, '-')
+	fmt.Printf("%s\n%s\n", fa[0], fa[1])
+
+	r, err := NewSAMRecord(ref, read, aln)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	r.WriteTo(os.Stdout)
+	// Output:
+	// ACGTAC-GTAC
+	// ACGTACGGTAC
+	// read1	0	chr1	7	255	2S6M1I4M2S	*	0	0	TTACGTACGGTACAA	*	AS:i:19
+}
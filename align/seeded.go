@@ -0,0 +1,368 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/index/kmerindex"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"sort"
+)
+
+// ErrBadSeedLength is returned by Seeded when the seed length is not positive.
+var ErrBadSeedLength = errors.New("align: seed length must be positive")
+
+// chainLookback is the maximum number of preceding anchors considered when
+// chaining an anchor.
+const chainLookback = 50
+
+// Seeded is the linear gap penalty seed-and-extend aligner type.
+type Seeded struct {
+	Matrix Linear
+
+	// K is the length of seeds.
+	K int
+
+	// W is the minimizer window size. If W is greater than
+	// one, only (W,K)-minimizers are used as seeds, otherwise
+	// all K-mers are used. Minimizers are found with the
+	// kmerindex package, so minimizer seeding requires a
+	// nucleic acid alphabet and K within the kmerindex k-mer
+	// length limits.
+	W int
+
+	// Width is the band width used for extension between
+	// anchors, as described for Band.
+	Width int
+}
+
+// Align aligns two sequences by finding exact K-mer matches between reference and query,
+// chaining the matches into the highest scoring collinear set of anchors and aligning
+// the regions between anchors with NWBanded. The returned alignment spans the first to
+// the last anchor of the best chain; query letters outside this span are not included
+// in the alignment description. If reference and query share no seeds, a nil alignment
+// is returned. It returns an error if the scoring matrix is not square, the seed length
+// is not positive, the band width is negative, the sequence data types or alphabets do
+// not match, or minimizer seeding is requested for sequences that are not nucleic acid
+// or with a seed length outside the limits of the kmerindex package.
+func (a Seeded) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	if a.K <= 0 {
+		return nil, ErrBadSeedLength
+	}
+	if a.Width < 0 {
+		return nil, ErrNegativeBandWidth
+	}
+	let := len(a.Matrix)
+	if let < alpha.Len() {
+		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
+	}
+	la := make([]int, 0, let*let)
+	for _, row := range a.Matrix {
+		if len(row) != let {
+			return nil, ErrMatrixNotSquare
+		}
+		la = append(la, row...)
+	}
+
+	rSl, qSl := reference.Slice(), query.Slice()
+	switch rSl.(type) {
+	case alphabet.Letters:
+		if _, ok := qSl.(alphabet.Letters); !ok {
+			return nil, ErrMismatchedTypes
+		}
+	case alphabet.QLetters:
+		if _, ok := qSl.(alphabet.QLetters); !ok {
+			return nil, ErrMismatchedTypes
+		}
+	default:
+		return nil, ErrTypeNotHandled
+	}
+	index := alpha.LetterIndex()
+	rVals, err := letterIndices(rSl, index, "rSeq")
+	if err != nil {
+		return nil, err
+	}
+	qVals, err := letterIndices(qSl, index, "qSeq")
+	if err != nil {
+		return nil, err
+	}
+
+	an, err := a.anchors(alpha, rSl, qSl, rVals, qVals)
+	if err != nil {
+		return nil, err
+	}
+	chain := a.chain(an)
+	if len(chain) == 0 {
+		return nil, nil
+	}
+
+	var (
+		aln    []feat.Pair
+		nw     = NWBanded{Matrix: a.Matrix, Width: a.Width}
+		ri, qj = chain[0].r, chain[0].q
+	)
+	for _, an := range chain {
+		// Trim the anchor to remove any overlap with
+		// the part of the alignment already described.
+		s := ri - an.r
+		if d := qj - an.q; d > s {
+			s = d
+		}
+		if s >= a.K {
+			continue
+		}
+		if s < 0 {
+			s = 0
+		}
+		r0, q0 := an.r+s, an.q+s
+		r1, q1 := an.r+a.K, an.q+a.K
+
+		switch {
+		case r0 > ri && q0 > qj:
+			sub, err := nw.Align(
+				slicer{alpha: alpha, s: rSl.Slice(ri, r0)},
+				slicer{alpha: alpha, s: qSl.Slice(qj, q0)},
+			)
+			if err != nil {
+				return nil, err
+			}
+			for _, fp := range sub {
+				fp := fp.(*featPair)
+				fp.a.start += ri
+				fp.a.end += ri
+				fp.b.start += qj
+				fp.b.end += qj
+				aln = appendPair(aln, fp)
+			}
+		case r0 > ri:
+			score := 0
+			for _, v := range rVals[ri:r0] {
+				score += la[v*let]
+			}
+			aln = appendPair(aln, &featPair{
+				a:     feature{start: ri, end: r0},
+				b:     feature{start: qj, end: qj},
+				score: score,
+			})
+		case q0 > qj:
+			score := 0
+			for _, v := range qVals[qj:q0] {
+				score += la[v]
+			}
+			aln = appendPair(aln, &featPair{
+				a:     feature{start: ri, end: ri},
+				b:     feature{start: qj, end: q0},
+				score: score,
+			})
+		}
+
+		score := 0
+		for _, v := range rVals[r0:r1] {
+			score += la[v*let+v]
+		}
+		aln = appendPair(aln, &featPair{
+			a:     feature{start: r0, end: r1},
+			b:     feature{start: q0, end: q1},
+			score: score,
+		})
+		ri, qj = r1, q1
+	}
+
+	return aln, nil
+}
+
+// slicer is a minimal AlphabetSlicer.
+type slicer struct {
+	alpha alphabet.Alphabet
+	s     alphabet.Slice
+}
+
+func (s slicer) Alphabet() alphabet.Alphabet { return s.alpha }
+func (s slicer) Slice() alphabet.Slice       { return s.s }
+
+// appendPair appends fp to aln, merging it with the last feature pair of aln if
+// they are contiguous and describe the same type of alignment segment.
+func appendPair(aln []feat.Pair, fp *featPair) []feat.Pair {
+	if len(aln) != 0 {
+		last := aln[len(aln)-1].(*featPair)
+		if last.a.end == fp.a.start && last.b.end == fp.b.start &&
+			(last.a.Len() != 0) == (fp.a.Len() != 0) && (last.b.Len() != 0) == (fp.b.Len() != 0) {
+			last.a.end = fp.a.end
+			last.b.end = fp.b.end
+			last.score += fp.score
+			return aln
+		}
+	}
+	return append(aln, fp)
+}
+
+// anchor is an exact seed match between reference and query.
+type anchor struct {
+	r, q int
+}
+
+// seed is a K-mer at a position in a sequence.
+type seed struct {
+	pos int
+	key string
+}
+
+// seeds returns the K-mer seeds of vals. If w is greater than one, only the
+// (w,k)-minimizers of the nucleic acid letters of sl, found by kmerindex,
+// are returned.
+func seeds(sl alphabet.Slice, alpha alphabet.Alphabet, vals []int, k, w int) ([]seed, error) {
+	if len(vals) < k {
+		return nil, nil
+	}
+	key := func(i int) string {
+		b := make([]byte, k)
+		for j, v := range vals[i : i+k] {
+			b[j] = byte(v)
+		}
+		return string(b)
+	}
+	if w <= 1 {
+		kmers := make([]seed, len(vals)-k+1)
+		for i := range kmers {
+			kmers[i] = seed{pos: i, key: key(i)}
+		}
+		return kmers, nil
+	}
+
+	// Minimizers are found over the ungapped
+	// alphabet so that each base is a 2-bit
+	// value and gaps and ambiguous letters
+	// break k-mers.
+	var nucl alphabet.Alphabet
+	switch alpha.Moltype() {
+	case feat.DNA:
+		nucl = alphabet.DNA
+	case feat.RNA:
+		nucl = alphabet.RNA
+	default:
+		return nil, ErrNotNucleic
+	}
+	var mins []seed
+	err := kmerindex.ForEachMinimizer(linear.NewSeq("", letters(sl), nucl), k, 0, len(vals), w, func(pos int, _ kmerindex.Kmer) {
+		mins = append(mins, seed{pos: pos, key: key(pos)})
+	})
+	return mins, err
+}
+
+// anchors returns all seed matches between the reference and query letter
+// indices, sorted by reference and then query position.
+func (a Seeded) anchors(alpha alphabet.Alphabet, rSl, qSl alphabet.Slice, rVals, qVals []int) ([]anchor, error) {
+	rSeeds, err := seeds(rSl, alpha, rVals, a.K, a.W)
+	if err != nil {
+		return nil, err
+	}
+	qSeeds, err := seeds(qSl, alpha, qVals, a.K, a.W)
+	if err != nil {
+		return nil, err
+	}
+	idx := make(map[string][]int)
+	for _, s := range rSeeds {
+		idx[s.key] = append(idx[s.key], s.pos)
+	}
+	var an []anchor
+	for _, s := range qSeeds {
+		for _, r := range idx[s.key] {
+			an = append(an, anchor{r: r, q: s.pos})
+		}
+	}
+	sort.Sort(anchors(an))
+	return an, nil
+}
+
+// letters returns the letters of s, which must be an alphabet.Letters or
+// alphabet.QLetters.
+func letters(s alphabet.Slice) alphabet.Letters {
+	switch s := s.(type) {
+	case alphabet.Letters:
+		return s
+	case alphabet.QLetters:
+		l := make(alphabet.Letters, len(s))
+		for i, ql := range s {
+			l[i] = ql.L
+		}
+		return l
+	}
+	panic("align: unexpected slice type")
+}
+
+// anchors sorts a slice of anchor by reference and then query position.
+type anchors []anchor
+
+func (a anchors) Len() int { return len(a) }
+func (a anchors) Less(i, j int) bool {
+	if a[i].r != a[j].r {
+		return a[i].r < a[j].r
+	}
+	return a[i].q < a[j].q
+}
+func (a anchors) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+
+// chain returns the highest scoring collinear chain of anchors in an. Each anchor
+// contributes the number of new letters it covers, and joining anchors costs the
+// difference between the reference and query distances between them.
+func (a Seeded) chain(an []anchor) []anchor {
+	if len(an) == 0 {
+		return nil
+	}
+	var (
+		score = make([]int, len(an))
+		prev  = make([]int, len(an))
+		best  int
+	)
+	for i, ai := range an {
+		score[i], prev[i] = a.K, -1
+		for j := i - 1; j >= 0 && j >= i-chainLookback; j-- {
+			aj := an[j]
+			dr, dq := ai.r-aj.r, ai.q-aj.q
+			if dr <= 0 || dq <= 0 {
+				continue
+			}
+			gain := dr
+			if dq < gain {
+				gain = dq
+			}
+			if a.K < gain {
+				gain = a.K
+			}
+			cost := dr - dq
+			if cost < 0 {
+				cost = -cost
+			}
+			if s := score[j] + gain - cost; s > score[i] {
+				score[i], prev[i] = s, j
+			}
+		}
+		if score[i] > score[best] {
+			best = i
+		}
+	}
+
+	var chain []anchor
+	for i := best; i >= 0; i = prev[i] {
+		chain = append(chain, an[i])
+	}
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain
+}
@@ -0,0 +1,41 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleSeeded_Align() {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("TTTTTGATTACAGGCATGCCATTCAGCTAGGCTTTTTT")), alphabet.DNAgapped)
+	read := linear.NewSeq("read", alphabet.BytesToLetters([]byte("GATTACAGGCATGATCCATTCAGCTAGG")), alphabet.DNAgapped)
+
+	seeded := Seeded{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 1, -1, -1, -1},
+			{-1, -1, 1, -1, -1},
+			{-1, -1, -1, 1, -1},
+			{-1, -1, -1, -1, 1},
+		},
+		K:     5,
+		Width: 2,
+	}
+	aln, err := seeded.Align(ref, read)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(aln)
+	fa := Format(ref, read, aln, '-')
+	fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	// Output:
+	// [[5,18)/[0,13)=13 -/[13,15)=-2 [18,31)/[15,28)=13]
+	// GATTACAGGCATG--CCATTCAGCTAGG
+	// GATTACAGGCATGATCCATTCAGCTAGG
+}
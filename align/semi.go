@@ -0,0 +1,59 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+)
+
+// Setting debugSemiGlobal to true gives verbose scoring table output for the dynamic programming.
+const debugSemiGlobal = false
+
+// SemiGlobal is the linear gap penalty semi-global Needleman-Wunsch aligner type.
+// Gaps at the ends of the alignment are scored according to EndGaps, which holds the
+// per-letter gap penalty for each end indexed by RefStart, RefEnd, QueryStart and
+// QueryEnd. The zero value of EndGaps leaves all ends free. Interior gaps are scored
+// using Matrix.
+//
+// Leaving QueryStart and QueryEnd free places the reference within the query, leaving
+// RefStart and RefEnd free places the query within the reference, and leaving RefStart
+// and QueryEnd free finds a suffix of the reference overlapping a prefix of the query.
+type SemiGlobal struct {
+	Matrix  Linear
+	EndGaps [4]int
+}
+
+// Align aligns two sequences using a semi-global modification of the Needleman-Wunsch
+// algorithm. It returns an alignment description or an error if the scoring matrix is
+// not square, or the sequence data types or alphabets do not match.
+func (a SemiGlobal) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	switch rSeq := reference.Slice().(type) {
+	case alphabet.Letters:
+		qSeq, ok := query.Slice().(alphabet.Letters)
+		if !ok {
+			return nil, ErrMismatchedTypes
+		}
+		return a.alignLetters(rSeq, qSeq, alpha)
+	case alphabet.QLetters:
+		qSeq, ok := query.Slice().(alphabet.QLetters)
+		if !ok {
+			return nil, ErrMismatchedTypes
+		}
+		return a.alignQLetters(rSeq, qSeq, alpha)
+	default:
+		return nil, ErrTypeNotHandled
+	}
+}
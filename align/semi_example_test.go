@@ -0,0 +1,53 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleSemiGlobal_Align() {
+	// The end of the contig overlaps the start of the read.
+	contig := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GGTCATTACAGGAC"))}
+	contig.Alpha = alphabet.DNAgapped
+	read := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ACAGGACTTGCA"))}
+	read.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-5	-5	-5	-5
+	// A	-5	 2	-3	-3	-3
+	// C	-5	-3	 2	-3	-3
+	// G	-5	-3	-3	 2	-3
+	// T	-5	-3	-3	-3	 2
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 2, -3, -3, -3},
+		{-5, -3, 2, -3, -3},
+		{-5, -3, -3, 2, -3},
+		{-5, -3, -3, -3, 2},
+	}
+
+	// Leave the leading contig and trailing read letters unpenalised,
+	// and fully penalise the other ends.
+	overlap := SemiGlobal{
+		Matrix:  m,
+		EndGaps: [4]int{RefStart: 0, RefEnd: -5, QueryStart: -5, QueryEnd: 0},
+	}
+
+	aln, err := overlap.Align(contig, read)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(contig, read, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[0,7)/-=0 [7,14)/[0,7)=14 -/[7,12)=0]
+	// GGTCATTACAGGAC-----
+	// -------ACAGGACTTGCA
+}
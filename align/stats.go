@@ -0,0 +1,147 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"fmt"
+)
+
+// A Denominator specifies the number of columns used as the denominator when
+// calculating alignment identity and similarity.
+type Denominator int
+
+const (
+	AlignedColumns  Denominator = iota // Columns with letters in both sequences.
+	AlignmentLength                    // All columns, including gap columns.
+	ShorterLength                      // Letters of the shorter aligned sequence region.
+	MeanLength                         // Mean number of letters of the aligned sequence regions.
+)
+
+// Stats holds summary statistics for an alignment.
+type Stats struct {
+	Columns   int // Number of alignment columns, including gap columns.
+	Aligned   int // Number of columns with letters in both sequences.
+	Identical int // Number of aligned columns with identical letters.
+	Similar   int // Number of aligned columns with a positive substitution score.
+
+	RefLetters   int // Number of reference letters in the alignment.
+	QueryLetters int // Number of query letters in the alignment.
+
+	Gaps       int         // Number of gaps.
+	GapLetters int         // Number of letters aligned against gaps.
+	GapLengths map[int]int // Number of gaps of each length.
+}
+
+// NewStats returns alignment statistics for the alignment of reference and query described
+// by aln. Letters are compared according to their alphabet index. If matrix is not nil,
+// aligned letter pairs with a positive score in matrix are counted as similar, otherwise
+// only identical letters are counted as similar. Reference and query segments aligned
+// against gaps are each counted as a gap. It returns an error if the scoring matrix is
+// not square, the alphabets do not match or a letter is not valid.
+func NewStats(reference, query AlphabetSlicer, aln []feat.Pair, matrix Linear) (*Stats, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if matrix != nil {
+		if len(matrix) < alpha.Len() {
+			return nil, ErrMatrixWrongSize{Size: len(matrix), Len: alpha.Len()}
+		}
+		for _, row := range matrix {
+			if len(row) != len(matrix) {
+				return nil, ErrMatrixNotSquare
+			}
+		}
+	}
+	index := alpha.LetterIndex()
+	rVals, err := letterIndices(reference.Slice(), index, "rSeq")
+	if err != nil {
+		return nil, err
+	}
+	qVals, err := letterIndices(query.Slice(), index, "qSeq")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Stats{GapLengths: make(map[int]int)}
+	for _, fp := range aln {
+		f := fp.Features()
+		rl, ql := f[0].Len(), f[1].Len()
+		s.RefLetters += rl
+		s.QueryLetters += ql
+		switch {
+		case rl != 0 && ql != 0:
+			if rl != ql {
+				return nil, fmt.Errorf("align: aligned segment lengths differ: %d != %d", rl, ql)
+			}
+			s.Columns += rl
+			s.Aligned += rl
+			for i, j := f[0].Start(), f[1].Start(); i < f[0].End(); i, j = i+1, j+1 {
+				r, q := rVals[i], qVals[j]
+				if r == q {
+					s.Identical++
+				}
+				if matrix == nil {
+					if r == q {
+						s.Similar++
+					}
+				} else if matrix[r][q] > 0 {
+					s.Similar++
+				}
+			}
+		case rl != 0, ql != 0:
+			n := rl + ql
+			s.Columns += n
+			s.Gaps++
+			s.GapLetters += n
+			s.GapLengths[n]++
+		}
+	}
+	return s, nil
+}
+
+// denominator returns the number of columns described by d.
+func (s *Stats) denominator(d Denominator) float64 {
+	switch d {
+	case AlignedColumns:
+		return float64(s.Aligned)
+	case AlignmentLength:
+		return float64(s.Columns)
+	case ShorterLength:
+		if s.RefLetters < s.QueryLetters {
+			return float64(s.RefLetters)
+		}
+		return float64(s.QueryLetters)
+	case MeanLength:
+		return float64(s.RefLetters+s.QueryLetters) / 2
+	default:
+		panic("align: invalid denominator")
+	}
+}
+
+// Identity returns the fraction of identical columns in the alignment, calculated
+// using the denominator d. If the denominator is zero, Identity returns zero.
+func (s *Stats) Identity(d Denominator) float64 {
+	n := s.denominator(d)
+	if n == 0 {
+		return 0
+	}
+	return float64(s.Identical) / n
+}
+
+// Similarity returns the fraction of similar columns in the alignment, calculated
+// using the denominator d. If the denominator is zero, Similarity returns zero.
+func (s *Stats) Similarity(d Denominator) float64 {
+	n := s.denominator(d)
+	if n == 0 {
+		return 0
+	}
+	return float64(s.Similar) / n
+}
@@ -0,0 +1,49 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleNewStats() {
+	nwsa := linear.NewSeq("a", alphabet.BytesToLetters([]byte("AGACTAGTTA")), alphabet.DNAgapped)
+	nwsb := linear.NewSeq("b", alphabet.BytesToLetters([]byte("GACAGACG")), alphabet.DNAgapped)
+
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	aln, err := NW(m).Align(nwsa, nwsb)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fa := Format(nwsa, nwsb, aln, '-')
+	fmt.Printf("%s\n%s\n", fa[0], fa[1])
+
+	s, err := NewStats(nwsa, nwsb, aln, m)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("columns=%d aligned=%d identical=%d similar=%d gaps=%d gap lengths=%v\n",
+		s.Columns, s.Aligned, s.Identical, s.Similar, s.Gaps, s.GapLengths)
+	fmt.Printf("identity: %.3f (aligned) %.3f (alignment length) %.3f (shorter)\n",
+		s.Identity(AlignedColumns), s.Identity(AlignmentLength), s.Identity(ShorterLength))
+	fmt.Printf("similarity: %.3f (aligned)\n", s.Similarity(AlignedColumns))
+	// Output:
+	// AGACTAGTTA
+	// -GAC-AGACG
+	// columns=10 aligned=8 identical=5 similar=5 gaps=2 gap lengths=map[1:2]
+	// identity: 0.625 (aligned) 0.500 (alignment length) 0.625 (shorter)
+	// similarity: 0.625 (aligned)
+}
@@ -0,0 +1,322 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"math"
+)
+
+// A Scorer returns the score of an optimal alignment of two sequences without
+// performing a traceback.
+type Scorer interface {
+	Score(reference, query AlphabetSlicer) (int, error)
+}
+
+var _ Scorer = StripedSW{}
+
+// lanes is the number of 16-bit lanes in a striped vector, corresponding
+// to a 128-bit SIMD register.
+const lanes = 8
+
+// vec16 is a vector of saturating 16-bit scores.
+type vec16 [lanes]int16
+
+func satAdd(a, b int16) int16 {
+	s := int32(a) + int32(b)
+	switch {
+	case s > math.MaxInt16:
+		return math.MaxInt16
+	case s < math.MinInt16:
+		return math.MinInt16
+	}
+	return int16(s)
+}
+
+func max16(a, b int16) int16 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func (v vec16) add(u vec16) vec16 {
+	for i := range v {
+		v[i] = satAdd(v[i], u[i])
+	}
+	return v
+}
+
+func (v vec16) addScalar(s int16) vec16 {
+	for i := range v {
+		v[i] = satAdd(v[i], s)
+	}
+	return v
+}
+
+func (v vec16) max(u vec16) vec16 {
+	for i := range v {
+		v[i] = max16(v[i], u[i])
+	}
+	return v
+}
+
+// shift returns v with each lane moved up by one and fill in the first lane.
+func (v vec16) shift(fill int16) vec16 {
+	copy(v[1:], v[:lanes-1])
+	v[0] = fill
+	return v
+}
+
+func (v vec16) anyGreater(u vec16) bool {
+	for i := range v {
+		if v[i] > u[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func (v vec16) hmax() int16 {
+	m := v[0]
+	for _, s := range v[1:] {
+		m = max16(m, s)
+	}
+	return m
+}
+
+func splat(s int16) vec16 {
+	var v vec16
+	for i := range v {
+		v[i] = s
+	}
+	return v
+}
+
+// StripedSW is the affine gap penalty Smith-Waterman score-only aligner type. Gaps are
+// scored as described for Affine.
+//
+// StripedSW uses Farrar's striped query profile formulation (Bioinformatics 23:156-161)
+// with saturating 16-bit lanes. Lanes are operated on in portable Go rather than with
+// SIMD instructions. When a score cannot be represented in 16 bits, the calculation
+// falls back to full width integer arithmetic.
+type StripedSW Affine
+
+// Score returns the optimal local alignment score of reference and query, or an error if
+// the scoring matrix is not square, or the sequence data types or alphabets do not match.
+// No traceback is performed.
+func (a StripedSW) Score(reference, query AlphabetSlicer) (int, error) {
+	p, err := a.Profile(query)
+	if err != nil {
+		return 0, err
+	}
+	return p.Score(reference)
+}
+
+// A StripedProfile is a striped query profile. A profile may be reused to score a
+// single query against many references.
+type StripedProfile struct {
+	alpha alphabet.Alphabet
+
+	la      []int
+	let     int
+	gapOpen int
+	query   []int
+
+	// wide indicates that the scoring system cannot
+	// be represented by 16-bit lanes.
+	wide bool
+
+	segLen int
+	sub    [][]vec16 // Striped substitution scores indexed by reference letter.
+	gap    []vec16   // Striped query letter gap scores.
+}
+
+// Profile returns a striped query profile for query using the scoring system of the receiver.
+func (a StripedSW) Profile(query AlphabetSlicer) (*StripedProfile, error) {
+	alpha := query.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	let := len(a.Matrix)
+	if let < alpha.Len() {
+		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
+	}
+	p := &StripedProfile{
+		alpha:   alpha,
+		la:      make([]int, 0, let*let),
+		let:     let,
+		gapOpen: a.GapOpen,
+	}
+	for _, row := range a.Matrix {
+		if len(row) != let {
+			return nil, ErrMatrixNotSquare
+		}
+		p.la = append(p.la, row...)
+	}
+	var err error
+	p.query, err = letterIndices(query.Slice(), alpha.LetterIndex(), "qSeq")
+	if err != nil {
+		return nil, err
+	}
+
+	const limit = math.MaxInt16 / 2
+	if a.GapOpen < -limit || a.GapOpen > limit {
+		p.wide = true
+	}
+	for _, s := range p.la {
+		if s < -limit || s > limit {
+			p.wide = true
+		}
+	}
+	if p.wide {
+		return p, nil
+	}
+
+	n := len(p.query)
+	p.segLen = (n + lanes - 1) / lanes
+	if p.segLen == 0 {
+		p.segLen = 1
+	}
+	p.sub = make([][]vec16, let)
+	for r := range p.sub {
+		p.sub[r] = make([]vec16, p.segLen)
+		for s := range p.sub[r] {
+			for l := range p.sub[r][s] {
+				if j := l*p.segLen + s; j < n {
+					p.sub[r][s][l] = int16(p.la[r*let+p.query[j]])
+				} else {
+					p.sub[r][s][l] = math.MinInt16
+				}
+			}
+		}
+	}
+	p.gap = make([]vec16, p.segLen)
+	for s := range p.gap {
+		for l := range p.gap[s] {
+			if j := l*p.segLen + s; j < n {
+				p.gap[s][l] = int16(p.la[p.query[j]])
+			} else {
+				p.gap[s][l] = math.MinInt16
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// Score returns the optimal local alignment score of reference and the profiled query,
+// or an error if the reference alphabet does not match the query alphabet.
+func (p *StripedProfile) Score(reference AlphabetSlicer) (int, error) {
+	if reference.Alphabet() != p.alpha {
+		return 0, ErrMismatchedAlphabets
+	}
+	ref, err := letterIndices(reference.Slice(), p.alpha.LetterIndex(), "rSeq")
+	if err != nil {
+		return 0, err
+	}
+	if !p.wide {
+		if s, ok := p.score16(ref); ok {
+			return s, nil
+		}
+	}
+	return swAffineScore(p.la, p.let, p.gapOpen, ref, p.query), nil
+}
+
+// score16 returns the optimal local alignment score using 16-bit lanes. If the
+// score saturates, ok is returned false.
+func (p *StripedProfile) score16(ref []int) (score int, ok bool) {
+	var (
+		segLen = p.segLen
+		open   = int16(p.gapOpen)
+
+		hLoad  = make([]vec16, segLen)
+		hStore = make([]vec16, segLen)
+		e      = make([]vec16, segLen)
+
+		zero, vMax vec16
+		negInf     = splat(math.MinInt16)
+	)
+	for s := range e {
+		e[s] = negInf
+	}
+
+	for _, rVal := range ref {
+		var (
+			sub = p.sub[rVal]
+			gr  = int16(p.la[rVal*p.let])
+		)
+		hLoad, hStore = hStore, hLoad
+		f := negInf
+		h := hLoad[segLen-1].shift(0)
+		for s := 0; s < segLen; s++ {
+			// Gaps in the query, extending from the previous row.
+			e[s] = hLoad[s].addScalar(open).max(e[s]).addScalar(gr)
+
+			h = h.add(sub[s]).max(e[s]).max(f).max(zero)
+			vMax = vMax.max(h)
+			hStore[s] = h
+
+			// Gaps in the reference, extending to the next query position.
+			if s+1 < segLen {
+				f = h.addScalar(open).max(f).add(p.gap[s+1])
+			} else {
+				f = h.addScalar(open).max(f).shift(math.MinInt16).add(p.gap[0])
+			}
+			h = hLoad[s]
+		}
+
+		// Lazily correct for gaps in the reference that cross segment boundaries.
+		for s := 0; f.anyGreater(hStore[s].addScalar(open)); {
+			hStore[s] = hStore[s].max(f)
+			vMax = vMax.max(hStore[s])
+			if s++; s == segLen {
+				s = 0
+				f = f.shift(math.MinInt16).add(p.gap[0])
+			} else {
+				f = f.add(p.gap[s])
+			}
+		}
+	}
+
+	m := vMax.hmax()
+	return int(m), m < math.MaxInt16
+}
+
+// swAffineScore returns the optimal affine gap local alignment score of the
+// reference and query letter indices using full width integer arithmetic.
+func swAffineScore(la []int, let, gapOpen int, ref, query []int) int {
+	c := len(query) + 1
+	var (
+		h    = make([]int, c)
+		e    = make([]int, c)
+		best int
+	)
+	for j := range e {
+		e[j] = minInt
+	}
+	for _, rVal := range ref {
+		gr := la[rVal*let]
+		diagH, f := 0, minInt
+		for j := 1; j < c; j++ {
+			qVal := query[j-1]
+			e[j] = max2(add(h[j], gapOpen+gr), add(e[j], gr))
+			f = max2(add(h[j-1], gapOpen+la[qVal]), add(f, la[qVal]))
+			v := max3(diagH+la[rVal*let+qVal], e[j], f)
+			if v < 0 {
+				v = 0
+			}
+			diagH, h[j] = h[j], v
+			if v > best {
+				best = v
+			}
+		}
+	}
+	return best
+}
@@ -12,9 +12,9 @@ import (
 // Setting debugSmith to true gives verbose scoring table output for the dynamic programming.
 const debugSmith = false
 
-// SW is the Smith-Waterman aligner type.
-// Matrix is a square scoring matrix with the last column and last row specifying gap penalties.
-// Currently gap opening is not considered.
+// SW is the linear gap penalty Smith-Waterman aligner type.
+// Matrix is a square scoring matrix with the first column and first row specifying gap penalties.
+// Gap opening is not considered; SWAffine provides affine gap penalty local alignment.
 type SW Linear
 
 // Align aligns two sequences using the Smith-Waterman algorithm. It returns an alignment description
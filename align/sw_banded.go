@@ -0,0 +1,53 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+)
+
+// Setting debugSmithBanded to true gives verbose scoring table output for the dynamic programming.
+const debugSmithBanded = false
+
+// SWBanded is the linear gap penalty banded Smith-Waterman aligner type.
+type SWBanded Band
+
+// Align aligns two sequences using the Smith-Waterman algorithm restricted to a band of
+// diagonals, requiring O(n·k) time and memory for a band of k diagonals. The band is centred
+// on the main diagonal of the dynamic programming table.
+// It returns an alignment description or an error if the scoring matrix is not square, the band width
+// is negative, or the sequence data types or alphabets do not match.
+func (a SWBanded) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	if a.Width < 0 {
+		return nil, ErrNegativeBandWidth
+	}
+	switch rSeq := reference.Slice().(type) {
+	case alphabet.Letters:
+		qSeq, ok := query.Slice().(alphabet.Letters)
+		if !ok {
+			return nil, ErrMismatchedTypes
+		}
+		return a.alignLetters(rSeq, qSeq, alpha)
+	case alphabet.QLetters:
+		qSeq, ok := query.Slice().(alphabet.QLetters)
+		if !ok {
+			return nil, ErrMismatchedTypes
+		}
+		return a.alignQLetters(rSeq, qSeq, alpha)
+	default:
+		return nil, ErrTypeNotHandled
+	}
+}
@@ -106,3 +106,37 @@ func ExampleSWAffine_Align() {
 	// ATAGGAA
 	// ATTGGCA
 }
+
+func ExampleSWBanded_Align() {
+	swsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ACACACTA"))}
+	swsa.Alpha = alphabet.DNAgapped
+	swsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("AGCACACA"))}
+	swsb.Alpha = alphabet.DNAgapped
+
+	// w(gap) = -1
+	// w(match) = +2
+	// w(mismatch) = -1
+	//
+	// Band width: 2
+	smith := SWBanded{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 2, -1, -1, -1},
+			{-1, -1, 2, -1, -1},
+			{-1, -1, -1, 2, -1},
+			{-1, -1, -1, -1, 2},
+		},
+		Width: 2,
+	}
+
+	aln, err := smith.Align(swsa, swsb)
+	if err == nil {
+		fmt.Printf("%v\n", aln)
+		fa := Format(swsa, swsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[0,1)/[0,1)=2 -/[1,2)=-1 [1,6)/[2,7)=10 [6,7)/-=-1 [7,8)/[7,8)=2]
+	// A-CACACTA
+	// AGCACAC-A
+}
@@ -0,0 +1,252 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"errors"
+	"fmt"
+)
+
+// ErrNegativeDrop is returned by XDrop when a drop threshold is negative.
+var ErrNegativeDrop = errors.New("align: negative drop threshold")
+
+// XDrop is the linear gap penalty X-drop extension aligner type.
+type XDrop struct {
+	Matrix Linear
+
+	// X is the X-drop threshold. Cells of the dynamic
+	// programming table scoring more than X below the
+	// best score seen are not extended.
+	X int
+
+	// Z is the Z-drop threshold. If Z is positive,
+	// extension terminates when the best score in a row
+	// is more than Z, plus the smallest per-letter gap
+	// penalty for each letter of difference between the
+	// diagonals of the row best and the overall best,
+	// below the overall best score.
+	Z int
+}
+
+// xdropRow is a row of an X-drop dynamic programming table holding
+// the scores and traceback moves for columns lo to lo+len(score).
+type xdropRow struct {
+	lo    int
+	score []int
+	move  []byte
+}
+
+func (r xdropRow) at(j int) int {
+	if j < r.lo || j >= r.lo+len(r.score) {
+		return minInt
+	}
+	return r.score[j-r.lo]
+}
+
+// Align extends an alignment from the start of reference and query, returning the
+// highest scoring alignment of prefixes of the two sequences. Cells of the dynamic
+// programming table that fall more than X below the best score are pruned, and
+// extension terminates when no cells remain or, if Z is positive, the Z-drop
+// condition is met. To extend an alignment leftwards from a seed, the reversed
+// sequences preceding the seed should be aligned. It returns an alignment
+// description or an error if the scoring matrix is not square, a drop threshold
+// is negative, or the sequence data types or alphabets do not match.
+func (a XDrop) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	if a.X < 0 || a.Z < 0 {
+		return nil, ErrNegativeDrop
+	}
+	let := len(a.Matrix)
+	if let < alpha.Len() {
+		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
+	}
+	la := make([]int, 0, let*let)
+	for _, row := range a.Matrix {
+		if len(row) != let {
+			return nil, ErrMatrixNotSquare
+		}
+		la = append(la, row...)
+	}
+
+	rSl, qSl := reference.Slice(), query.Slice()
+	switch rSl.(type) {
+	case alphabet.Letters:
+		if _, ok := qSl.(alphabet.Letters); !ok {
+			return nil, ErrMismatchedTypes
+		}
+	case alphabet.QLetters:
+		if _, ok := qSl.(alphabet.QLetters); !ok {
+			return nil, ErrMismatchedTypes
+		}
+	default:
+		return nil, ErrTypeNotHandled
+	}
+	index := alpha.LetterIndex()
+	rVals, err := letterIndices(rSl, index, "rSeq")
+	if err != nil {
+		return nil, err
+	}
+	qVals, err := letterIndices(qSl, index, "qSeq")
+	if err != nil {
+		return nil, err
+	}
+
+	// The smallest per-letter gap penalty is used for Z-drop.
+	gapPen := minInt
+	for l := 1; l < alpha.Len(); l++ {
+		gapPen = max3(gapPen, la[l*let], la[l])
+	}
+	if gapPen = -gapPen; gapPen < 0 {
+		gapPen = 0
+	}
+
+	var (
+		c = len(qVals) + 1
+
+		rows = []xdropRow{{lo: 0, score: []int{0}, move: []byte{diag}}}
+
+		best, bestI, bestJ int
+	)
+	for j := 1; j < c; j++ {
+		v := rows[0].score[j-1] + la[qVals[j-1]]
+		if v < best-a.X {
+			break
+		}
+		rows[0].score = append(rows[0].score, v)
+		rows[0].move = append(rows[0].move, left)
+	}
+
+	for i := 1; i <= len(rVals); i++ {
+		var (
+			prev = rows[i-1]
+			rVal = rVals[i-1]
+			cur  = xdropRow{lo: prev.lo}
+
+			rowBest  = minInt
+			rowBestJ int
+		)
+		for j := prev.lo; j < c; j++ {
+			var (
+				v    = add(prev.at(j), la[rVal*let])
+				move = byte(up)
+			)
+			if j > 0 {
+				qVal := qVals[j-1]
+				if s := add(prev.at(j-1), la[rVal*let+qVal]); s >= v {
+					v, move = s, diag
+				}
+				if j > cur.lo {
+					if s := add(cur.score[j-1-cur.lo], la[qVal]); s > v {
+						v, move = s, left
+					}
+				}
+			}
+			if v != minInt && v < best-a.X {
+				v = minInt
+			}
+			if v == minInt {
+				if len(cur.score) == 0 {
+					// Trim dead cells from the start of the row.
+					cur.lo++
+					continue
+				}
+				if j >= prev.lo+len(prev.score) {
+					// No further cells in this row can be reached.
+					break
+				}
+			}
+			cur.score = append(cur.score, v)
+			cur.move = append(cur.move, move)
+			if v > rowBest {
+				rowBest, rowBestJ = v, j
+			}
+		}
+		// Trim dead cells from the end of the row.
+		for len(cur.score) != 0 && cur.score[len(cur.score)-1] == minInt {
+			cur.score = cur.score[:len(cur.score)-1]
+			cur.move = cur.move[:len(cur.move)-1]
+		}
+		if len(cur.score) == 0 {
+			break
+		}
+		rows = append(rows, cur)
+
+		if rowBest > best {
+			best, bestI, bestJ = rowBest, i, rowBestJ
+		} else if a.Z > 0 {
+			d := (i - bestI) - (rowBestJ - bestJ)
+			if d < 0 {
+				d = -d
+			}
+			if best-rowBest > a.Z+gapPen*d {
+				break
+			}
+		}
+	}
+
+	var ops []byte
+	for i, j := bestI, bestJ; i > 0 || j > 0; {
+		row := rows[i]
+		switch op := row.move[j-row.lo]; op {
+		case diag:
+			i--
+			j--
+			ops = append(ops, op)
+		case up:
+			i--
+			ops = append(ops, op)
+		case left:
+			j--
+			ops = append(ops, op)
+		default:
+			panic(fmt.Sprintf("align: x-drop internal error: no path at row: %d col:%d\n", i, j))
+		}
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	var (
+		aln  []feat.Pair
+		i, j int
+	)
+	for p := 0; p < len(ops); {
+		op := ops[p]
+		si, sj, score := i, j, 0
+		for ; p < len(ops) && ops[p] == op; p++ {
+			switch op {
+			case diag:
+				score += la[rVals[i]*let+qVals[j]]
+				i++
+				j++
+			case up:
+				score += la[rVals[i]*let]
+				i++
+			case left:
+				score += la[qVals[j]]
+				j++
+			}
+		}
+		aln = append(aln, &featPair{
+			a:     feature{start: si, end: i},
+			b:     feature{start: sj, end: j},
+			score: score,
+		})
+	}
+
+	return aln, nil
+}
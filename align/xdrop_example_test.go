@@ -0,0 +1,40 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleXDrop_Align() {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("ACGTTACGGTACCATTTTTTTTTTTT")), alphabet.DNAgapped)
+	query := linear.NewSeq("query", alphabet.BytesToLetters([]byte("ACGTACGGTACTATGGGGCCCCGGGGCCCC")), alphabet.DNAgapped)
+
+	xdrop := XDrop{
+		Matrix: Linear{
+			{0, -2, -2, -2, -2},
+			{-2, 1, -1, -1, -1},
+			{-2, -1, 1, -1, -1},
+			{-2, -1, -1, 1, -1},
+			{-2, -1, -1, -1, 1},
+		},
+		X: 4,
+	}
+	aln, err := xdrop.Align(ref, query)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(aln)
+	fa := Format(ref, query, aln, '-')
+	fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	// Output:
+	// [[0,3)/[0,3)=3 [3,4)/-=-2 [4,15)/[3,14)=9]
+	// ACGTTACGGTACCAT
+	// ACG-TACGGTACTAT
+}
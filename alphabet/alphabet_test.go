@@ -5,6 +5,8 @@
 package alphabet
 
 import (
+	"github.com/biogo/biogo/feat"
+
 	"strings"
 	"testing"
 	"unicode"
@@ -25,7 +27,7 @@ func (s *S) TestInterfaces(c *check.C) {
 		comp  Complementor
 	)
 
-	for _, a := range []interface{}{DNA, RNA, Protein} {
+	for _, a := range []interface{}{DNA, RNA, Protein, RY, Murphy10} {
 		c.Check(a, check.Implements, &alpha)
 	}
 
@@ -64,6 +66,8 @@ func (s *S) TestLetter(c *check.C) {
 		DNA,
 		RNA,
 		Protein,
+		RY,
+		Murphy10,
 	} {
 		for i := 0; i < t.Len(); i++ {
 			c.Check(t.IndexOf(t.Letter(i)), check.Equals, i,
@@ -89,6 +93,78 @@ func (s *S) TestComplement(c *check.C) {
 	}
 }
 
+func (s *S) TestIUPAC(c *check.C) {
+	var codes []Letter
+	for _, l := range DNAredundant.Letters() {
+		if l != '-' {
+			codes = append(codes, Letter(l), Letter(unicode.ToUpper(l)))
+		}
+	}
+	for _, a := range codes {
+		bases := Expand(a)
+		c.Check(len(bases) > 0, check.Equals, true, check.Commentf("letter %c", a))
+		for _, b := range bases {
+			c.Check(unicode.IsUpper(rune(b)), check.Equals, unicode.IsUpper(rune(a)))
+			c.Check(Match(a, b), check.Equals, true)
+		}
+		for _, b := range codes {
+			var share bool
+			for _, x := range Expand(a) {
+				for _, y := range Expand(b) {
+					share = share || unicode.ToLower(rune(x)) == unicode.ToLower(rune(y))
+				}
+			}
+			c.Check(Match(a, b), check.Equals, share, check.Commentf("letters %c %c", a, b))
+		}
+	}
+	c.Check(Expand('u'), check.DeepEquals, []Letter{'u'})
+	c.Check(Match('u', 'T'), check.Equals, true)
+	for _, l := range []Letter{'-', 'x', 'e', 0} {
+		c.Check(Expand(l), check.IsNil)
+		c.Check(Match(l, 'n'), check.Equals, false)
+	}
+}
+
+func (s *S) TestReduced(c *check.C) {
+	for i := 0; i < 256; i++ {
+		l := Letter(i)
+		c.Check(RY.IsValid(l), check.Equals, strings.ContainsRune("ragyctuRAGYCTU", rune(i)))
+		c.Check(Murphy10.IsValid(l), check.Equals, Protein.IsValid(l) && !strings.ContainsRune("-bjxz*BJXZ", rune(i)),
+			check.Commentf("letter %q", l))
+	}
+	c.Check(RY.Len(), check.Equals, 2)
+	c.Check(RY.Letters(), check.Equals, "ragyctuRAGYCTU")
+	for _, t := range []struct {
+		in, out string
+		idx     []int
+	}{
+		{in: "acgtuRYN-", out: "ryryyRYN-", idx: []int{0, 1, 0, 1, 1, 0, 1, -1, -1}},
+	} {
+		l := BytesToLetters([]byte(t.in))
+		for i, v := range l {
+			c.Check(RY.IndexOf(v), check.Equals, t.idx[i])
+		}
+		RY.ReduceLetters(l)
+		c.Check(Letters(l).String(), check.Equals, t.out)
+	}
+	c.Check(Murphy10.Reduce('I'), check.Equals, Letter('L'))
+	c.Check(Murphy10.IndexOf('w'), check.Equals, Murphy10.IndexOf('F'))
+
+	for _, groups := range [][]string{
+		nil,
+		{"ag", ""},
+		{"ag", "ct", "ga"},
+		{"aé"},
+	} {
+		_, err := NewReduced(groups, feat.DNA, '-', 'n', !CaseSensitive)
+		c.Check(err, check.NotNil, check.Commentf("groups %q", groups))
+	}
+	r, err := NewReduced([]string{"ag", "AG"}, feat.DNA, '-', 'n', CaseSensitive)
+	c.Assert(err, check.Equals, nil)
+	c.Check(r.IndexOf('A'), check.Equals, 1)
+	c.Check(r.Reduce('G'), check.Equals, Letter('A'))
+}
+
 func (s *S) TestComplementDirect(c *check.C) {
 	for _, t := range []Complementor{
 		DNA,
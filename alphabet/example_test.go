@@ -34,3 +34,23 @@ func Example_complement() {
 	// u true
 	// t false
 }
+
+func Example_iupac() {
+	fmt.Printf("%s %s\n", Letters(Expand('r')), Letters(Expand('N')))
+	fmt.Println(Match('r', 'a'), Match('R', 'y'), Match('n', 'u'), Match('s', '-'))
+	// Output:
+	// ag ACGT
+	// true false true false
+}
+
+func Example_reduced() {
+	l := []Letter("MKVLITGAFW")
+	fmt.Println(Murphy10.AllValid(l))
+	Murphy10.ReduceLetters(l)
+	fmt.Println(Letters(l))
+	fmt.Println(Murphy10.IndexOf('f'), Murphy10.IndexOf('Y'), Murphy10.Len())
+	// Output:
+	// true -1
+	// LKLLLSGAFF
+	// 6 6 10
+}
@@ -0,0 +1,53 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alphabet
+
+// iupacSets holds bit sets of the concrete bases represented by each IUPAC
+// nucleotide code, with bits set for a, c, g and t from the least significant
+// bit. Letters that are not IUPAC nucleotide codes have an empty set.
+var iupacSets = func() (t [256]byte) {
+	for _, c := range []struct {
+		code  byte
+		bases byte
+	}{
+		{'a', 0x1}, {'c', 0x2}, {'g', 0x4}, {'t', 0x8}, {'u', 0x8},
+		{'r', 0x5}, {'y', 0xa}, {'s', 0x6}, {'w', 0x9}, {'k', 0xc}, {'m', 0x3},
+		{'b', 0xe}, {'d', 0xd}, {'h', 0xb}, {'v', 0x7},
+		{'n', 0xf},
+	} {
+		t[c.code] = c.bases
+		t[c.code-'a'+'A'] = c.bases
+	}
+	return t
+}()
+
+// Expand returns the concrete bases represented by the IUPAC nucleotide code l, in the order
+// a, c, g and t, with the case of l. The letters 'u' and 'U' are returned unaltered. Expand
+// returns nil if l is not an IUPAC nucleotide code.
+func Expand(l Letter) []Letter {
+	set := iupacSets[l]
+	if set == 0 {
+		return nil
+	}
+	if l == 'u' || l == 'U' {
+		return []Letter{l}
+	}
+	bases := "acgt"
+	if l < 'a' {
+		bases = "ACGT"
+	}
+	var e []Letter
+	for i := range bases {
+		if set&(1<<uint(i)) != 0 {
+			e = append(e, Letter(bases[i]))
+		}
+	}
+	return e
+}
+
+// Match returns whether the IUPAC nucleotide codes a and b represent at least one concrete
+// base in common. Letters are compared case-insensitively with 'u' read as 't'. Match returns
+// false if either letter is not an IUPAC nucleotide code.
+func Match(a, b Letter) bool { return iupacSets[a]&iupacSets[b] != 0 }
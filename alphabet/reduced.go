@@ -0,0 +1,128 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alphabet
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var (
+	// RY is the purine/pyrimidine coded nucleic acid alphabet. The
+	// letters r, a and g have index 0 and y, c, t and u have index 1.
+	RY = mustReduced(NewReduced(
+		[]string{"rag", "yctu"},
+		feat.DNA,
+		'-', 'n',
+		!CaseSensitive,
+	))
+
+	// Murphy10 is the 10 letter reduced amino acid alphabet of
+	// Murphy, Wallqvist and Levy, Protein Eng 13:149–152 (2000).
+	Murphy10 = mustReduced(NewReduced(
+		[]string{"lvim", "c", "a", "g", "st", "p", "fyw", "ednq", "kr", "h"},
+		feat.Protein,
+		'-', 'x',
+		!CaseSensitive,
+	))
+)
+
+func mustReduced(r *Reduced, err error) *Reduced {
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// A Reduced is an Alphabet that groups letters into classes. All the letters of a class
+// share an index, and validation, indexing and reduction of letters are performed with
+// 256 entry lookup tables.
+type Reduced struct {
+	*alpha
+	reps   []Letter
+	reduce [256]Letter
+}
+
+// NewReduced returns a new Reduced alphabet with a class for each element of groups. The
+// first letter of each group is the representative letter of its class, and the index of
+// each letter is the position of its group in groups. Letters must be within the ASCII range
+// and may appear in only one group. Letter parameter handling is otherwise the same as for
+// NewAlphabet.
+func NewReduced(groups []string, molType feat.Moltype, gap, ambiguous Letter, caseSensitive bool) (*Reduced, error) {
+	if len(groups) == 0 {
+		return nil, errors.New("alphabet: no letter groups")
+	}
+	a := &alpha{
+		length:        len(groups),
+		gap:           gap,
+		ambiguous:     ambiguous,
+		caseSensitive: caseSensitive,
+		molType:       molType,
+	}
+	for i := range a.index {
+		a.index[i] = -1
+	}
+	r := &Reduced{alpha: a, reps: make([]Letter, len(groups))}
+	for i := range r.reduce {
+		r.reduce[i] = Letter(i)
+	}
+
+	var letters []string
+	for i, g := range groups {
+		if g == "" {
+			return nil, fmt.Errorf("alphabet: empty letter group %d", i)
+		}
+		if strings.IndexFunc(g, func(r rune) bool { return r < 0 || r > unicode.MaxASCII }) > -1 {
+			return nil, errors.New("alphabet: letters contains non-ASCII rune")
+		}
+		if !caseSensitive {
+			g = strings.ToLower(g)
+		}
+		r.reps[i] = Letter(g[0])
+		for j := 0; j < len(g); j++ {
+			ls := []byte{g[j]}
+			if !caseSensitive {
+				ls = append(ls, byte(unicode.ToUpper(rune(g[j]))))
+			}
+			for k, l := range ls {
+				if a.valid[l] {
+					return nil, fmt.Errorf("alphabet: letter %q in more than one group", l)
+				}
+				a.valid[l] = true
+				a.index[l] = i
+				r.reduce[l] = r.reps[i]
+				if k != 0 {
+					r.reduce[l] = Letter(unicode.ToUpper(rune(r.reps[i])))
+				}
+			}
+		}
+		letters = append(letters, g)
+	}
+	a.letters = strings.Join(letters, "")
+	if !caseSensitive {
+		a.letters += strings.ToUpper(a.letters)
+	}
+
+	return r, nil
+}
+
+// Letter returns the representative letter of the class with index i.
+func (r *Reduced) Letter(i int) Letter { return r.reps[i] }
+
+// Reduce returns the representative letter of the class of l, preserving case for case
+// insensitive alphabets. Letters that are not valid are returned unaltered.
+func (r *Reduced) Reduce(l Letter) Letter { return r.reduce[l] }
+
+// ReduceLetters replaces each valid letter in l with the representative letter of its class
+// as described for Reduce.
+func (r *Reduced) ReduceLetters(l []Letter) {
+	for i, v := range l {
+		l[i] = r.reduce[v]
+	}
+}
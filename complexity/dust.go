@@ -0,0 +1,126 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package complexity
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/sequtils"
+
+	"fmt"
+	"math"
+)
+
+// Dust is a low complexity nucleotide sequence masker using the triplet scoring of the
+// symmetric DUST algorithm:
+//
+//	A fast and symmetric DUST implementation to mask low-complexity DNA sequences.
+//	 Aleksandr Morgulis, E. Michael Gertz, Alejandro A. Schäffer and Richa Agarwala. J Comput Biol 13:1028–1040 (2006).
+//
+// The score of an interval with l triplets is the sum over all triplets t of c_t(c_t-1)/2
+// divided by l-1, where c_t is the number of occurrences of t in the interval. An interval is
+// perfect if its score multiplied by 10 exceeds Level and none of its sub-intervals has a
+// higher score. The union of all perfect intervals no longer than Window bases is masked.
+type Dust struct {
+	// Window is the maximum length of a
+	// scored interval.
+	Window int
+
+	// Level is the score threshold
+	// scaled by a factor of 10.
+	Level float64
+}
+
+// DefaultDust is the Dust masker with the default parameters of dustmasker.
+var DefaultDust = Dust{Window: 64, Level: 20}
+
+// Mask returns the low complexity intervals of s. Triplets including bases other than a, c, g,
+// t and u are not scored, so low complexity intervals do not span ambiguous bases. It returns
+// an error if s is not a nucleic acid sequence or the window is shorter than four bases.
+func (d Dust) Mask(s seq.Sequence) (sequtils.Mask, error) {
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, fmt.Errorf("complex: sequence is not nucleic acid")
+	}
+	if d.Window < 4 {
+		return nil, fmt.Errorf("complex: dust window too short")
+	}
+
+	// Encode each triplet by the index of its
+	// first base, using -1 for triplets that
+	// include ambiguous bases.
+	n := s.Len()
+	trip := make([]int, max(0, n-2))
+	for i := range trip {
+		for j := 0; j < 3; j++ {
+			b := baseIndex(byte(s.At(s.Start() + i + j).L))
+			if b < 0 {
+				trip[i] = -1
+				break
+			}
+			trip[i] = trip[i]<<2 | b
+		}
+	}
+
+	// Find the perfect intervals, those that score
+	// above the threshold and have no sub-interval
+	// with a higher score. The best sub-interval
+	// scores of intervals starting at i are found
+	// from those of intervals starting at i+1.
+	var (
+		ivs    []feat.Feature
+		counts [64]int
+		maxLen = d.Window - 2
+		best   = make([]float64, maxLen)
+		prev   = make([]float64, maxLen)
+	)
+	for i := len(trip) - 1; i >= 0; i-- {
+		counts = [64]int{}
+		best, prev = prev, best
+		var sum, end int
+		for j := i; j < len(trip) && j-i < maxLen && trip[j] >= 0; j++ {
+			sum += counts[trip[j]]
+			counts[trip[j]]++
+			var score float64
+			if l := j - i + 1; l > 1 {
+				score = float64(sum) / float64(l-1)
+			}
+			sub := 0.
+			if j > i {
+				sub = math.Max(prev[j-i-1], best[j-i-1])
+			}
+			best[j-i] = math.Max(score, sub)
+			if score*10 > d.Level && score >= sub {
+				end = j + 3
+			}
+		}
+		if end != 0 {
+			ivs = append(ivs, &sequtils.MaskInterval{Loc: s, From: s.Start() + i, To: s.Start() + end})
+		}
+	}
+	return sequtils.NewMask(s, ivs...), nil
+}
+
+// baseIndex returns the index of the base b in "acgt" with u read as t, or -1 if b is not a
+// concrete base.
+func baseIndex(b byte) int {
+	switch b | ('a' - 'A') {
+	case 'a':
+		return 0
+	case 'c':
+		return 1
+	case 'g':
+		return 2
+	case 't', 'u':
+		return 3
+	}
+	return -1
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,63 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package complexity
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"strings"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestDust(c *check.C) {
+	random := "cctccctaactcattttatgaggccagcatcattctgataccaaagccgggcagagacacaacc"
+	repeat := strings.Repeat("ca", 20)
+	sq := stringToSeq(random + repeat + random)
+	m, err := DefaultDust.Mask(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(m, check.HasLen, 1)
+	c.Check(m[0].From <= len(random) && m[0].From > len(random)-4, check.Equals, true, check.Commentf("from=%d", m[0].From))
+	c.Check(m[0].To >= len(random)+len(repeat) && m[0].To < len(random)+len(repeat)+4, check.Equals, true, check.Commentf("to=%d", m[0].To))
+
+	m, err = DefaultDust.Mask(stringToSeq(random))
+	c.Assert(err, check.Equals, nil)
+	c.Check(m, check.HasLen, 0)
+
+	// Ambiguous bases break low complexity intervals.
+	m, err = DefaultDust.Mask(stringToSeq(strings.Repeat("a", 10) + "n" + strings.Repeat("a", 10)))
+	c.Assert(err, check.Equals, nil)
+	c.Check(m, check.HasLen, 2)
+
+	_, err = DefaultDust.Mask(linear.NewSeq("", alphabet.BytesToLetters([]byte("MKV")), alphabet.Protein))
+	c.Check(err, check.NotNil)
+}
+
+func (s *S) TestSeg(c *check.C) {
+	prot := func(s string) *linear.Seq {
+		return linear.NewSeq("", alphabet.BytesToLetters([]byte(s)), alphabet.Protein)
+	}
+	flank := "MDVFMKGLSKAKEGVVAAAEKTKQGVAEAAGKTKEGVLYVGSKTKEGVVHGVATVAEKTKEQVTNVGGAVVTGVTAVAQKTVEGAGSIAAATGFVKKDQLGKNEEGAPQEGILEDMPVDPDNEAYEMPSEEGYQDYEPEA"
+	repeat := strings.Repeat("Q", 30)
+	sq := prot(flank[:60] + repeat + flank[60:])
+	m, err := DefaultSeg.Mask(sq)
+	c.Assert(err, check.Equals, nil)
+	var found bool
+	for _, iv := range m {
+		if iv.From <= 60 && iv.To >= 90 {
+			found = true
+		}
+	}
+	c.Check(found, check.Equals, true, check.Commentf("%v", m))
+	c.Check(m.Masked() < sq.Len()/2, check.Equals, true)
+
+	m, err = DefaultSeg.Mask(prot("ACDEFGHIKLMNPQRSTVWY"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(m, check.HasLen, 0)
+
+	_, err = DefaultSeg.Mask(stringToSeq("acgt"))
+	c.Check(err, check.NotNil)
+}
@@ -0,0 +1,195 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package complexity
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/sequtils"
+
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Seg is a low complexity protein sequence masker implementing the SEG algorithm of:
+//
+//	Statistics of local complexity in amino acid sequences and sequence databases.
+//	 John C. Wootton and Scott Federhen. Comput Chem 17:149–163 (1993).
+//
+// Windows of Window residues with a Shannon entropy in bits of at most LowCut trigger a low
+// complexity segment, which is extended to include all contiguous overlapping windows with
+// an entropy of at most HighCut. Each segment is then trimmed to its subsequence with the
+// lowest compositional probability, and the trimmed flanks are searched again.
+type Seg struct {
+	Window          int
+	LowCut, HighCut float64
+}
+
+// DefaultSeg is the Seg masker with the default parameters of seg.
+var DefaultSeg = Seg{Window: 12, LowCut: 2.2, HighCut: 2.5}
+
+// maxTrim is the maximum number of residues removed when trimming a segment, as used by seg.
+const maxTrim = 100
+
+// Mask returns the low complexity intervals of s. Windows including letters other than the
+// 20 standard amino acids are not low complexity. It returns an error if s is not a protein
+// sequence, the window is not positive or HighCut is less than LowCut.
+func (g Seg) Mask(s seq.Sequence) (sequtils.Mask, error) {
+	if s.Alphabet().Moltype() != feat.Protein {
+		return nil, fmt.Errorf("complex: sequence is not protein")
+	}
+	if g.Window < 1 {
+		return nil, fmt.Errorf("complex: seg window not positive")
+	}
+	if g.HighCut < g.LowCut {
+		return nil, fmt.Errorf("complex: seg high cut less than low cut")
+	}
+
+	r := make([]int, s.Len())
+	for i := range r {
+		r[i] = residueIndex(byte(s.At(s.Start() + i).L))
+	}
+	var ivs []feat.Feature
+	for _, iv := range g.segments(r, 0, len(r)) {
+		ivs = append(ivs, &sequtils.MaskInterval{Loc: s, From: s.Start() + iv[0], To: s.Start() + iv[1]})
+	}
+	return sequtils.NewMask(s, ivs...), nil
+}
+
+// segments returns the low complexity segments of r[from:to].
+func (g Seg) segments(r []int, from, to int) [][2]int {
+	nw := to - from - g.Window + 1
+	if nw < 1 {
+		return nil
+	}
+	h := make([]float64, nw)
+	for i := range h {
+		h[i] = entropy(r[from+i : from+i+g.Window])
+	}
+
+	var (
+		segs [][2]int
+		last = from
+	)
+	for i := 0; i < nw; i++ {
+		if h[i] > g.LowCut {
+			continue
+		}
+		lo, hi := i, i
+		for lo > 0 && h[lo-1] <= g.HighCut {
+			lo--
+		}
+		for hi+1 < nw && h[hi+1] <= g.HighCut {
+			hi++
+		}
+		start, end := max(from+lo, last), from+hi+g.Window
+		last = end
+		ts, te := trim(r[start:end])
+		ts += start
+		te += start
+		if ts-start >= g.Window {
+			segs = append(segs, g.segments(r, start, ts)...)
+		}
+		segs = append(segs, [2]int{ts, te})
+		if end-te >= g.Window {
+			segs = append(segs, g.segments(r, te, end)...)
+		}
+		i = hi
+	}
+	sort.Sort(segsByStart(segs))
+	return segs
+}
+
+type segsByStart [][2]int
+
+func (s segsByStart) Len() int           { return len(s) }
+func (s segsByStart) Less(i, j int) bool { return s[i][0] < s[j][0] }
+func (s segsByStart) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// trim returns the bounds of the subsequence of r with the lowest compositional probability.
+// At most maxTrim residues are removed and ties are resolved in favour of longer subsequences.
+func trim(r []int) (start, end int) {
+	minLen := 1
+	if len(r) > maxTrim {
+		minLen = len(r) - maxTrim
+	}
+	min := math.Inf(1)
+	for i := 0; i+minLen <= len(r); i++ {
+		var counts [20]int
+		for j, x := range r[i:] {
+			if x >= 0 {
+				counts[x]++
+			}
+			if l := j + 1; l >= minLen {
+				if p := lnProb(counts, l); p < min || (p == min && l > end-start) {
+					min = p
+					start, end = i, i+l
+				}
+			}
+		}
+	}
+	return start, end
+}
+
+// lnProb returns the natural logarithm of the probability of a sequence of length n having
+// the composition described by counts, up to permutation of the residue identities.
+func lnProb(counts [20]int, n int) float64 {
+	sorted := counts
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted[:])))
+
+	// Number of compositions with the same
+	// sorted count vector.
+	lnAss := lnFac(len(sorted))
+	for i := 0; i < len(sorted); {
+		j := i
+		for j < len(sorted) && sorted[j] == sorted[i] {
+			j++
+		}
+		lnAss -= lnFac(j - i)
+		i = j
+	}
+
+	// Number of sequences with the composition.
+	lnPerm := lnFac(n)
+	for _, c := range sorted {
+		lnPerm -= lnFac(c)
+	}
+
+	return lnAss + lnPerm - float64(n)*math.Log(float64(len(sorted)))
+}
+
+// entropy returns the Shannon entropy in bits of the residues in w, or +Inf if w includes
+// non-standard residues.
+func entropy(w []int) float64 {
+	var counts [20]int
+	for _, x := range w {
+		if x < 0 {
+			return math.Inf(1)
+		}
+		counts[x]++
+	}
+	var h float64
+	for _, c := range counts {
+		if c != 0 {
+			p := float64(c) / float64(len(w))
+			h -= p * math.Log2(p)
+		}
+	}
+	return h
+}
+
+// residueIndex returns the index of the amino acid b, or -1 if b is not one of the 20
+// standard amino acids.
+func residueIndex(b byte) int {
+	const residues = "ACDEFGHIKLMNPQRSTVWY"
+	b &^= 'a' - 'A'
+	for i := 0; i < len(residues); i++ {
+		if residues[i] == b {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,141 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package complexity
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/sequtils"
+
+	"github.com/biogo/store/step"
+
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// A Func is a complexity measure of a segment of a sequence defined by start and end.
+// Entropic, WF, Z and LinguisticComplexity are Funcs.
+type Func func(s seq.Sequence, start, end int) (float64, error)
+
+// Track returns a step vector holding the complexity of s measured by fn over windows of
+// length w starting every by positions. The value of each window is assigned to the by
+// positions at the centre of the window, and positions not at the centre of a window have a
+// value of NaN. It returns an error if w or by is not positive, by is greater than w, s is
+// shorter than w or fn returns an error.
+func Track(s seq.Sequence, fn Func, w, by int) (*step.Vector, error) {
+	if w < 1 || by < 1 {
+		return nil, fmt.Errorf("complex: window length or step not positive")
+	}
+	if by > w {
+		return nil, fmt.Errorf("complex: window step longer than window")
+	}
+	if s.Len() < w {
+		return nil, fmt.Errorf("complex: sequence shorter than window")
+	}
+	v, err := step.New(s.Start(), s.End(), step.Float(math.NaN()))
+	if err != nil {
+		return nil, err
+	}
+	off := (w - by) / 2
+	for start := s.Start(); start+w <= s.End(); start += by {
+		c, err := fn(s, start, start+w)
+		if err != nil {
+			return nil, err
+		}
+		v.SetRange(start+off, start+off+by, step.Float(c))
+	}
+	return v, nil
+}
+
+// LinguisticComplexity returns the linguistic complexity of a segment of s defined by start
+// and end, the number of distinct substrings of the segment divided by the maximum possible
+// number of distinct substrings of a segment of the same length, as described in:
+//
+//	Sequence complexity profiles of prokaryotic genomic sequences: a fast algorithm for calculating linguistic complexity.
+//	 Olga G. Troyanskaya, Ora Arbell, Yair Koren, Gad M. Landau and Alexander Bolshoy. Bioinformatics 18:679–688 (2002).
+//
+// The maximum number of distinct substrings of length k is the smaller of a^k and n-k+1,
+// where a is the number of letters of the alphabet of s and n is the number of letters
+// of the segment that are valid in the alphabet. Invalid letters are ignored.
+func LinguisticComplexity(s seq.Sequence, start, end int) (float64, error) {
+	if start < s.Start() || end > s.End() {
+		return 0, fmt.Errorf("complex: index out of range")
+	}
+	it := s.Alphabet().LetterIndex()
+	var b []byte
+	for i := start; i < end; i++ {
+		if ind := it[s.At(i).L]; ind >= 0 {
+			b = append(b, byte(ind))
+		}
+	}
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	// The number of distinct substrings is the sum
+	// of the suffix lengths less the longest common
+	// prefixes of adjacent suffixes in sorted order.
+	suffixes := make([][]byte, len(b))
+	for i := range b {
+		suffixes[i] = b[i:]
+	}
+	sort.Sort(bySuffix(suffixes))
+	var distinct int
+	for i, suf := range suffixes {
+		distinct += len(suf)
+		if i > 0 {
+			distinct -= lcp(suffixes[i-1], suf)
+		}
+	}
+
+	var (
+		possible int
+		a        = s.Alphabet().Len()
+		pow      = 1
+	)
+	for k := 1; k <= len(b); k++ {
+		if pow < len(b) {
+			pow *= a
+		}
+		possible += min(pow, len(b)-k+1)
+	}
+	return float64(distinct) / float64(possible), nil
+}
+
+type bySuffix [][]byte
+
+func (s bySuffix) Len() int           { return len(s) }
+func (s bySuffix) Less(i, j int) bool { return bytes.Compare(s[i], s[j]) < 0 }
+func (s bySuffix) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// lcp returns the length of the longest common prefix of a and b.
+func lcp(a, b []byte) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Below returns a mask of the sequence loc covering the positions where the value of the
+// track v is less than thresh. Positions with a NaN value are not masked.
+func Below(loc feat.Feature, v *step.Vector, thresh float64) sequtils.Mask {
+	var ivs []feat.Feature
+	v.Do(func(start, end int, e step.Equaler) {
+		if float64(e.(step.Float)) < thresh {
+			ivs = append(ivs, &sequtils.MaskInterval{Loc: loc, From: start, To: end})
+		}
+	})
+	return sequtils.NewMask(loc, ivs...)
+}
@@ -0,0 +1,74 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package complexity
+
+import (
+	"github.com/biogo/store/step"
+
+	"math"
+	"strings"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestLinguisticComplexity(c *check.C) {
+	for _, t := range []struct {
+		s string
+		c float64
+	}{
+		{"", 0},
+		{"acgt", 1},
+		{"aaaa", 0.4},
+		{"acac", 0.7},
+		{"ac-ac", 0.7},
+	} {
+		lc, err := LinguisticComplexity(stringToSeq(t.s), 0, len(t.s))
+		c.Assert(err, check.Equals, nil)
+		c.Check(lc, check.Equals, t.c, check.Commentf("%q", t.s))
+	}
+	_, err := LinguisticComplexity(stringToSeq("acgt"), 0, 5)
+	c.Check(err, check.NotNil)
+}
+
+func (s *S) TestTrack(c *check.C) {
+	random := "cctccctaactcattttatgaggccagcatcattctgataccaaagccgggcagagacacaacc"
+	sq := stringToSeq(random + strings.Repeat("a", 40) + random)
+
+	v, err := Track(sq, Entropic, 20, 10)
+	c.Assert(err, check.Equals, nil)
+	c.Check(v.Start(), check.Equals, 0)
+	c.Check(v.End(), check.Equals, sq.Len())
+	for _, t := range []struct {
+		pos int
+		min float64
+		max float64
+	}{
+		{pos: 10, min: 0.8, max: 1},
+		{pos: 85, min: 0, max: 0},
+		{pos: 150, min: 0.8, max: 1},
+	} {
+		e, err := v.At(t.pos)
+		c.Assert(err, check.Equals, nil)
+		f := float64(e.(step.Float))
+		c.Check(f >= t.min && f <= t.max, check.Equals, true, check.Commentf("position %d: %f", t.pos, f))
+	}
+	e, err := v.At(0)
+	c.Assert(err, check.Equals, nil)
+	c.Check(math.IsNaN(float64(e.(step.Float))), check.Equals, true)
+
+	m := Below(sq, v, 0.5)
+	c.Assert(m, check.HasLen, 1)
+	c.Check(m[0].From >= len(random)-5 && m[0].To <= len(random)+45, check.Equals, true, check.Commentf("%v", m[0]))
+
+	v, err = Track(sq, LinguisticComplexity, 20, 1)
+	c.Assert(err, check.Equals, nil)
+	m = Below(sq, v, 0.5)
+	c.Check(m.Masked() > 0 && m.Masked() <= 40, check.Equals, true)
+
+	for _, t := range []struct{ w, by int }{{0, 1}, {10, 0}, {10, 11}, {1000, 1}} {
+		_, err = Track(sq, Entropic, t.w, t.by)
+		c.Check(err, check.NotNil)
+	}
+}
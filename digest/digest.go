@@ -0,0 +1,394 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package digest provides restriction enzyme definitions and in silico restriction digestion
+// of nucleic acid sequences.
+package digest
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A Cut is a pair of cleavage positions of a restriction enzyme relative to the start of its
+// recognition site on the top strand. Top is the number of bases of the top strand before
+// the cleavage point and Bottom is the equivalent position of the bottom strand cleavage in
+// top strand coordinates. Positions may be negative or beyond the end of the recognition site
+// for enzymes that cut outside their site.
+type Cut struct {
+	Top, Bottom int
+}
+
+// An Enzyme is a restriction enzyme.
+type Enzyme struct {
+	// Name is the name of the enzyme.
+	Name string
+
+	// Prototype is the name of the prototype
+	// isoschizomer of the enzyme if known.
+	Prototype string
+
+	// Site is the recognition sequence of the
+	// enzyme using IUPAC nucleotide codes.
+	Site string
+
+	// Cuts holds the cleavage positions of the
+	// enzyme. Enzymes that cut on both sides of
+	// their recognition site have two cuts. If
+	// Cuts is empty the cleavage positions are
+	// not known.
+	Cuts []Cut
+
+	// Suppliers holds the REBASE commercial
+	// supplier codes for the enzyme.
+	Suppliers string
+}
+
+// NewEnzyme returns an Enzyme named name with the recognition site and cleavage positions
+// described by site using the REBASE notation. Top strand cleavage within the site is marked
+// with '^', for example "GACGT^C", and the bottom strand cleavage is taken to be at the
+// symmetric position. Cleavage outside the site is specified by "(n/m)" following or
+// preceding the site with n and m being the distances of the top and bottom strand cleavage
+// positions from the site, for example "GGTCTC(1/5)" or "(8/13)GACNNNNNNTGG(12/7)". It returns
+// an error if the site is not valid.
+func NewEnzyme(name, site string) (*Enzyme, error) {
+	e := &Enzyme{Name: name}
+	var pre, post *Cut
+	if strings.HasPrefix(site, "(") {
+		i := strings.Index(site, ")")
+		if i < 0 {
+			return nil, fmt.Errorf("digest: invalid site %q", site)
+		}
+		c, err := parseOffsets(site[1:i])
+		if err != nil {
+			return nil, fmt.Errorf("digest: invalid site %q: %v", site, err)
+		}
+		pre = &c
+		site = site[i+1:]
+	}
+	if strings.HasSuffix(site, ")") {
+		i := strings.LastIndex(site, "(")
+		if i < 0 {
+			return nil, fmt.Errorf("digest: invalid site %q", site)
+		}
+		c, err := parseOffsets(site[i+1 : len(site)-1])
+		if err != nil {
+			return nil, fmt.Errorf("digest: invalid site %q: %v", site, err)
+		}
+		post = &c
+		site = site[:i]
+	}
+	caret := strings.Index(site, "^")
+	if caret >= 0 {
+		site = site[:caret] + site[caret+1:]
+	}
+	if site == "" {
+		return nil, errors.New("digest: empty site")
+	}
+	for i := 0; i < len(site); i++ {
+		if alphabet.Expand(alphabet.Letter(site[i])) == nil {
+			return nil, fmt.Errorf("digest: invalid base %q in site %q", site[i], site)
+		}
+	}
+	e.Site = strings.ToUpper(site)
+
+	switch {
+	case caret >= 0:
+		e.Cuts = []Cut{{Top: caret, Bottom: len(site) - caret}}
+	case pre != nil || post != nil:
+		if pre != nil {
+			e.Cuts = append(e.Cuts, Cut{Top: -pre.Top, Bottom: -pre.Bottom})
+		}
+		if post != nil {
+			e.Cuts = append(e.Cuts, Cut{Top: len(site) + post.Top, Bottom: len(site) + post.Bottom})
+		}
+	}
+	return e, nil
+}
+
+// parseOffsets parses an "n/m" cleavage offset pair.
+func parseOffsets(s string) (Cut, error) {
+	f := strings.Split(s, "/")
+	if len(f) != 2 {
+		return Cut{}, errors.New("missing offset")
+	}
+	top, err := strconv.Atoi(f[0])
+	if err != nil {
+		return Cut{}, err
+	}
+	bottom, err := strconv.Atoi(f[1])
+	if err != nil {
+		return Cut{}, err
+	}
+	return Cut{Top: top, Bottom: bottom}, nil
+}
+
+// String returns the name of the enzyme.
+func (e *Enzyme) String() string { return e.Name }
+
+// IsBlunt returns whether the enzyme produces blunt ends.
+func (e *Enzyme) IsBlunt() bool {
+	for _, c := range e.Cuts {
+		if c.Top != c.Bottom {
+			return false
+		}
+	}
+	return len(e.Cuts) != 0
+}
+
+// Overhang returns the length of the single-stranded overhang produced by the first cut of
+// the enzyme. Positive values indicate 5' overhangs and negative values indicate 3'
+// overhangs.
+func (e *Enzyme) Overhang() int {
+	if len(e.Cuts) == 0 {
+		return 0
+	}
+	return e.Cuts[0].Bottom - e.Cuts[0].Top
+}
+
+// A Site is an occurrence of a restriction enzyme recognition site in a sequence.
+type Site struct {
+	Enzyme *Enzyme
+
+	// Pos is the position of the start of the
+	// recognition site on the top strand.
+	Pos int
+
+	// Strand is the strand holding the
+	// recognition site in the orientation of
+	// the enzyme's Site.
+	Strand seq.Strand
+
+	// Cuts holds the top and bottom strand
+	// cleavage positions in sequence coordinates.
+	// For circular sequences, positions are
+	// within the extent of the sequence.
+	Cuts []Cut
+}
+
+// Sites returns the recognition sites of the enzymes in s, sorted by position. Only sites
+// where all the cleavage positions of the enzyme lie within the sequence are reported for
+// linear sequences. For circular sequences, sites may span the origin. Recognition sites
+// match only unambiguous bases of s. It returns an error if s is not a nucleic acid sequence.
+func Sites(s seq.Sequence, enzymes ...*Enzyme) ([]Site, error) {
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, errors.New("digest: sequence is not nucleic acid")
+	}
+	var (
+		n        = s.Len()
+		offset   = s.Start()
+		circular bool
+		l        = make([]alphabet.Letter, n)
+		sites    []Site
+	)
+	if c, ok := s.(seq.Conformationer); ok {
+		circular = c.Conformation() == feat.Circular
+	}
+	for i := range l {
+		l[i] = s.At(offset + i).L
+	}
+
+	for _, e := range enzymes {
+		if len(e.Site) > n {
+			// A recognition site longer than the
+			// sequence cannot occur in it, even
+			// when the sequence is circular.
+			continue
+		}
+		fwd := []byte(e.Site)
+		rev := revComp(fwd)
+		strands := []seq.Strand{seq.Plus}
+		if string(rev) != e.Site {
+			strands = append(strands, seq.Minus)
+		}
+		for _, strand := range strands {
+			site := fwd
+			if strand == seq.Minus {
+				site = rev
+			}
+			end := n - len(site)
+			if circular {
+				end = n - 1
+			}
+		search:
+			for i := 0; i <= end; i++ {
+				for j, b := range site {
+					k := i + j
+					if k >= n {
+						k -= n
+					}
+					if !isConcrete(l[k]) || !alphabet.Match(l[k], alphabet.Letter(b)) {
+						continue search
+					}
+				}
+				cuts := make([]Cut, len(e.Cuts))
+				for k, c := range e.Cuts {
+					if strand == seq.Minus {
+						c = Cut{Top: len(site) - c.Bottom, Bottom: len(site) - c.Top}
+					}
+					c.Top += i
+					c.Bottom += i
+					if circular {
+						c.Top = mod(c.Top, n)
+						c.Bottom = mod(c.Bottom, n)
+					} else if c.Top <= 0 || c.Top >= n || c.Bottom <= 0 || c.Bottom >= n {
+						continue search
+					}
+					c.Top += offset
+					c.Bottom += offset
+					cuts[k] = c
+				}
+				sites = append(sites, Site{Enzyme: e, Pos: offset + i, Strand: strand, Cuts: cuts})
+			}
+		}
+	}
+	sort.Sort(sitesByPos(sites))
+
+	return sites, nil
+}
+
+// A Fragment is a restriction fragment of a sequence.
+type Fragment struct {
+	// Loc is the digested sequence.
+	Loc feat.Feature
+
+	// From and To are the top strand cleavage
+	// positions bounding the fragment. For
+	// fragments spanning the origin of a
+	// circular sequence, To is greater than
+	// the end of the sequence and the fragment
+	// continues from the start of the sequence.
+	From, To int
+
+	// Left and Right are the enzymes that cut
+	// to produce the ends of the fragment. They
+	// are nil for the ends of a linear sequence.
+	Left, Right []*Enzyme
+}
+
+func (f *Fragment) Name() string {
+	if f == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s:%d..%d", f.Loc.Name(), f.From, f.To)
+}
+
+// Description returns the string "fragment".
+func (f *Fragment) Description() string    { return "fragment" }
+func (f *Fragment) Start() int             { return f.From }
+func (f *Fragment) End() int               { return f.To }
+func (f *Fragment) Len() int               { return f.To - f.From }
+func (f *Fragment) Location() feat.Feature { return f.Loc }
+
+// Digest returns the fragments resulting from complete digestion of s by the enzymes, sorted
+// by position. Enzymes without known cleavage positions do not cut. An uncut circular sequence
+// is returned as a single fragment spanning the whole sequence. It returns an error if s is
+// not a nucleic acid sequence.
+func Digest(s seq.Sequence, enzymes ...*Enzyme) ([]*Fragment, error) {
+	sites, err := Sites(s, enzymes...)
+	if err != nil {
+		return nil, err
+	}
+	var circular bool
+	if c, ok := s.(seq.Conformationer); ok {
+		circular = c.Conformation() == feat.Circular
+	}
+
+	cutters := make(map[int][]*Enzyme)
+	for _, site := range sites {
+		for _, c := range site.Cuts {
+			cutters[c.Top] = appendEnzyme(cutters[c.Top], site.Enzyme)
+		}
+	}
+	cuts := make([]int, 0, len(cutters))
+	for p := range cutters {
+		cuts = append(cuts, p)
+	}
+	sort.Ints(cuts)
+
+	var frags []*Fragment
+	if !circular {
+		from := s.Start()
+		var left []*Enzyme
+		for _, p := range cuts {
+			frags = append(frags, &Fragment{Loc: s, From: from, To: p, Left: left, Right: cutters[p]})
+			from, left = p, cutters[p]
+		}
+		return append(frags, &Fragment{Loc: s, From: from, To: s.End(), Left: left}), nil
+	}
+
+	if len(cuts) == 0 {
+		return []*Fragment{{Loc: s, From: s.Start(), To: s.End()}}, nil
+	}
+	for i, p := range cuts {
+		next := cuts[(i+1)%len(cuts)]
+		if next <= p {
+			next += s.Len()
+		}
+		frags = append(frags, &Fragment{Loc: s, From: p, To: next, Left: cutters[p], Right: cutters[cuts[(i+1)%len(cuts)]]})
+	}
+	return frags, nil
+}
+
+// appendEnzyme appends e to enzymes if it is not already present.
+func appendEnzyme(enzymes []*Enzyme, e *Enzyme) []*Enzyme {
+	for _, x := range enzymes {
+		if x == e {
+			return enzymes
+		}
+	}
+	return append(enzymes, e)
+}
+
+// isConcrete returns whether l is an unambiguous base.
+func isConcrete(l alphabet.Letter) bool {
+	return len(alphabet.Expand(l)) == 1
+}
+
+// iupacComplement holds the complements of the upper case IUPAC nucleotide codes.
+var iupacComplement = map[byte]byte{
+	'A': 'T', 'C': 'G', 'G': 'C', 'T': 'A', 'U': 'A',
+	'R': 'Y', 'Y': 'R', 'S': 'S', 'W': 'W', 'K': 'M', 'M': 'K',
+	'B': 'V', 'V': 'B', 'D': 'H', 'H': 'D',
+	'N': 'N',
+}
+
+// revComp returns the reverse complement of the upper case IUPAC site.
+func revComp(site []byte) []byte {
+	rc := make([]byte, len(site))
+	for i, b := range site {
+		rc[len(site)-1-i] = iupacComplement[b]
+	}
+	return rc
+}
+
+func mod(a, n int) int {
+	a %= n
+	if a < 0 {
+		a += n
+	}
+	return a
+}
+
+// sitesByPos sorts sites by position, enzyme name and strand.
+type sitesByPos []Site
+
+func (s sitesByPos) Len() int { return len(s) }
+func (s sitesByPos) Less(i, j int) bool {
+	if s[i].Pos != s[j].Pos {
+		return s[i].Pos < s[j].Pos
+	}
+	if s[i].Enzyme.Name != s[j].Enzyme.Name {
+		return s[i].Enzyme.Name < s[j].Enzyme.Name
+	}
+	return s[i].Strand > s[j].Strand
+}
+func (s sitesByPos) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,85 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package digest
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"strings"
+)
+
+const rebase = `REBASE version 605                                              withrefm.605
+
+<1>EcoRI
+<2>EcoRI
+<3>G^AATTC
+<4>
+<5>Escherichia coli RY13
+<6>R.N. Yoshimori
+<7>BCFIJKMNOQRSVX
+<8>Greene, P.J., et al., (1978) J. Mol. Biol., vol. 99, pp. 237-261.
+
+<1>BsaI
+<2>BsaI
+<3>GGTCTC(1/5)
+<4>
+<5>Bacillus stearothermophilus 6-55
+<6>Z. Chen
+<7>N
+<8>Chen, Z., Unpublished observations.
+`
+
+func ExampleDigest() {
+	enzymes, err := ReadREBASE(strings.NewReader(rebase))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, e := range enzymes {
+		fmt.Printf("%s %s cuts:%v overhang:%d\n", e, e.Site, e.Cuts, e.Overhang())
+	}
+
+	s := linear.NewSeq("plasmid", alphabet.BytesToLetters([]byte(
+		"ttgaattcaaggtctcaaaaccccgggttttgagaccaagaattctt",
+	)), alphabet.DNA)
+
+	frags, err := Digest(s, enzymes...)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("linear:")
+	for _, f := range frags {
+		fmt.Println(f.Name(), f.Len(), f.Left, f.Right)
+	}
+
+	s.Conform = feat.Circular
+	frags, err = Digest(s, enzymes...)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("circular:")
+	for _, f := range frags {
+		fmt.Println(f.Name(), f.Len(), f.Left, f.Right)
+	}
+	// Output:
+	// EcoRI GAATTC cuts:[{1 5}] overhang:4
+	// BsaI GGTCTC cuts:[{7 11}] overhang:4
+	// linear:
+	// plasmid:0..3 3 [] [EcoRI]
+	// plasmid:3..17 14 [EcoRI] [BsaI]
+	// plasmid:17..26 9 [BsaI] [BsaI]
+	// plasmid:26..40 14 [BsaI] [EcoRI]
+	// plasmid:40..47 7 [EcoRI] []
+	// circular:
+	// plasmid:3..17 14 [EcoRI] [BsaI]
+	// plasmid:17..26 9 [BsaI] [BsaI]
+	// plasmid:26..40 14 [BsaI] [EcoRI]
+	// plasmid:40..50 10 [EcoRI] [EcoRI]
+}
@@ -0,0 +1,146 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package digest
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestNewEnzyme(c *check.C) {
+	for _, t := range []struct {
+		site  string
+		want  string
+		cuts  []Cut
+		blunt bool
+		err   bool
+	}{
+		{site: "G^AATTC", want: "GAATTC", cuts: []Cut{{1, 5}}},
+		{site: "CCC^GGG", want: "CCCGGG", cuts: []Cut{{3, 3}}, blunt: true},
+		{site: "GACGT^C", want: "GACGTC", cuts: []Cut{{5, 1}}},
+		{site: "CCTNAGG", want: "CCTNAGG"},
+		{site: "GGTCTC(1/5)", want: "GGTCTC", cuts: []Cut{{7, 11}}},
+		{site: "(8/13)GACNNNNNNTGG(12/7)", want: "GACNNNNNNTGG", cuts: []Cut{{-8, -13}, {24, 19}}},
+		{site: "GAXTC", err: true},
+		{site: "GGTCTC(1)", err: true},
+		{site: "", err: true},
+	} {
+		e, err := NewEnzyme("test", t.site)
+		if t.err {
+			c.Check(err, check.NotNil, check.Commentf("site %q", t.site))
+			continue
+		}
+		c.Assert(err, check.Equals, nil)
+		c.Check(e.Site, check.Equals, t.want)
+		c.Check(e.Cuts, check.DeepEquals, t.cuts)
+		c.Check(e.IsBlunt(), check.Equals, t.blunt)
+	}
+}
+
+func (s *S) TestSites(c *check.C) {
+	bsaI, err := NewEnzyme("BsaI", "GGTCTC(1/5)")
+	c.Assert(err, check.Equals, nil)
+	ecoRII, err := NewEnzyme("EcoRII", "^CCWGG")
+	c.Assert(err, check.Equals, nil)
+
+	//                                                       1111111111222222222233333
+	//                                             01234567890123456789012345678901234
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("aaggtctcaaaaaccaggaaaaagagaccaaaaaa")), alphabet.DNA)
+	sites, err := Sites(sq, bsaI, ecoRII)
+	c.Assert(err, check.Equals, nil)
+	c.Check(sites, check.DeepEquals, []Site{
+		{Enzyme: bsaI, Pos: 2, Strand: seq.Plus, Cuts: []Cut{{9, 13}}},
+		{Enzyme: ecoRII, Pos: 13, Strand: seq.Plus, Cuts: []Cut{{13, 18}}},
+		{Enzyme: bsaI, Pos: 23, Strand: seq.Minus, Cuts: []Cut{{18, 22}}},
+	})
+
+	// Ambiguous bases in the sequence do not match.
+	sq = linear.NewSeq("test", alphabet.BytesToLetters([]byte("aaccnggaa")), alphabet.DNA)
+	sites, err = Sites(sq, ecoRII)
+	c.Assert(err, check.Equals, nil)
+	c.Check(sites, check.HasLen, 0)
+
+	// Sites that cut outside a linear sequence are not reported.
+	sq = linear.NewSeq("test", alphabet.BytesToLetters([]byte("aaggtctca")), alphabet.DNA)
+	sites, err = Sites(sq, bsaI)
+	c.Assert(err, check.Equals, nil)
+	c.Check(sites, check.HasLen, 0)
+
+	// Sites may span the origin of a circular sequence.
+	sq = linear.NewSeq("test", alphabet.BytesToLetters([]byte("tctcaaaaaaaaaaaagg")), alphabet.DNA)
+	sq.Conform = feat.Circular
+	sites, err = Sites(sq, bsaI)
+	c.Assert(err, check.Equals, nil)
+	c.Check(sites, check.DeepEquals, []Site{
+		{Enzyme: bsaI, Pos: 16, Strand: seq.Plus, Cuts: []Cut{{5, 9}}},
+	})
+
+	// Sites longer than a circular sequence are not reported.
+	bglI, err := NewEnzyme("BglI", "GGNNNN^NNNNGG")
+	c.Assert(err, check.Equals, nil)
+	sq = linear.NewSeq("test", alphabet.BytesToLetters([]byte("gggg")), alphabet.DNA)
+	sq.Conform = feat.Circular
+	sites, err = Sites(sq, bglI, ecoRII)
+	c.Assert(err, check.Equals, nil)
+	c.Check(sites, check.HasLen, 0)
+
+	_, err = Sites(linear.NewSeq("protein", alphabet.BytesToLetters([]byte("MKV")), alphabet.Protein), bsaI)
+	c.Check(err, check.NotNil)
+}
+
+func (s *S) TestDigest(c *check.C) {
+	ecoRI, err := NewEnzyme("EcoRI", "G^AATTC")
+	c.Assert(err, check.Equals, nil)
+
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("aaaaaaaaaa")), alphabet.DNA)
+	frags, err := Digest(sq, ecoRI)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(frags, check.HasLen, 1)
+	c.Check(frags[0].Len(), check.Equals, 10)
+
+	sq.Conform = feat.Circular
+	frags, err = Digest(sq, ecoRI)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(frags, check.HasLen, 1)
+	c.Check(frags[0].Len(), check.Equals, 10)
+
+	// A single cut linearises a circular sequence.
+	sq = linear.NewSeq("test", alphabet.BytesToLetters([]byte("aaagaattcaaa")), alphabet.DNA)
+	sq.Conform = feat.Circular
+	frags, err = Digest(sq, ecoRI)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(frags, check.HasLen, 1)
+	c.Check(frags[0].Start(), check.Equals, 4)
+	c.Check(frags[0].End(), check.Equals, 16)
+	c.Check(frags[0].Left, check.DeepEquals, []*Enzyme{ecoRI})
+	c.Check(frags[0].Right, check.DeepEquals, []*Enzyme{ecoRI})
+}
+
+func (s *S) TestReadREBASE(c *check.C) {
+	enzymes, err := ReadREBASE(strings.NewReader(rebase))
+	c.Assert(err, check.Equals, nil)
+	c.Assert(enzymes, check.HasLen, 2)
+	c.Check(enzymes[0].Name, check.Equals, "EcoRI")
+	c.Check(enzymes[0].Suppliers, check.Equals, "BCFIJKMNOQRSVX")
+	c.Check(enzymes[1].Prototype, check.Equals, "BsaI")
+
+	enzymes, err = ReadREBASE(strings.NewReader("<1>Unknown\n<2>\n<3>?\n<1>Bad\n<3>GAZTC\n"))
+	c.Check(enzymes, check.IsNil)
+	c.Check(err, check.ErrorMatches, `digest: line 4: .*`)
+}
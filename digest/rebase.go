@@ -0,0 +1,83 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package digest
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ReadREBASE reads restriction enzyme definitions in the REBASE withrefm format from r.
+// Each record is a set of lines tagged with a field number in angle brackets, for example:
+//
+//	<1>AatII
+//	<2>ZraI
+//	<3>GACGT^C
+//	<4>
+//	<5>Acetobacter aceti
+//	<6>IFO 3281
+//	<7>ILMNOPRSV
+//	<8>Sato, H., Suzuki, T., Yamada, Y., (1990) Agric. Biol. Chem., vol. 54, pp. 3319-3325.
+//
+// The name, prototype, recognition site and supplier fields are retained. Lines outside
+// records, such as the REBASE file header, are ignored. Records with an unknown recognition
+// site, indicated by '?', are skipped. It returns an error if a recognition site cannot be
+// parsed.
+func ReadREBASE(r io.Reader) ([]*Enzyme, error) {
+	var (
+		enzymes []*Enzyme
+		rec     map[byte]string
+		line    int
+		start   int
+	)
+	flush := func() error {
+		if rec == nil {
+			return nil
+		}
+		defer func() { rec = nil }()
+		site := rec['3']
+		if site == "" || strings.Contains(site, "?") {
+			return nil
+		}
+		e, err := NewEnzyme(rec['1'], site)
+		if err != nil {
+			return fmt.Errorf("digest: line %d: %v", start, err)
+		}
+		e.Prototype = rec['2']
+		e.Suppliers = rec['7']
+		enzymes = append(enzymes, e)
+		return nil
+	}
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line++
+		l := strings.TrimSpace(sc.Text())
+		if len(l) < 3 || l[0] != '<' || l[2] != '>' || l[1] < '1' || l[1] > '8' {
+			continue
+		}
+		if l[1] == '1' {
+			err := flush()
+			if err != nil {
+				return nil, err
+			}
+			rec = make(map[byte]string)
+			start = line
+		}
+		if rec != nil {
+			rec[l[1]] = strings.TrimSpace(l[3:])
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	err := flush()
+	if err != nil {
+		return nil, err
+	}
+	return enzymes, nil
+}
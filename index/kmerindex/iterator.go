@@ -0,0 +1,160 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kmerindex
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+)
+
+// An Ambiguity specifies how an Iterator handles k-mers that include ambiguous bases.
+type Ambiguity int
+
+const (
+	SkipAmbiguous   Ambiguity = iota // K-mers including ambiguous bases are skipped.
+	ExpandAmbiguous                  // K-mers including IUPAC ambiguity codes are expanded to all the k-mers they represent.
+)
+
+// An Iterator iterates over the k-mers of a nucleic acid sequence. K-mers are packed two
+// bits per base with a, c, g and t represented by 0, 1, 2 and 3, in the order of the
+// alphabet.DNA letter indices, and with u read as t.
+type Iterator struct {
+	s        seq.Sequence
+	k        int
+	amb      Ambiguity
+	mask     Kmer
+	end      int
+	next     int
+	n        int
+	kmer     Kmer
+	expanded []Kmer
+	idx      int
+
+	pos int
+	cur Kmer
+}
+
+// NewIterator returns an Iterator over the k-mers of s using the ambiguity handling amb.
+// When amb is ExpandAmbiguous, each k-mer including IUPAC ambiguity codes is expanded to
+// every concrete k-mer it represents, so k-mers with many ambiguous bases may produce a
+// large number of k-mers. K-mers including letters that are not IUPAC nucleotide codes are
+// always skipped. It returns an error if k is not between 1 and MaxKmerLen or s is not a
+// nucleic acid sequence.
+func NewIterator(s seq.Sequence, k int, amb Ambiguity) (*Iterator, error) {
+	switch {
+	case k > MaxKmerLen:
+		return nil, ErrKTooLarge
+	case k < 1:
+		return nil, ErrKTooSmall
+	}
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, errors.New("kmerindex: sequence is not nucleic acid")
+	}
+	if amb != SkipAmbiguous && amb != ExpandAmbiguous {
+		return nil, errors.New("kmerindex: unknown ambiguity handling")
+	}
+	return &Iterator{
+		s:    s,
+		k:    k,
+		amb:  amb,
+		mask: Kmer(1<<(2*uint(k)) - 1),
+		end:  s.End(),
+		next: s.Start(),
+	}, nil
+}
+
+// Next advances the iterator to the next k-mer, which will then be available through the
+// Kmer, Letters and Pos methods. It returns false when there are no more k-mers.
+func (it *Iterator) Next() bool {
+	if it.amb == ExpandAmbiguous {
+		return it.nextExpanded()
+	}
+	for it.next < it.end {
+		b := baseIndex(it.s.At(it.next).L)
+		it.next++
+		if b < 0 {
+			it.n = 0
+			continue
+		}
+		it.kmer = (it.kmer<<2 | Kmer(b)) & it.mask
+		if it.n < it.k {
+			it.n++
+		}
+		if it.n == it.k {
+			it.pos = it.next - it.k
+			it.cur = it.kmer
+			return true
+		}
+	}
+	return false
+}
+
+// nextExpanded advances the iterator to the next k-mer, expanding ambiguous bases.
+func (it *Iterator) nextExpanded() bool {
+	for {
+		if it.idx < len(it.expanded) {
+			it.cur = it.expanded[it.idx]
+			it.idx++
+			return true
+		}
+		if it.next+it.k > it.end {
+			return false
+		}
+		it.pos = it.next
+		it.next++
+		it.idx = 0
+		it.expanded = append(it.expanded[:0], 0)
+		for i := it.pos; i < it.pos+it.k; i++ {
+			bases := alphabet.Expand(it.s.At(i).L)
+			if bases == nil {
+				it.expanded = it.expanded[:0]
+				break
+			}
+			n := len(it.expanded)
+			for _, kmer := range it.expanded[:n] {
+				for _, b := range bases[1:] {
+					it.expanded = append(it.expanded, kmer<<2|Kmer(baseIndex(b)))
+				}
+			}
+			for j := range it.expanded[:n] {
+				it.expanded[j] = it.expanded[j]<<2 | Kmer(baseIndex(bases[0]))
+			}
+		}
+	}
+}
+
+// Kmer returns the most recent k-mer found by a call to Next.
+func (it *Iterator) Kmer() Kmer { return it.cur }
+
+// Letters returns the lower case letters of the most recent k-mer found by a call to Next.
+func (it *Iterator) Letters() alphabet.Letters {
+	l := make(alphabet.Letters, it.k)
+	for i, kmer := it.k-1, it.cur; i >= 0; i, kmer = i-1, kmer>>2 {
+		l[i] = alphabet.Letter("acgt"[kmer&3])
+	}
+	return l
+}
+
+// Pos returns the position of the first base of the most recent k-mer found by a call to
+// Next.
+func (it *Iterator) Pos() int { return it.pos }
+
+// baseIndex returns the packed value of the base l, or -1 if l is not a concrete base.
+func baseIndex(l alphabet.Letter) int {
+	switch l {
+	case 'a', 'A':
+		return 0
+	case 'c', 'C':
+		return 1
+	case 'g', 'G':
+		return 2
+	case 't', 'T', 'u', 'U':
+		return 3
+	}
+	return -1
+}
@@ -0,0 +1,33 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kmerindex
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"strings"
+)
+
+func ExampleIterator() {
+	s := linear.NewSeq("example", alphabet.BytesToLetters([]byte("ACGTNACGR")), alphabet.DNAredundant)
+
+	for _, amb := range []Ambiguity{SkipAmbiguous, ExpandAmbiguous} {
+		it, err := NewIterator(s, 3, amb)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var kmers []string
+		for it.Next() {
+			kmers = append(kmers, fmt.Sprintf("%d:%v:%d", it.Pos(), it.Letters(), it.Kmer()))
+		}
+		fmt.Println(strings.Join(kmers, " "))
+	}
+	// Output:
+	// 0:acg:6 1:cgt:27 5:acg:6
+	// 0:acg:6 1:cgt:27 2:gta:44 2:gtc:45 2:gtg:46 2:gtt:47 3:taa:48 3:tca:52 3:tga:56 3:tta:60 4:aac:1 4:cac:17 4:gac:33 4:tac:49 5:acg:6 6:cga:24 6:cgg:26
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/biogo/biogo/util"
 
 	"math/rand"
+	"sort"
 	"strings"
 	"testing"
 
@@ -146,3 +147,115 @@ func (s *S) TestKmerKmerUtilities(c *check.C) {
 		}
 	}
 }
+
+func (s *S) TestMinimizers(c *check.C) {
+	for k := MinKmerLen; k <= 8; k++ {
+		for _, w := range []int{1, 5, 10} {
+			i, err := New(k, s.Seq)
+			c.Assert(err, check.Equals, nil)
+
+			var kmers []int
+			i.ForEachKmerOf(s.Seq, 0, s.Seq.Len(), func(_ *Index, _, kmer int) { kmers = append(kmers, kmer) })
+			want := make(map[int]bool)
+			for start := 0; start+w <= len(kmers); start++ {
+				min := start
+				for p := start + 1; p < start+w; p++ {
+					if i.hash(Kmer(kmers[p])) < i.hash(Kmer(kmers[min])) {
+						min = p
+					}
+				}
+				want[min] = true
+			}
+
+			got := make(map[int]bool)
+			err = i.ForEachMinimizerOf(s.Seq, 0, s.Seq.Len(), w, func(_ *Index, position, kmer int) {
+				c.Check(got[position], check.Equals, false)
+				c.Check(kmer, check.Equals, kmers[position])
+				got[position] = true
+			})
+			c.Assert(err, check.Equals, nil)
+			c.Check(got, check.DeepEquals, want)
+
+			// Minimizers may be found without an index.
+			got = make(map[int]bool)
+			err = ForEachMinimizer(s.Seq, k, 0, s.Seq.Len(), w, func(position int, kmer Kmer) {
+				c.Check(int(kmer), check.Equals, kmers[position])
+				got[position] = true
+			})
+			c.Assert(err, check.Equals, nil)
+			c.Check(got, check.DeepEquals, want)
+
+			c.Assert(i.BuildMinimizers(w), check.Equals, nil)
+			c.Check(len(i.Pos()), check.Equals, len(want))
+			for p := range want {
+				pos, err := i.KmerPositions(Kmer(kmers[p]))
+				c.Assert(err, check.Equals, nil)
+				var found bool
+				for _, q := range pos {
+					found = found || q == p
+				}
+				c.Check(found, check.Equals, true)
+			}
+		}
+	}
+
+	i, err := New(MinKmerLen, s.Seq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(i.BuildMinimizers(0), check.Equals, ErrBadWindow)
+	c.Check(ForEachMinimizer(s.Seq, MaxKmerLen+1, 0, s.Seq.Len(), 5, nil), check.Equals, ErrKTooLarge)
+	c.Check(ForEachMinimizer(linear.NewSeq("", nil, alphabet.DNAgapped), MinKmerLen, 0, 0, 5, nil), check.Equals, ErrBadAlphabet)
+	c.Check(ForEachMinimizer(s.Seq, MinKmerLen, 0, MinKmerLen-1, 5, nil), check.Equals, nil)
+}
+
+func (s *S) TestIterator(c *check.C) {
+	sq := linear.NewSeq("", append(alphabet.Letters(nil), s.Seq.Seq...), alphabet.DNA)
+	for _, p := range []int{0, 10, 11, 500, testLen - 1} {
+		sq.Seq[p] = 'n'
+	}
+	for k := MinKmerLen; k <= MaxKmerLen; k++ {
+		ki, err := New(k, sq)
+		c.Assert(err, check.Equals, nil)
+		type kmer struct {
+			pos  int
+			kmer Kmer
+		}
+		var want []kmer
+		ki.ForEachKmerOf(sq, 0, sq.Len(), func(_ *Index, pos, k int) {
+			want = append(want, kmer{pos: pos, kmer: Kmer(k)})
+		})
+
+		it, err := NewIterator(sq, k, SkipAmbiguous)
+		c.Assert(err, check.Equals, nil)
+		var got []kmer
+		for it.Next() {
+			got = append(got, kmer{pos: it.Pos(), kmer: it.Kmer()})
+			c.Check(strings.EqualFold(it.Letters().String(), sq.Seq[it.Pos():it.Pos()+k].String()), check.Equals, true)
+		}
+		c.Check(got, check.DeepEquals, want)
+	}
+
+	// Expansion yields every concrete k-mer of each window.
+	sq = linear.NewSeq("", alphabet.Letters("acRYnxgt"), alphabet.DNAredundant)
+	it, err := NewIterator(sq, 3, ExpandAmbiguous)
+	c.Assert(err, check.Equals, nil)
+	got := make(map[int][]string)
+	for it.Next() {
+		got[it.Pos()] = append(got[it.Pos()], it.Letters().String())
+	}
+	for pos := range got {
+		sort.Strings(got[pos])
+	}
+	c.Check(got, check.DeepEquals, map[int][]string{
+		0: {"aca", "acg"},
+		1: {"cac", "cat", "cgc", "cgt"},
+		2: {
+			"aca", "acc", "acg", "act", "ata", "atc", "atg", "att",
+			"gca", "gcc", "gcg", "gct", "gta", "gtc", "gtg", "gtt",
+		},
+	})
+
+	_, err = NewIterator(linear.NewSeq("", nil, alphabet.Protein), 3, SkipAmbiguous)
+	c.Check(err, check.ErrorMatches, "kmerindex: sequence is not nucleic acid")
+	_, err = NewIterator(sq, 0, SkipAmbiguous)
+	c.Check(err, check.Equals, ErrKTooSmall)
+}
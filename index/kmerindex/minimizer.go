@@ -0,0 +1,137 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kmerindex
+
+import (
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/util"
+
+	"errors"
+)
+
+var ErrBadWindow = errors.New("kmerindex: minimizer window < 1")
+
+// hash returns an invertible scrambling of kmer within the k-mer mask of the index so that
+// minimizer selection is not biased toward low complexity k-mers. The mixing function is
+// Thomas Wang's 64 bit integer hash as used by minimap.
+func (ki *Index) hash(kmer Kmer) Kmer {
+	mask := uint64(ki.kMask)
+	key := uint64(kmer)
+	key = (^key + (key << 21)) & mask
+	key = key ^ key>>24
+	key = (key + (key << 3) + (key << 8)) & mask
+	key = key ^ key>>14
+	key = (key + (key << 2) + (key << 4)) & mask
+	key = key ^ key>>28
+	key = (key + (key << 31)) & mask
+	return Kmer(key)
+}
+
+// Applies the f Eval func to the (w,k)-minimizers of s from start to end in order of position. A
+// minimizer is the kmer with the smallest hashed value in a window of w consecutive kmers, with
+// ties broken by taking the leftmost kmer. Each minimizer position is visited once and windows
+// including ambiguous positions are ignored. Returns any panic raised by f as an error.
+func (ki *Index) ForEachMinimizerOf(s *linear.Seq, start, end, w int, f Eval) error {
+	if w < 1 {
+		return ErrBadWindow
+	}
+
+	type candidate struct {
+		pos, kmer int
+		hash      Kmer
+	}
+	var (
+		window  []candidate
+		last    = start - 1
+		run     int
+		emitted = -1
+	)
+	return ki.ForEachKmerOf(s, start, end, func(index *Index, position, kmer int) {
+		if position != last+1 {
+			window = window[:0]
+			run = 0
+		}
+		last = position
+		run++
+
+		h := index.hash(Kmer(kmer))
+		for len(window) > 0 && window[len(window)-1].hash > h {
+			window = window[:len(window)-1]
+		}
+		window = append(window, candidate{pos: position, kmer: kmer, hash: h})
+		if window[0].pos <= position-w {
+			window = window[1:]
+		}
+
+		if run >= w && window[0].pos != emitted {
+			emitted = window[0].pos
+			f(index, emitted, window[0].kmer)
+		}
+	})
+}
+
+// Applies f to the (w,k)-minimizers of s from start to end in order of position, as described
+// for ForEachMinimizerOf, without building an index of s. The alphabet of s must have four
+// letters. Returns any panic raised by f as an error.
+func ForEachMinimizer(s *linear.Seq, k, start, end, w int, f func(position int, kmer Kmer)) error {
+	switch {
+	case k > MaxKmerLen:
+		return ErrKTooLarge
+	case k < MinKmerLen:
+		return ErrKTooSmall
+	case s.Alpha.Len() != 4:
+		return ErrBadAlphabet
+	}
+	if end-start < k {
+		return nil
+	}
+
+	// Only the k-mer parameters of the index
+	// are used by ForEachMinimizerOf, so the
+	// position table is not built.
+	ki := &Index{
+		k:      k,
+		kMask:  Kmer(util.Pow4(k) - 1),
+		seq:    s,
+		lookUp: s.Alpha.LetterIndex(),
+	}
+	return ki.ForEachMinimizerOf(s, start, end, w, func(_ *Index, position, kmer int) {
+		f(position, Kmer(kmer))
+	})
+}
+
+// Build the Kmer position table from only the (w,k)-minimizers of the indexed sequence,
+// destructively replacing Kmer frequencies. The resulting index is smaller than that built
+// by Build by a factor of approximately (w+1)/2 and is intended for lookup of minimizers
+// found with ForEachMinimizerOf. Check will report a minimizer index as incomplete.
+func (ki *Index) BuildMinimizers(w int) error {
+	for i := range ki.finger {
+		ki.finger[i] = 0
+	}
+	incrementFinger := func(index *Index, _, kmer int) {
+		index.finger[kmer]++
+	}
+	if err := ki.ForEachMinimizerOf(ki.seq, 0, ki.seq.Len(), w, incrementFinger); err != nil {
+		return err
+	}
+
+	var sum Kmer
+	for i, v := range ki.finger {
+		ki.finger[i], sum = sum, sum+v
+	}
+
+	locatePositions := func(index *Index, position, kmer int) {
+		index.pos[index.finger[kmer]] = position
+		index.finger[kmer]++
+	}
+	ki.pos = make([]int, sum)
+	if err := ki.ForEachMinimizerOf(ki.seq, 0, ki.seq.Len(), w, locatePositions); err != nil {
+		return err
+	}
+
+	ki.indexed = true
+
+	return nil
+}
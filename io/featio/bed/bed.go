@@ -22,6 +22,7 @@ import (
 	"io"
 	"reflect"
 	"runtime"
+	"sort"
 	"strconv"
 	"unsafe"
 )
@@ -32,6 +33,8 @@ var (
 	ErrBadStrand          = errors.New("invalid strand")
 	ErrBadColorField      = errors.New("bad color field")
 	ErrMissingBlockValues = errors.New("missing block values")
+	ErrBadBlocks          = errors.New("bad block values")
+	ErrBadThickRange      = errors.New("bad thick range")
 	ErrNoChromField       = errors.New("no chrom field available")
 )
 
@@ -68,6 +71,9 @@ var (
 
 	_ feat.Orienter = (*Bed6)(nil)
 	_ feat.Orienter = (*Bed12)(nil)
+
+	_ feat.Collection = (*Bed12)(nil)
+	_ feat.Feature    = (*Block)(nil)
 )
 
 type Bed interface {
@@ -342,9 +348,37 @@ func parseBed12(line []byte) (b *Bed12, err error) {
 	if b.BlockCount != len(b.BlockSizes) || b.BlockCount != len(b.BlockStarts) {
 		return nil, ErrMissingBlockValues
 	}
+	// Equal thick start and end values, commonly 0,
+	// mark a feature with no thick region and may
+	// lie outside the feature, so only inverted
+	// thick ranges are rejected.
+	if b.ThickEnd < b.ThickStart {
+		return nil, &csv.ParseError{Column: thickEndField, Err: ErrBadThickRange}
+	}
+	if !validBlocks(b.BlockSizes, b.BlockStarts, b.Len()) {
+		return nil, &csv.ParseError{Column: blockStartsField, Err: ErrBadBlocks}
+	}
 	return
 }
 
+// validBlocks returns whether the blocks described by sizes and starts satisfy
+// the BED12 requirements for a feature of length n: blocks must be ordered and
+// not overlap, the first block must start at zero and the last block must end
+// at n.
+func validBlocks(sizes, starts []int, n int) bool {
+	if len(sizes) != len(starts) || len(starts) == 0 || starts[0] != 0 {
+		return false
+	}
+	end := 0
+	for i, s := range starts {
+		if s < end || sizes[i] <= 0 {
+			return false
+		}
+		end = s + sizes[i]
+	}
+	return end == n
+}
+
 func (b *Bed12) Start() int                    { return b.ChromStart }
 func (b *Bed12) End() int                      { return b.ChromEnd }
 func (b *Bed12) Len() int                      { return b.ChromEnd - b.ChromStart }
@@ -355,6 +389,77 @@ func (b *Bed12) Orientation() feat.Orientation { return feat.Orientation(b.FeatS
 func (b *Bed12) canBed(i int) bool             { return i <= 12 }
 func (b *Bed12) Format(fs fmt.State, c rune)   { format(b, fs, c) }
 
+// Features returns the blocks of b as a slice of *Block. If BlockCount does not match the
+// number of block sizes and starts, Features returns nil.
+func (b *Bed12) Features() []feat.Feature {
+	if b.BlockCount != len(b.BlockSizes) || b.BlockCount != len(b.BlockStarts) {
+		return nil
+	}
+	fs := make([]feat.Feature, len(b.BlockStarts))
+	for i, s := range b.BlockStarts {
+		fs[i] = &Block{BlockStart: s, BlockEnd: s + b.BlockSizes[i], Bed: b}
+	}
+	return fs
+}
+
+// SetFeatures sets the blocks of b from the provided features. The features must
+// be located on b and, once sorted by start, must not overlap, the first must
+// start at zero and the last must end at b.Len(). If an error occurs it is
+// returned and the blocks are not set.
+func (b *Bed12) SetFeatures(fs ...feat.Feature) error {
+	sizes, starts, err := blocksOf(b, fs)
+	if err != nil {
+		return err
+	}
+	b.BlockCount = len(starts)
+	b.BlockSizes = sizes
+	b.BlockStarts = starts
+	return nil
+}
+
+// blocksOf returns the BED12 block sizes and starts described by the features
+// in fs which must be located on f.
+func blocksOf(f feat.Feature, fs []feat.Feature) (sizes, starts []int, err error) {
+	fs = append([]feat.Feature(nil), fs...)
+	sort.Sort(byStart(fs))
+	sizes = make([]int, len(fs))
+	starts = make([]int, len(fs))
+	for i, sf := range fs {
+		if sf.Location() != f {
+			return nil, nil, ErrBadBlocks
+		}
+		sizes[i] = sf.Len()
+		starts[i] = sf.Start()
+	}
+	if !validBlocks(sizes, starts, f.Len()) {
+		return nil, nil, ErrBadBlocks
+	}
+	return sizes, starts, nil
+}
+
+type byStart []feat.Feature
+
+func (f byStart) Len() int           { return len(f) }
+func (f byStart) Less(i, j int) bool { return f[i].Start() < f[j].Start() }
+func (f byStart) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+// A Block is a block of a Bed12 feature, usually representing an exon. Block
+// coordinates are relative to the start of the Bed12 feature.
+type Block struct {
+	BlockStart int
+	BlockEnd   int
+	Bed        *Bed12
+}
+
+func (b *Block) Start() int { return b.BlockStart }
+func (b *Block) End() int   { return b.BlockEnd }
+func (b *Block) Len() int   { return b.BlockEnd - b.BlockStart }
+func (b *Block) Name() string {
+	return fmt.Sprintf("%s:[%d,%d)", b.Bed.FeatName, b.BlockStart, b.BlockEnd)
+}
+func (b *Block) Description() string    { return "bed12 block" }
+func (b *Block) Location() feat.Feature { return b.Bed }
+
 // BED format reader type.
 type Reader struct {
 	r       *bufio.Reader
@@ -512,6 +617,14 @@ func (w *Writer) Write(f feat.Feature) (n int, err error) {
 		if !f.canBed(w.BedType) {
 			return 0, ErrBadBedType
 		}
+		if b, ok := f.(*Bed12); ok && w.BedType == 12 {
+			if b.BlockCount != len(b.BlockSizes) || b.BlockCount != len(b.BlockStarts) {
+				return 0, ErrMissingBlockValues
+			}
+			if !validBlocks(b.BlockSizes, b.BlockStarts, b.Len()) {
+				return 0, ErrBadBlocks
+			}
+		}
 		return fmt.Fprintf(w.w, "%*s", w.BedType, f)
 	}
 
@@ -569,8 +682,34 @@ func (w *Writer) Write(f feat.Feature) (n int, err error) {
 		return
 	}
 
-	// Don't handle Bed12.
-	_n, err = w.w.Write([]byte{'\n'})
+	// Bed12
+	//
+	// Features that are a feat.Set have their sub-features written as
+	// blocks, otherwise the feature is written as a single block. No
+	// thick region or item colour is written.
+	var sizes, starts []int
+	if s, ok := f.(feat.Set); ok {
+		sizes, starts, err = blocksOf(f, s.Features())
+		if err != nil {
+			_n, _ = w.w.Write([]byte{'\n'})
+			return n + _n, err
+		}
+	} else {
+		sizes, starts = []int{f.Len()}, []int{0}
+	}
+	_n, err = fmt.Fprintf(w.w, "\t%d\t%d\t0\t%d\t%s\t%s",
+		f.Start(), f.Start(), len(starts), joinInts(sizes), joinInts(starts))
 	n += _n
-	return n, ErrBadBedType
+	return n, err
+}
+
+func joinInts(a []int) []byte {
+	var b []byte
+	for i, v := range a {
+		if i != 0 {
+			b = append(b, ',')
+		}
+		b = strconv.AppendInt(b, int64(v), 10)
+	}
+	return b
 }
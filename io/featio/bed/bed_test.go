@@ -9,6 +9,7 @@ import (
 	"github.com/biogo/biogo/seq"
 
 	"bytes"
+	"encoding/csv"
 	"fmt"
 	"image/color"
 	"strings"
@@ -238,7 +239,7 @@ func (s *S) TestWriteFeature(c *check.C) {
 		},
 		{
 			&tf{chrom: Chrom("test chrom"), start: 1, end: 99, name: "test feat"}, 12,
-			"test chrom\t1\t99\ttest feat\t0\t.\n", ErrBadBedType,
+			"test chrom\t1\t99\ttest feat\t0\t.\t1\t1\t0\t1\t98\t0\n", nil,
 		},
 
 		// Scorer.
@@ -264,7 +265,7 @@ func (s *S) TestWriteFeature(c *check.C) {
 		},
 		{
 			&sctf{tf: tf{chrom: Chrom("test chrom"), start: 1, end: 99, name: "test feat"}, score: 100}, 12,
-			"test chrom\t1\t99\ttest feat\t100\t.\n", ErrBadBedType,
+			"test chrom\t1\t99\ttest feat\t100\t.\t1\t1\t0\t1\t98\t0\n", nil,
 		},
 
 		// feat.Orientater.
@@ -290,7 +291,7 @@ func (s *S) TestWriteFeature(c *check.C) {
 		},
 		{
 			&sttf{tf: tf{chrom: Chrom("test chrom"), start: 1, end: 99, name: "test feat"}, strand: +1}, 12,
-			"test chrom\t1\t99\ttest feat\t0\t+\n", ErrBadBedType,
+			"test chrom\t1\t99\ttest feat\t0\t+\t1\t1\t0\t1\t98\t0\n", nil,
 		},
 
 		// Complete.
@@ -316,7 +317,7 @@ func (s *S) TestWriteFeature(c *check.C) {
 		},
 		{
 			&ctf{tf: tf{chrom: Chrom("test chrom"), start: 1, end: 99, name: "test feat"}, score: 100, strand: +1}, 12,
-			"test chrom\t1\t99\ttest feat\t100\t+\n", ErrBadBedType,
+			"test chrom\t1\t99\ttest feat\t100\t+\t1\t1\t0\t1\t98\t0\n", nil,
 		},
 	} {
 		buf := &bytes.Buffer{}
@@ -328,3 +329,97 @@ func (s *S) TestWriteFeature(c *check.C) {
 		c.Check(buf.String(), check.Equals, f.line, check.Commentf("Test: %d type: Bed%d", i, f.typ))
 	}
 }
+
+type settf struct {
+	tf
+	feats []feat.Feature
+}
+
+func (f *settf) Features() []feat.Feature { return f.feats }
+
+func (s *S) TestBlocks(c *check.C) {
+	r, err := NewReader(strings.NewReader(bedTests[len(bedTests)-1].line), 12)
+	c.Assert(err, check.Equals, nil)
+	f, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	b := f.(*Bed12)
+	fs := b.Features()
+	c.Check(fs, check.DeepEquals, []feat.Feature{
+		&Block{BlockStart: 0, BlockEnd: 354, Bed: b},
+		&Block{BlockStart: 739, BlockEnd: 848, Bed: b},
+		&Block{BlockStart: 1347, BlockEnd: 2536, Bed: b},
+	})
+	c.Check(fs[1].Location(), check.Equals, feat.Feature(b))
+	c.Check(fs[1].Name(), check.Equals, "uc001aaa.3:[739,848)")
+	pos, ref := feat.BasePositionOf(fs[1], 0)
+	c.Check(pos, check.Equals, 12612)
+	c.Check(ref, check.Equals, feat.Feature(Chrom("chr1")))
+
+	c.Check(b.SetFeatures(fs[2], fs[0]), check.Equals, nil)
+	c.Check(b.BlockCount, check.Equals, 2)
+	c.Check(b.BlockSizes, check.DeepEquals, []int{354, 1189})
+	c.Check(b.BlockStarts, check.DeepEquals, []int{0, 1347})
+	c.Check(b.SetFeatures(fs[0]), check.Equals, ErrBadBlocks)
+	c.Check(b.SetFeatures(fs[0], &Block{BlockStart: 300, BlockEnd: 2536, Bed: b}), check.Equals, ErrBadBlocks)
+	c.Check(b.SetFeatures(fs[0], &tf{chrom: Chrom("chr1"), start: 354, end: 2536}), check.Equals, ErrBadBlocks)
+	c.Check(b.BlockCount, check.Equals, 2)
+
+	for i, t := range []struct {
+		line string
+		err  error
+	}{
+		{"chr1\t10\t20\tx\t0\t+\t10\t10\t0\t2\t5,5\t0,4\n", ErrBadBlocks},
+		{"chr1\t10\t20\tx\t0\t+\t10\t10\t0\t2\t5,5\t1,5\n", ErrBadBlocks},
+		{"chr1\t10\t20\tx\t0\t+\t10\t10\t0\t2\t5,4\t0,5\n", ErrBadBlocks},
+		{"chr1\t10\t20\tx\t0\t+\t12\t11\t0\t1\t10\t0\n", ErrBadThickRange},
+		{"chr1\t10\t20\tx\t0\t+\t20\t10\t0\t1\t10\t0\n", ErrBadThickRange},
+	} {
+		r, err := NewReader(strings.NewReader(t.line), 12)
+		c.Assert(err, check.Equals, nil)
+		_, err = r.Read()
+		perr, ok := err.(*csv.ParseError)
+		c.Assert(ok, check.Equals, true, check.Commentf("Test: %d", i))
+		c.Check(perr.Line, check.Equals, 1)
+		c.Check(perr.Err, check.Equals, t.err, check.Commentf("Test: %d", i))
+	}
+
+	for i, line := range []string{
+		"chr1\t10\t20\tx\t0\t+\t0\t0\t0\t1\t10\t0\n",
+		"chr1\t10\t20\tx\t0\t+\t20\t20\t0\t1\t10\t0\n",
+		"chr1\t10\t20\tx\t0\t+\t12\t12\t0\t1\t10\t0\n",
+	} {
+		r, err := NewReader(strings.NewReader(line), 12)
+		c.Assert(err, check.Equals, nil)
+		_, err = r.Read()
+		c.Check(err, check.Equals, nil, check.Commentf("Test: %d", i))
+	}
+
+	c.Check((&Bed12{BlockCount: 2, BlockSizes: []int{10}, BlockStarts: []int{0}}).Features(), check.IsNil)
+	c.Check((&Bed12{BlockCount: 1, BlockSizes: []int{10}, BlockStarts: []int{0, 5}}).Features(), check.IsNil)
+	c.Check((&Bed12{BlockCount: 1}).Features(), check.IsNil)
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, 12)
+	c.Assert(err, check.Equals, nil)
+	_, err = w.Write(&Bed12{Chrom: "chr1", ChromStart: 10, ChromEnd: 20, BlockCount: 1, BlockSizes: []int{5}, BlockStarts: []int{0}})
+	c.Check(err, check.Equals, ErrBadBlocks)
+	_, err = w.Write(&Bed12{Chrom: "chr1", ChromStart: 10, ChromEnd: 20, BlockCount: 2, BlockSizes: []int{10}, BlockStarts: []int{0}})
+	c.Check(err, check.Equals, ErrMissingBlockValues)
+	c.Check(buf.Len(), check.Equals, 0)
+
+	set := &settf{tf: tf{chrom: Chrom("chr2"), start: 100, end: 200, name: "tx"}}
+	set.feats = []feat.Feature{
+		&tf{chrom: set, start: 80, end: 100},
+		&tf{chrom: set, start: 0, end: 30},
+	}
+	n, err := w.Write(set)
+	c.Check(err, check.Equals, nil)
+	c.Check(n, check.Equals, buf.Len())
+	c.Check(buf.String(), check.Equals, "chr2\t100\t200\ttx\t0\t.\t100\t100\t0\t2\t30,20\t0,80\n")
+
+	r, err = NewReader(&buf, 12)
+	c.Assert(err, check.Equals, nil)
+	f, err = r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(f.(*Bed12).Features()[1].Start(), check.Equals, 80)
+}
@@ -0,0 +1,711 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gff3 provides types to read and write version 3 General Feature Format
+// files according to the Sequence Ontology specification.
+//
+// The specification can be found at https://github.com/The-Sequence-Ontology/Specifications/blob/master/gff3.md.
+package gff3
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ featio.Reader = (*Reader)(nil)
+	_ featio.Writer = (*Writer)(nil)
+)
+
+// Version is the GFF version that is read and written.
+const Version = 3
+
+type Error struct{ string }
+
+func (e Error) Error() string { return e.string }
+
+var (
+	ErrBadFeature     = Error{"gff3: feature start not less than feature end"}
+	ErrBadStrand      = Error{"gff3: invalid strand"}
+	ErrBadPhase       = Error{"gff3: invalid phase"}
+	ErrBadAttribute   = Error{"gff3: invalid attribute"}
+	ErrBadEscape      = Error{"gff3: invalid percent escape"}
+	ErrFieldMissing   = Error{"gff3: missing fields"}
+	ErrBadMetaLine    = Error{"gff3: incomplete metaline"}
+	ErrNotHandled     = Error{"gff3: type not handled"}
+	ErrCannotHeader   = Error{"gff3: cannot write header: data written"}
+	ErrFeatureInFASTA = Error{"gff3: cannot write feature after FASTA section"}
+)
+
+// A ParentError is returned when a feature refers to a parent that is not defined before
+// the next ### directive or the end of the feature section.
+type ParentError struct {
+	// ID is the identifier of
+	// the missing parent.
+	ID string
+
+	// Child is the first feature
+	// referring to the parent.
+	Child *Feature
+}
+
+func (e *ParentError) Error() string {
+	return fmt.Sprintf("gff3: unresolved parent %q of %s", e.ID, e.Child.Name())
+}
+
+const (
+	seqidField = iota
+	sourceField
+	typeField
+	startField
+	endField
+	scoreField
+	strandField
+	phaseField
+	attributeField
+	fieldCount
+)
+
+// Phase is the phase of a CDS feature, the number of bases that must be removed from the
+// start of the feature to reach the first base of the next codon.
+type Phase int8
+
+func (p Phase) String() string {
+	if p == NoPhase {
+		return "."
+	}
+	return strconv.Itoa(int(p))
+}
+
+const (
+	NoPhase Phase = iota - 1
+	Phase0
+	Phase1
+	Phase2
+)
+
+// Sequence is the landmark sequence on which features are located.
+type Sequence struct {
+	SeqID string
+}
+
+func (s Sequence) Start() int             { return 0 }
+func (s Sequence) End() int               { return 0 }
+func (s Sequence) Len() int               { return 0 }
+func (s Sequence) Name() string           { return s.SeqID }
+func (s Sequence) Description() string    { return "GFF3 sequence" }
+func (s Sequence) Location() feat.Feature { return nil }
+
+// A Region is the extent of a landmark sequence given by a ##sequence-region directive.
+type Region struct {
+	Sequence
+	RegionStart int
+	RegionEnd   int
+}
+
+func (r *Region) Start() int             { return r.RegionStart }
+func (r *Region) End() int               { return r.RegionEnd }
+func (r *Region) Len() int               { return r.RegionEnd - r.RegionStart }
+func (r *Region) Description() string    { return "GFF3 region" }
+func (r *Region) Location() feat.Feature { return r.Sequence }
+
+// An Attribute is a GFF3 attribute with one or more values.
+type Attribute struct {
+	Tag    string
+	Values []string
+}
+
+// Attributes is a list of feature attributes.
+type Attributes []Attribute
+
+// Get returns the first value of the attribute with the given tag, or the empty string if
+// no such attribute exists.
+func (a Attributes) Get(tag string) string {
+	v := a.Values(tag)
+	if len(v) == 0 {
+		return ""
+	}
+	return v[0]
+}
+
+// Values returns the values of the attribute with the given tag.
+func (a Attributes) Values(tag string) []string {
+	for _, tv := range a {
+		if tv.Tag == tag {
+			return tv.Values
+		}
+	}
+	return nil
+}
+
+// String returns the percent-escaped GFF3 representation of the attributes.
+func (a Attributes) String() string {
+	var buf bytes.Buffer
+	for i, tv := range a {
+		if i != 0 {
+			buf.WriteByte(';')
+		}
+		buf.WriteString(escape(tv.Tag, attributeReserved))
+		buf.WriteByte('=')
+		for j, v := range tv.Values {
+			if j != 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(escape(v, attributeReserved))
+		}
+	}
+	return buf.String()
+}
+
+// A Feature represents a GFF3 feature line. Features that share an ID are parts of a
+// single discontinuous feature.
+type Feature struct {
+	// SeqID is the identifier of the
+	// landmark sequence on which the
+	// feature is located.
+	SeqID string
+
+	// Source describes the program or
+	// database that produced the feature.
+	Source string
+
+	// Type is the feature type, a
+	// Sequence Ontology term or accession.
+	Type string
+
+	// FeatStart and FeatEnd are the
+	// zero-based half-open bounds of the
+	// feature.
+	FeatStart, FeatEnd int
+
+	// FeatScore is the score of the feature.
+	// A nil value indicates the score is not
+	// available.
+	FeatScore *float64
+
+	// FeatStrand is the strand of the
+	// feature. The unknown strand, "?", is
+	// read as seq.None.
+	FeatStrand seq.Strand
+
+	// FeatPhase is the phase of a CDS
+	// feature, and NoPhase otherwise.
+	FeatPhase Phase
+
+	// FeatAttributes holds the attributes
+	// of the feature in the order they are
+	// written.
+	FeatAttributes Attributes
+
+	// Parents and Children are the features
+	// linked to the feature by Parent
+	// attributes, in the order they are
+	// resolved by a Reader. Where a parent
+	// is a discontinuous feature, the first
+	// part read is used.
+	Parents, Children []*Feature
+}
+
+func (g *Feature) Start() int { return g.FeatStart }
+func (g *Feature) End() int   { return g.FeatEnd }
+func (g *Feature) Len() int   { return g.FeatEnd - g.FeatStart }
+
+// Name returns the value of the Name or ID attribute of the feature, or a description of its
+// type and position if neither is present.
+func (g *Feature) Name() string {
+	if n := g.FeatAttributes.Get("Name"); n != "" {
+		return n
+	}
+	if id := g.FeatAttributes.Get("ID"); id != "" {
+		return id
+	}
+	return fmt.Sprintf("%s/%s:[%d,%d)", g.Type, g.SeqID, g.FeatStart, g.FeatEnd)
+}
+
+// Description returns the type and source of the feature.
+func (g *Feature) Description() string    { return fmt.Sprintf("%s/%s", g.Type, g.Source) }
+func (g *Feature) Location() feat.Feature { return Sequence{SeqID: g.SeqID} }
+
+// Metadata holds the directives read from a GFF3 file.
+type Metadata struct {
+	// Version is the version given by
+	// the ##gff-version directive.
+	Version string
+
+	// Directives holds the text of other
+	// directives, without the leading ##,
+	// in the order they were read.
+	Directives []string
+}
+
+// A Reader reads GFF3 features, sequence regions and sequences.
+type Reader struct {
+	r    *bufio.Reader
+	line int
+
+	// Template is the sequence type
+	// used for sequences read from the
+	// FASTA section.
+	Template seqio.SequenceAppender
+
+	fasta *fasta.Reader
+
+	ids     map[string]*Feature
+	pending map[string][]*Feature
+	order   []string
+
+	Metadata
+}
+
+// NewReader returns a new GFF3 format reader that reads from r. Sequences in the FASTA
+// section are read as *linear.Seq with the alphabet.DNAredundant alphabet unless the
+// Template field is changed before the FASTA section is reached.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r:        bufio.NewReader(r),
+		Template: linear.NewSeq("", nil, alphabet.DNAredundant),
+		ids:      make(map[string]*Feature),
+		pending:  make(map[string][]*Feature),
+	}
+}
+
+// Read reads a single feature, sequence region or sequence and returns it or an error.
+// Features are returned as *Feature, ##sequence-region directives as *Region and the
+// sequences of the FASTA section as seq.Sequence. Other directives are recorded in the
+// Reader's Metadata field.
+//
+// The Parents and Children fields of features are linked as Parent attributes are
+// resolved. Parent references may precede the definition of the parent, but must be
+// resolved before the next ### directive, ##FASTA directive or the end of the input,
+// otherwise a *ParentError is returned. Children linked after a feature is returned by Read
+// are only visible once resolution is complete.
+func (r *Reader) Read() (feat.Feature, error) {
+	if r.fasta != nil {
+		return r.fasta.Read()
+	}
+	for {
+		line, err := r.r.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			if err != io.EOF {
+				return nil, &csv.ParseError{Line: r.line, Err: err}
+			}
+			if err := r.resolved(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		r.line++
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.TrimSpace(line) == "":
+			continue
+		case line == "###":
+			if err := r.resolved(); err != nil {
+				return nil, err
+			}
+			continue
+		case strings.HasPrefix(line, "##"):
+			f, err := r.directive(line[2:])
+			if f != nil || err != nil {
+				return f, err
+			}
+			continue
+		case line[0] == '>':
+			return r.startFASTA(line + "\n")
+		case line[0] == '#':
+			continue
+		}
+		return r.feature(line)
+	}
+}
+
+func (r *Reader) directive(line string) (feat.Feature, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return nil, &csv.ParseError{Line: r.line, Err: ErrBadMetaLine}
+	}
+	switch fields[0] {
+	case "gff-version":
+		if len(fields) < 2 {
+			return nil, &csv.ParseError{Line: r.line, Err: ErrBadMetaLine}
+		}
+		major := strings.SplitN(fields[1], ".", 2)[0]
+		v, err := strconv.Atoi(major)
+		if err != nil || v != Version {
+			return nil, &csv.ParseError{Line: r.line, Err: ErrNotHandled}
+		}
+		r.Version = fields[1]
+	case "sequence-region":
+		if len(fields) < 4 {
+			return nil, &csv.ParseError{Line: r.line, Err: ErrBadMetaLine}
+		}
+		id, err := unescape(fields[1])
+		if err != nil {
+			return nil, &csv.ParseError{Line: r.line, Err: err}
+		}
+		start, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, &csv.ParseError{Line: r.line, Err: err}
+		}
+		end, err := strconv.Atoi(fields[3])
+		if err != nil {
+			return nil, &csv.ParseError{Line: r.line, Err: err}
+		}
+		return &Region{
+			Sequence:    Sequence{SeqID: id},
+			RegionStart: feat.OneToZero(start),
+			RegionEnd:   end,
+		}, nil
+	case "FASTA":
+		return r.startFASTA("")
+	default:
+		r.Directives = append(r.Directives, line)
+	}
+	return nil, nil
+}
+
+// startFASTA switches the Reader to reading the FASTA section, starting with the text in
+// prefix, and returns the first sequence.
+func (r *Reader) startFASTA(prefix string) (feat.Feature, error) {
+	if err := r.resolved(); err != nil {
+		return nil, err
+	}
+	r.fasta = fasta.NewReader(io.MultiReader(strings.NewReader(prefix), r.r), r.Template)
+	return r.fasta.Read()
+}
+
+func (r *Reader) feature(line string) (feat.Feature, error) {
+	fields := strings.Split(line, "\t")
+	if len(fields) < fieldCount-1 || len(fields) > fieldCount {
+		return nil, &csv.ParseError{Line: r.line, Column: len(fields), Err: ErrFieldMissing}
+	}
+	var (
+		f   Feature
+		err error
+	)
+	for i, p := range []*string{&f.SeqID, &f.Source, &f.Type} {
+		*p, err = unescape(fields[i])
+		if err != nil {
+			return nil, &csv.ParseError{Line: r.line, Column: i, Err: err}
+		}
+	}
+	start, err := strconv.Atoi(fields[startField])
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: startField, Err: err}
+	}
+	f.FeatStart = feat.OneToZero(start)
+	f.FeatEnd, err = strconv.Atoi(fields[endField])
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: endField, Err: err}
+	}
+	if f.FeatStart < 0 || f.FeatStart >= f.FeatEnd {
+		return nil, &csv.ParseError{Line: r.line, Column: endField, Err: ErrBadFeature}
+	}
+	if fields[scoreField] != "." {
+		s, err := strconv.ParseFloat(fields[scoreField], 64)
+		if err != nil {
+			return nil, &csv.ParseError{Line: r.line, Column: scoreField, Err: err}
+		}
+		f.FeatScore = &s
+	}
+	switch fields[strandField] {
+	case "+":
+		f.FeatStrand = seq.Plus
+	case "-":
+		f.FeatStrand = seq.Minus
+	case ".", "?":
+		f.FeatStrand = seq.None
+	default:
+		return nil, &csv.ParseError{Line: r.line, Column: strandField, Err: ErrBadStrand}
+	}
+	switch fields[phaseField] {
+	case ".":
+		f.FeatPhase = NoPhase
+	case "0", "1", "2":
+		f.FeatPhase = Phase(fields[phaseField][0] - '0')
+	default:
+		return nil, &csv.ParseError{Line: r.line, Column: phaseField, Err: ErrBadPhase}
+	}
+	if len(fields) > attributeField {
+		f.FeatAttributes, err = parseAttributes(fields[attributeField])
+		if err != nil {
+			return nil, &csv.ParseError{Line: r.line, Column: attributeField, Err: err}
+		}
+	}
+	r.link(&f)
+	return &f, nil
+}
+
+// link resolves the ID and Parent attributes of f against the features read since the
+// last resolution barrier.
+func (r *Reader) link(f *Feature) {
+	if id := f.FeatAttributes.Get("ID"); id != "" {
+		if _, ok := r.ids[id]; !ok {
+			r.ids[id] = f
+			for _, c := range r.pending[id] {
+				c.Parents = append(c.Parents, f)
+				f.Children = append(f.Children, c)
+			}
+			delete(r.pending, id)
+		}
+	}
+	for _, id := range f.FeatAttributes.Values("Parent") {
+		p, ok := r.ids[id]
+		if !ok {
+			if _, ok := r.pending[id]; !ok {
+				r.order = append(r.order, id)
+			}
+			r.pending[id] = append(r.pending[id], f)
+			continue
+		}
+		f.Parents = append(f.Parents, p)
+		p.Children = append(p.Children, f)
+	}
+}
+
+// resolved returns a *ParentError if any parent reference is unresolved and clears the
+// features held for resolution.
+func (r *Reader) resolved() error {
+	var err error
+	for _, id := range r.order {
+		if c, ok := r.pending[id]; ok {
+			err = &ParentError{ID: id, Child: c[0]}
+			break
+		}
+	}
+	r.ids = make(map[string]*Feature)
+	r.pending = make(map[string][]*Feature)
+	r.order = r.order[:0]
+	return err
+}
+
+// parseAttributes parses a GFF3 attribute column.
+func parseAttributes(s string) (Attributes, error) {
+	if s == "." || s == "" {
+		return nil, nil
+	}
+	var a Attributes
+	for _, tv := range strings.Split(s, ";") {
+		tv = strings.TrimSpace(tv)
+		if tv == "" {
+			continue
+		}
+		i := strings.Index(tv, "=")
+		if i <= 0 {
+			return nil, ErrBadAttribute
+		}
+		tag, err := unescape(tv[:i])
+		if err != nil {
+			return nil, err
+		}
+		vals := strings.Split(tv[i+1:], ",")
+		for j, v := range vals {
+			vals[j], err = unescape(v)
+			if err != nil {
+				return nil, err
+			}
+		}
+		a = append(a, Attribute{Tag: tag, Values: vals})
+	}
+	return a, nil
+}
+
+// unescape returns s with percent-escaped characters decoded.
+func unescape(s string) (string, error) {
+	if strings.IndexByte(s, '%') < 0 {
+		return s, nil
+	}
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			b = append(b, s[i])
+			continue
+		}
+		if i+2 >= len(s) {
+			return "", ErrBadEscape
+		}
+		c, err := strconv.ParseUint(s[i+1:i+3], 16, 8)
+		if err != nil {
+			return "", ErrBadEscape
+		}
+		b = append(b, byte(c))
+		i += 2
+	}
+	return string(b), nil
+}
+
+const (
+	// columnReserved holds the characters
+	// that must be escaped in columns 1-8.
+	columnReserved = "%\t\n\r"
+
+	// attributeReserved holds the characters
+	// that must be escaped in attributes.
+	attributeReserved = "%\t\n\r;=&,"
+)
+
+// escape returns s with control characters and characters in reserved percent-escaped.
+func escape(s, reserved string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < ' ' || c == 0x7f || strings.IndexByte(reserved, c) >= 0 {
+			fmt.Fprintf(&buf, "%%%02X", c)
+		} else {
+			buf.WriteByte(c)
+		}
+	}
+	return buf.String()
+}
+
+// escapeSeqID returns the sequence identifier s with characters that are not permitted
+// unescaped in a seqid percent-escaped.
+func escapeSeqID(s string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', strings.IndexByte(".:^*$@!+_?-|", c) >= 0:
+			buf.WriteByte(c)
+		default:
+			fmt.Fprintf(&buf, "%%%02X", c)
+		}
+	}
+	return buf.String()
+}
+
+// A Writer outputs features, sequence regions and sequences in GFF3 format.
+type Writer struct {
+	w         io.Writer
+	Precision int
+	Width     int
+	header    bool
+	fasta     bool
+}
+
+// NewWriter returns a new GFF3 format writer using w. Sequences are written with lines of
+// width letters. When header is true, a version header is written.
+func NewWriter(w io.Writer, width int, header bool) *Writer {
+	gw := &Writer{
+		w:         w,
+		Width:     width,
+		Precision: -1,
+	}
+	if header {
+		gw.WriteMetaData(Version)
+	}
+	return gw
+}
+
+// Write writes a single feature and returns the number of bytes written and any error.
+// gff3.Features are written as GFF3 feature lines and seq.Sequences are written in FASTA
+// format in the FASTA section, which is started by the first sequence written. Features
+// cannot be written once the FASTA section has started. gff3.Sequences are not handled
+// and return ErrNotHandled. All other feat.Features are written as sequence region
+// directives.
+func (w *Writer) Write(f feat.Feature) (n int, err error) {
+	// Sequences have a zero length, so must
+	// be rejected before the length check.
+	if _, ok := f.(Sequence); ok {
+		return 0, ErrNotHandled
+	}
+	if f.Start() >= f.End() {
+		return 0, ErrBadFeature
+	}
+	if _, ok := f.(seq.Sequence); !ok && w.fasta {
+		return 0, ErrFeatureInFASTA
+	}
+	w.header = true
+	switch f := f.(type) {
+	case *Feature:
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "%s\t%s\t%s\t%d\t%d\t",
+			escapeSeqID(f.SeqID),
+			orDot(escape(f.Source, columnReserved)),
+			escape(f.Type, columnReserved),
+			feat.ZeroToOne(f.FeatStart),
+			f.FeatEnd,
+		)
+		switch {
+		case f.FeatScore == nil || math.IsNaN(*f.FeatScore):
+			buf.WriteByte('.')
+		case w.Precision < 0:
+			fmt.Fprint(&buf, *f.FeatScore)
+		default:
+			fmt.Fprintf(&buf, "%.*f", w.Precision, *f.FeatScore)
+		}
+		fmt.Fprintf(&buf, "\t%s\t%s\t%s\n", f.FeatStrand, f.FeatPhase, orDot(f.FeatAttributes.String()))
+		return w.w.Write(buf.Bytes())
+	case seq.Sequence:
+		if !w.fasta {
+			n, err = w.w.Write([]byte("##FASTA\n"))
+			if err != nil {
+				return n, err
+			}
+			w.fasta = true
+		}
+		_n, err := fasta.NewWriter(w.w, w.Width).Write(f)
+		return n + _n, err
+	case *Region:
+		return fmt.Fprintf(w.w, "##sequence-region %s %d %d\n", escapeSeqID(f.SeqID), feat.ZeroToOne(f.RegionStart), f.RegionEnd)
+	default:
+		return fmt.Fprintf(w.w, "##sequence-region %s %d %d\n", escapeSeqID(f.Name()), feat.ZeroToOne(f.Start()), f.End())
+	}
+}
+
+// orDot returns s, or "." if s is empty.
+func orDot(s string) string {
+	if s == "" {
+		return "."
+	}
+	return s
+}
+
+// WriteMetaData writes a directive line to a GFF3 file. Strings are written verbatim
+// following "##", and an int is interpreted as a version number and can only be written
+// before any other data. All other types return an ErrNotHandled.
+func (w *Writer) WriteMetaData(d interface{}) (n int, err error) {
+	if w.fasta {
+		return 0, ErrFeatureInFASTA
+	}
+	defer func() { w.header = true }()
+	switch d := d.(type) {
+	case string:
+		return fmt.Fprintf(w.w, "##%s\n", d)
+	case int:
+		if w.header {
+			return 0, ErrCannotHeader
+		}
+		return fmt.Fprintf(w.w, "##gff-version %d\n", d)
+	}
+	return 0, ErrNotHandled
+}
+
+// WriteResolved writes a ### directive, indicating that all forward references to parent
+// features have been resolved.
+func (w *Writer) WriteResolved() (n int, err error) {
+	if w.fasta {
+		return 0, ErrFeatureInFASTA
+	}
+	return w.w.Write([]byte("###\n"))
+}
+
+// WriteComment writes a comment line to a GFF3 file.
+func (w *Writer) WriteComment(c string) (n int, err error) {
+	return fmt.Fprintf(w.w, "# %s\n", c)
+}
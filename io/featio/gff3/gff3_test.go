@@ -0,0 +1,200 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gff3
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Helpers
+func floatPtr(f float64) *float64 { return &f }
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const testGFF3 = `##gff-version 3.1.26
+##sequence-region ctg123 1 1497228
+##species https://www.ncbi.nlm.nih.gov/Taxonomy/Browser/wwwtax.cgi?id=9606
+ctg123	.	gene	1000	9000	.	+	.	ID=gene00001;Name=EDEN;Note=protein kinase%3B putative,second note
+ctg123	.	mRNA	1050	9000	.	+	.	ID=mRNA00001;Parent=gene00001;Name=EDEN.1
+ctg123	.	CDS	1201	1500	.	+	0	ID=cds00001;Parent=mRNA00001
+ctg123	.	CDS	3000	3902	.	+	1	ID=cds00001;Parent=mRNA00001
+ctg123	.	exon	1300	1500	.	+	.	Parent=mRNA00003,mRNA00001
+ctg123	.	mRNA	1300	9000	0.5	+	.	ID=mRNA00003;Parent=gene00001
+###
+ctg%20124	src	match	10	20	1e-05	-	.	.
+##FASTA
+>ctg123 a contig
+ACGTACGTAC
+GTAC
+>ctg124
+TTTT
+`
+
+func (s *S) TestRead(c *check.C) {
+	r := NewReader(strings.NewReader(testGFF3))
+	var (
+		feats []*Feature
+		seqs  []seq.Sequence
+		reg   *Region
+	)
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		switch f := f.(type) {
+		case *Feature:
+			feats = append(feats, f)
+		case *Region:
+			reg = f
+		case seq.Sequence:
+			seqs = append(seqs, f)
+		default:
+			c.Fatalf("unexpected feature type %T", f)
+		}
+	}
+	c.Check(r.Version, check.Equals, "3.1.26")
+	c.Check(r.Directives, check.DeepEquals, []string{"species https://www.ncbi.nlm.nih.gov/Taxonomy/Browser/wwwtax.cgi?id=9606"})
+	c.Check(reg, check.DeepEquals, &Region{Sequence: Sequence{SeqID: "ctg123"}, RegionStart: 0, RegionEnd: 1497228})
+
+	c.Assert(len(feats), check.Equals, 7)
+	gene, mRNA1, cds1, cds2, exon, mRNA3, match := feats[0], feats[1], feats[2], feats[3], feats[4], feats[5], feats[6]
+	c.Check(gene.Name(), check.Equals, "EDEN")
+	c.Check(gene.Start(), check.Equals, 999)
+	c.Check(gene.End(), check.Equals, 9000)
+	c.Check(gene.FeatPhase, check.Equals, NoPhase)
+	c.Check(gene.FeatAttributes.Values("Note"), check.DeepEquals, []string{"protein kinase; putative", "second note"})
+	c.Check(len(gene.Children) == 2 && gene.Children[0] == mRNA1 && gene.Children[1] == mRNA3, check.Equals, true)
+	c.Check(len(mRNA1.Parents) == 1 && mRNA1.Parents[0] == gene, check.Equals, true)
+	c.Check(len(mRNA1.Children), check.Equals, 3)
+	c.Check(mRNA1.Children[0] == cds1 && mRNA1.Children[1] == cds2 && mRNA1.Children[2] == exon, check.Equals, true)
+	c.Check(cds2.FeatPhase, check.Equals, Phase1)
+	c.Check(len(exon.Parents) == 2 && exon.Parents[0] == mRNA1 && exon.Parents[1] == mRNA3, check.Equals, true)
+	c.Check(*mRNA3.FeatScore, check.Equals, 0.5)
+	c.Check(match.SeqID, check.Equals, "ctg 124")
+	c.Check(match.FeatStrand, check.Equals, seq.Minus)
+	c.Check(match.FeatAttributes, check.IsNil)
+	c.Check(match.Name(), check.Equals, "match/ctg 124:[9,20)")
+	c.Check(match.Location(), check.Equals, feat.Feature(Sequence{SeqID: "ctg 124"}))
+
+	c.Assert(len(seqs), check.Equals, 2)
+	c.Check(seqs[0].Name(), check.Equals, "ctg123")
+	c.Check(seqs[0].Description(), check.Equals, "a contig")
+	c.Check(seqs[0].(*linear.Seq).Seq.String(), check.Equals, "ACGTACGTACGTAC")
+	c.Check(seqs[1].(*linear.Seq).Seq.String(), check.Equals, "TTTT")
+}
+
+func (s *S) TestReadErrors(c *check.C) {
+	for i, t := range []struct {
+		gff  string
+		line int
+		err  error
+	}{
+		{gff: "##gff-version 2\n", line: 1, err: ErrNotHandled},
+		{gff: "c\t.\tgene\t10\t5\t.\t+\t.\t.\n", line: 1, err: ErrBadFeature},
+		{gff: "c\t.\tgene\t1\t5\t.\tx\t.\t.\n", line: 1, err: ErrBadStrand},
+		{gff: "c\t.\tCDS\t1\t5\t.\t+\t3\t.\n", line: 1, err: ErrBadPhase},
+		{gff: "c\t.\tgene\t1\t5\t.\t+\t.\tID\n", line: 1, err: ErrBadAttribute},
+		{gff: "c\t.\tgene\t1\t5\t.\t+\t.\tID=a%2\n", line: 1, err: ErrBadEscape},
+		{gff: "c\t.\tgene\t1\t5\t.\t+\n", line: 1, err: ErrFieldMissing},
+	} {
+		_, err := NewReader(strings.NewReader(t.gff)).Read()
+		perr, ok := err.(*csv.ParseError)
+		c.Assert(ok, check.Equals, true, check.Commentf("Test %d: %v", i, err))
+		c.Check(perr.Line, check.Equals, t.line, check.Commentf("Test %d", i))
+		c.Check(perr.Err, check.Equals, t.err, check.Commentf("Test %d", i))
+	}
+
+	for _, gff := range []string{
+		"c\t.\tmRNA\t1\t5\t.\t+\t.\tID=m;Parent=g\n###\nc\t.\tgene\t1\t5\t.\t+\t.\tID=g\n",
+		"c\t.\tmRNA\t1\t5\t.\t+\t.\tID=m;Parent=g\n",
+	} {
+		r := NewReader(strings.NewReader(gff))
+		_, err := r.Read()
+		c.Assert(err, check.Equals, nil)
+		_, err = r.Read()
+		c.Check(err, check.ErrorMatches, `gff3: unresolved parent "g" of m`)
+	}
+}
+
+func (s *S) TestWrite(c *check.C) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, 6, true)
+	_, err := w.Write(&Region{Sequence: Sequence{SeqID: "ctg123"}, RegionEnd: 2000})
+	c.Check(err, check.Equals, nil)
+	_, err = w.WriteMetaData("species 9606")
+	c.Check(err, check.Equals, nil)
+	_, err = w.WriteMetaData(3)
+	c.Check(err, check.Equals, ErrCannotHeader)
+	_, err = w.Write(Sequence{SeqID: "ctg123"})
+	c.Check(err, check.Equals, ErrNotHandled)
+	f := &Feature{
+		SeqID:      "ctg 123",
+		Source:     ".",
+		Type:       "gene",
+		FeatStart:  999,
+		FeatEnd:    2000,
+		FeatScore:  floatPtr(2.5),
+		FeatStrand: seq.Minus,
+		FeatPhase:  NoPhase,
+		FeatAttributes: Attributes{
+			{Tag: "ID", Values: []string{"gene;1"}},
+			{Tag: "Note", Values: []string{"a=b", "c,d"}},
+		},
+	}
+	n, err := w.Write(f)
+	c.Check(err, check.Equals, nil)
+	c.Check(n, check.Equals, 64)
+	_, err = w.WriteResolved()
+	c.Check(err, check.Equals, nil)
+	_, err = w.Write(&Feature{SeqID: "ctg123", Type: "CDS", FeatEnd: 3, FeatPhase: Phase2})
+	c.Check(err, check.Equals, nil)
+	_, err = w.Write(linear.NewSeq("ctg123", alphabet.BytesToLetters([]byte("ACGTACGT")), alphabet.DNA))
+	c.Check(err, check.Equals, nil)
+	_, err = w.Write(f)
+	c.Check(err, check.Equals, ErrFeatureInFASTA)
+	c.Check(buf.String(), check.Equals, `##gff-version 3
+##sequence-region ctg123 1 2000
+##species 9606
+ctg%20123	.	gene	1000	2000	2.5	-	.	ID=gene%3B1;Note=a%3Db,c%2Cd
+###
+ctg123	.	CDS	1	3	.	.	2	.
+##FASTA
+>ctg123
+ACGTAC
+GT
+`)
+
+	r := NewReader(&buf)
+	var got []feat.Feature
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		got = append(got, f)
+	}
+	c.Assert(len(got), check.Equals, 4)
+	c.Check(got[1], check.DeepEquals, f)
+	c.Check(got[3].(*linear.Seq).Seq.String(), check.Equals, "ACGTACGT")
+}
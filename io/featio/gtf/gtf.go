@@ -0,0 +1,336 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gtf provides types to read and write Gene Transfer Format files and to
+// reconstruct the gene models they describe.
+//
+// The GTF2.2 specification can be found at http://mblab.wustl.edu/GTF22.html.
+package gtf
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/io/featio/gff"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ featio.Reader = (*Reader)(nil)
+	_ featio.Writer = (*Writer)(nil)
+)
+
+type Error struct{ string }
+
+func (e Error) Error() string { return e.string }
+
+var (
+	ErrBadFeature          = Error{"gtf: feature start not less than feature end"}
+	ErrBadStrand           = Error{"gtf: invalid strand"}
+	ErrBadFrame            = Error{"gtf: invalid frame"}
+	ErrBadAttribute        = Error{"gtf: invalid attribute"}
+	ErrFieldMissing        = Error{"gtf: missing fields"}
+	ErrMissingGeneID       = Error{"gtf: missing gene_id"}
+	ErrMissingTranscriptID = Error{"gtf: missing transcript_id"}
+	ErrNotHandled          = Error{"gtf: type not handled"}
+)
+
+const (
+	nameField = iota
+	sourceField
+	featureField
+	startField
+	endField
+	scoreField
+	strandField
+	frameField
+	attributeField
+	fieldCount
+)
+
+// An Attribute is a GTF attribute other than gene_id and transcript_id.
+type Attribute struct {
+	Tag, Value string
+}
+
+// Attributes is a list of feature attributes.
+type Attributes []Attribute
+
+// Get returns the value of the first attribute with the given tag, or the empty string if
+// no such attribute exists.
+func (a Attributes) Get(tag string) string {
+	for _, tv := range a {
+		if tv.Tag == tag {
+			return tv.Value
+		}
+	}
+	return ""
+}
+
+// A Feature represents a GTF feature line.
+type Feature struct {
+	// SeqName is the name of the sequence
+	// on which the feature is located.
+	SeqName string
+
+	// Source describes the program or
+	// database that produced the feature.
+	Source string
+
+	// Feature is the feature type, for
+	// example "exon" or "CDS".
+	Feature string
+
+	// FeatStart and FeatEnd are the
+	// zero-based half-open bounds of the
+	// feature.
+	FeatStart, FeatEnd int
+
+	// FeatScore is the score of the feature.
+	// A nil value indicates the score is not
+	// available.
+	FeatScore *float64
+
+	// FeatStrand is the strand of the
+	// feature.
+	FeatStrand seq.Strand
+
+	// FeatFrame is the frame of CDS,
+	// start_codon and stop_codon features,
+	// and gff.NoFrame otherwise.
+	FeatFrame gff.Frame
+
+	// GeneID and TranscriptID are the
+	// values of the gene_id and
+	// transcript_id attributes. GTF
+	// requires both, although gene
+	// features commonly omit the
+	// transcript_id.
+	GeneID, TranscriptID string
+
+	// FeatAttributes holds the other
+	// attributes of the feature in the
+	// order they are written.
+	FeatAttributes Attributes
+}
+
+func (g *Feature) Start() int { return g.FeatStart }
+func (g *Feature) End() int   { return g.FeatEnd }
+func (g *Feature) Len() int   { return g.FeatEnd - g.FeatStart }
+func (g *Feature) Name() string {
+	return fmt.Sprintf("%s/%s:[%d,%d)", g.Feature, g.SeqName, g.FeatStart, g.FeatEnd)
+}
+func (g *Feature) Description() string    { return fmt.Sprintf("%s/%s", g.Feature, g.Source) }
+func (g *Feature) Location() feat.Feature { return gff.Sequence{SeqName: g.SeqName} }
+
+// A Reader reads GTF features.
+type Reader struct {
+	r    *bufio.Reader
+	line int
+}
+
+// NewReader returns a new GTF format reader that reads from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Read reads a single feature and returns it as a *Feature, or an error. Comment lines are
+// skipped.
+func (r *Reader) Read() (feat.Feature, error) {
+	var line string
+	for {
+		var err error
+		line, err = r.r.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			if err == io.EOF {
+				return nil, err
+			}
+			return nil, &csv.ParseError{Line: r.line, Err: err}
+		}
+		r.line++
+		line = strings.TrimRight(line, "\r\n")
+		if strings.TrimSpace(line) != "" && line[0] != '#' {
+			break
+		}
+	}
+
+	fields := strings.SplitN(line, "\t", fieldCount)
+	if len(fields) < fieldCount {
+		return nil, &csv.ParseError{Line: r.line, Column: len(fields), Err: ErrFieldMissing}
+	}
+	f := &Feature{
+		SeqName: fields[nameField],
+		Source:  fields[sourceField],
+		Feature: fields[featureField],
+	}
+	start, err := strconv.Atoi(fields[startField])
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: startField, Err: err}
+	}
+	f.FeatStart = feat.OneToZero(start)
+	f.FeatEnd, err = strconv.Atoi(fields[endField])
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: endField, Err: err}
+	}
+	if f.FeatStart < 0 || f.FeatStart >= f.FeatEnd {
+		return nil, &csv.ParseError{Line: r.line, Column: endField, Err: ErrBadFeature}
+	}
+	if fields[scoreField] != "." {
+		s, err := strconv.ParseFloat(fields[scoreField], 64)
+		if err != nil {
+			return nil, &csv.ParseError{Line: r.line, Column: scoreField, Err: err}
+		}
+		f.FeatScore = &s
+	}
+	switch fields[strandField] {
+	case "+":
+		f.FeatStrand = seq.Plus
+	case "-":
+		f.FeatStrand = seq.Minus
+	case ".":
+		f.FeatStrand = seq.None
+	default:
+		return nil, &csv.ParseError{Line: r.line, Column: strandField, Err: ErrBadStrand}
+	}
+	switch fields[frameField] {
+	case ".":
+		f.FeatFrame = gff.NoFrame
+	case "0", "1", "2":
+		f.FeatFrame = gff.Frame(fields[frameField][0] - '0')
+	default:
+		return nil, &csv.ParseError{Line: r.line, Column: frameField, Err: ErrBadFrame}
+	}
+	err = parseAttributes(f, fields[attributeField])
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: attributeField, Err: err}
+	}
+	if f.GeneID == "" {
+		return nil, &csv.ParseError{Line: r.line, Column: attributeField, Err: ErrMissingGeneID}
+	}
+	return f, nil
+}
+
+// parseAttributes parses the GTF attribute column s into f. Values may be quoted, and
+// quoted values may contain semicolons.
+func parseAttributes(f *Feature, s string) error {
+	for {
+		s = strings.TrimLeft(s, " \t;")
+		if s == "" {
+			return nil
+		}
+		i := strings.IndexAny(s, " \t")
+		if i <= 0 {
+			return ErrBadAttribute
+		}
+		tag := s[:i]
+		s = strings.TrimLeft(s[i:], " \t")
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			i = strings.Index(s[1:], `"`)
+			if i < 0 {
+				return ErrBadAttribute
+			}
+			value, s = s[1:i+1], s[i+2:]
+		} else {
+			i = strings.IndexAny(s, " \t;")
+			if i < 0 {
+				i = len(s)
+			}
+			value, s = s[:i], s[i:]
+		}
+		s = strings.TrimLeft(s, " \t")
+		if s != "" && s[0] != ';' {
+			return ErrBadAttribute
+		}
+		switch tag {
+		case "gene_id":
+			f.GeneID = value
+		case "transcript_id":
+			f.TranscriptID = value
+		default:
+			f.FeatAttributes = append(f.FeatAttributes, Attribute{Tag: tag, Value: value})
+		}
+	}
+}
+
+// A Writer outputs features in GTF format.
+type Writer struct {
+	w         io.Writer
+	Precision int
+}
+
+// NewWriter returns a new GTF format writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w, Precision: -1}
+}
+
+// Write writes a single feature and returns the number of bytes written and any error.
+// gtf.Features are written as GTF lines with gene_id and transcript_id as the first
+// attributes and all attribute values quoted. A feature must have a GeneID and, unless it
+// is a gene feature, a TranscriptID. *Gene and *Transcript values are written as the lines
+// of the features they hold in the order returned by their Features methods. All other
+// feat.Feature types return an ErrNotHandled.
+func (w *Writer) Write(f feat.Feature) (n int, err error) {
+	switch f := f.(type) {
+	case *Feature:
+		return w.writeFeature(f)
+	case interface {
+		Features() []*Feature
+	}:
+		for _, gf := range f.Features() {
+			_n, err := w.writeFeature(gf)
+			n += _n
+			if err != nil {
+				return n, err
+			}
+		}
+		return n, nil
+	}
+	return 0, ErrNotHandled
+}
+
+func (w *Writer) writeFeature(f *Feature) (n int, err error) {
+	if f.FeatStart >= f.FeatEnd {
+		return 0, ErrBadFeature
+	}
+	if f.GeneID == "" {
+		return 0, ErrMissingGeneID
+	}
+	if f.TranscriptID == "" && f.Feature != "gene" {
+		return 0, ErrMissingTranscriptID
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s\t%s\t%s\t%d\t%d\t",
+		f.SeqName,
+		f.Source,
+		f.Feature,
+		feat.ZeroToOne(f.FeatStart),
+		f.FeatEnd,
+	)
+	switch {
+	case f.FeatScore == nil || math.IsNaN(*f.FeatScore):
+		buf.WriteByte('.')
+	case w.Precision < 0:
+		fmt.Fprint(&buf, *f.FeatScore)
+	default:
+		fmt.Fprintf(&buf, "%.*f", w.Precision, *f.FeatScore)
+	}
+	fmt.Fprintf(&buf, "\t%s\t%s\tgene_id \"%s\";", f.FeatStrand, f.FeatFrame, f.GeneID)
+	if f.TranscriptID != "" {
+		fmt.Fprintf(&buf, " transcript_id \"%s\";", f.TranscriptID)
+	}
+	for _, a := range f.FeatAttributes {
+		fmt.Fprintf(&buf, " %s \"%s\";", a.Tag, a.Value)
+	}
+	buf.WriteByte('\n')
+	return w.w.Write(buf.Bytes())
+}
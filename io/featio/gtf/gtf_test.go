@@ -0,0 +1,171 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gtf
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio/gff"
+	"github.com/biogo/biogo/seq"
+
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const testGTF = `#!genome-build GRCh38
+1	havana	gene	11869	14409	.	+	.	gene_id "G1"; gene_name "DDX11L1"; level 2;
+1	havana	transcript	11869	14409	.	+	.	gene_id "G1"; transcript_id "T1"; transcript_name "DDX11L1-202";
+1	havana	exon	12613	12721	.	+	.	gene_id "G1"; transcript_id "T1"; exon_number "2";
+1	havana	exon	11869	12227	.	+	.	gene_id "G1"; transcript_id "T1"; exon_number "1";
+1	havana	CDS	12010	12057	0.5	+	2	gene_id "G1"; transcript_id "T1"; note "a; b";
+1	havana	start_codon	12010	12012	.	+	0	gene_id "G1"; transcript_id "T1";
+1	havana	exon	12010	12057	.	+	.	gene_id "G1"; transcript_id "T2";
+2	ensembl	exon	100	200	.	-	.	gene_id "G2"; transcript_id "T3"
+`
+
+func (s *S) TestRead(c *check.C) {
+	r := NewReader(strings.NewReader(testGTF))
+	var fs []*Feature
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		fs = append(fs, f.(*Feature))
+	}
+	c.Assert(len(fs), check.Equals, 8)
+
+	gene := fs[0]
+	c.Check(gene.GeneID, check.Equals, "G1")
+	c.Check(gene.TranscriptID, check.Equals, "")
+	c.Check(gene.FeatAttributes, check.DeepEquals, Attributes{{Tag: "gene_name", Value: "DDX11L1"}, {Tag: "level", Value: "2"}})
+	c.Check(gene.Start(), check.Equals, 11868)
+	c.Check(gene.End(), check.Equals, 14409)
+	c.Check(gene.FeatFrame, check.Equals, gff.NoFrame)
+	c.Check(gene.Location(), check.Equals, feat.Feature(gff.Sequence{SeqName: "1"}))
+
+	cds := fs[4]
+	c.Check(cds.TranscriptID, check.Equals, "T1")
+	c.Check(*cds.FeatScore, check.Equals, 0.5)
+	c.Check(cds.FeatFrame, check.Equals, gff.Frame2)
+	c.Check(cds.FeatAttributes.Get("note"), check.Equals, "a; b")
+
+	c.Check(fs[7].FeatStrand, check.Equals, seq.Minus)
+	c.Check(fs[7].TranscriptID, check.Equals, "T3")
+}
+
+func (s *S) TestReadErrors(c *check.C) {
+	for i, t := range []struct {
+		gtf string
+		err error
+	}{
+		{gtf: "1\t.\texon\t10\t5\t.\t+\t.\tgene_id \"g\";\n", err: ErrBadFeature},
+		{gtf: "1\t.\texon\t1\t5\t.\tx\t.\tgene_id \"g\";\n", err: ErrBadStrand},
+		{gtf: "1\t.\tCDS\t1\t5\t.\t+\t3\tgene_id \"g\";\n", err: ErrBadFrame},
+		{gtf: "1\t.\texon\t1\t5\t.\t+\t.\tgene_id \"g;\n", err: ErrBadAttribute},
+		{gtf: "1\t.\texon\t1\t5\t.\t+\t.\ttranscript_id \"t\";\n", err: ErrMissingGeneID},
+		{gtf: "1\t.\texon\t1\t5\t.\t+\n", err: ErrFieldMissing},
+	} {
+		_, err := NewReader(strings.NewReader(t.gtf)).Read()
+		perr, ok := err.(*csv.ParseError)
+		c.Assert(ok, check.Equals, true, check.Commentf("Test %d: %v", i, err))
+		c.Check(perr.Line, check.Equals, 1, check.Commentf("Test %d", i))
+		c.Check(perr.Err, check.Equals, t.err, check.Commentf("Test %d", i))
+	}
+}
+
+func readAll(c *check.C, gtf string) []*Feature {
+	r := NewReader(strings.NewReader(gtf))
+	var fs []*Feature
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		fs = append(fs, f.(*Feature))
+	}
+	return fs
+}
+
+func (s *S) TestGenes(c *check.C) {
+	fs := readAll(c, testGTF)
+	genes, err := Genes(fs)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(genes), check.Equals, 2)
+
+	g := genes[0]
+	c.Check(g.ID, check.Equals, "G1")
+	c.Check(g.Name(), check.Equals, "DDX11L1")
+	c.Check(g.Feature == fs[0], check.Equals, true)
+	c.Check(g.Start(), check.Equals, 11868)
+	c.Check(g.End(), check.Equals, 14409)
+	c.Assert(len(g.Transcripts), check.Equals, 2)
+
+	t := g.Transcripts[0]
+	c.Check(t.Name(), check.Equals, "DDX11L1-202")
+	c.Check(t.Gene == g, check.Equals, true)
+	c.Check(t.Location(), check.Equals, feat.Feature(g))
+	c.Check(t.Feature == fs[1], check.Equals, true)
+	c.Check(len(t.Exons) == 2 && t.Exons[0] == fs[3] && t.Exons[1] == fs[2], check.Equals, true)
+	c.Check(len(t.CDS) == 1 && t.CDS[0] == fs[4], check.Equals, true)
+	c.Check(len(t.Other) == 1 && t.Other[0] == fs[5], check.Equals, true)
+
+	t = g.Transcripts[1]
+	c.Check(t.Name(), check.Equals, "T2")
+	c.Check(t.Feature, check.IsNil)
+	c.Check(t.Start(), check.Equals, 12009)
+	c.Check(t.End(), check.Equals, 12057)
+
+	g = genes[1]
+	c.Check(g.Name(), check.Equals, "G2")
+	c.Check(g.Feature, check.IsNil)
+	c.Check(g.Strand, check.Equals, seq.Minus)
+
+	_, err = Genes(readAll(c, testGTF+"2\tensembl\texon\t300\t400\t.\t-\t.\tgene_id \"G1\"; transcript_id \"T4\";\n"))
+	c.Check(err, check.ErrorMatches, `gtf: inconsistent location for exon/2:\[299,400\) in gene "G1"`)
+	_, err = Genes(readAll(c, testGTF+"2\tensembl\texon\t300\t400\t.\t-\t.\tgene_id \"G2\"; transcript_id \"T1\";\n"))
+	c.Check(err, check.ErrorMatches, `gtf: transcript "T1" in genes "G1" and "G2"`)
+}
+
+func (s *S) TestWrite(c *check.C) {
+	fs := readAll(c, testGTF)
+	genes, err := Genes(fs)
+	c.Assert(err, check.Equals, nil)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, g := range genes {
+		_, err = w.Write(g)
+		c.Assert(err, check.Equals, nil)
+	}
+	c.Check(buf.String(), check.Equals, `1	havana	gene	11869	14409	.	+	.	gene_id "G1"; gene_name "DDX11L1"; level "2";
+1	havana	transcript	11869	14409	.	+	.	gene_id "G1"; transcript_id "T1"; transcript_name "DDX11L1-202";
+1	havana	exon	11869	12227	.	+	.	gene_id "G1"; transcript_id "T1"; exon_number "1";
+1	havana	CDS	12010	12057	0.5	+	2	gene_id "G1"; transcript_id "T1"; note "a; b";
+1	havana	start_codon	12010	12012	.	+	0	gene_id "G1"; transcript_id "T1";
+1	havana	exon	12613	12721	.	+	.	gene_id "G1"; transcript_id "T1"; exon_number "2";
+1	havana	exon	12010	12057	.	+	.	gene_id "G1"; transcript_id "T2";
+2	ensembl	exon	100	200	.	-	.	gene_id "G2"; transcript_id "T3";
+`)
+	c.Check(readAll(c, buf.String())[3], check.DeepEquals, fs[4])
+
+	_, err = w.Write(&Feature{SeqName: "1", Feature: "exon", FeatEnd: 10, GeneID: "G1"})
+	c.Check(err, check.Equals, ErrMissingTranscriptID)
+	_, err = w.Write(gff.Sequence{SeqName: "1"})
+	c.Check(err, check.Equals, ErrNotHandled)
+}
@@ -0,0 +1,204 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gtf
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio/gff"
+	"github.com/biogo/biogo/seq"
+
+	"fmt"
+	"sort"
+)
+
+// A Gene is a gene model reconstructed from the features sharing a gene_id.
+type Gene struct {
+	ID      string
+	SeqName string
+	Strand  seq.Strand
+
+	// Feature is the gene feature
+	// line, or nil if the gene has no
+	// gene line.
+	Feature *Feature
+
+	// Transcripts holds the transcripts of
+	// the gene in the order they are first
+	// seen.
+	Transcripts []*Transcript
+
+	start, end int
+}
+
+// Name returns the gene_name attribute of the gene, or its ID if it has no gene_name.
+func (g *Gene) Name() string {
+	for _, f := range g.Features() {
+		if n := f.FeatAttributes.Get("gene_name"); n != "" {
+			return n
+		}
+	}
+	return g.ID
+}
+
+// Description returns "gene".
+func (g *Gene) Description() string    { return "gene" }
+func (g *Gene) Start() int             { return g.start }
+func (g *Gene) End() int               { return g.end }
+func (g *Gene) Len() int               { return g.end - g.start }
+func (g *Gene) Location() feat.Feature { return gff.Sequence{SeqName: g.SeqName} }
+
+// Features returns the features of the gene, the gene line followed by the features of
+// each transcript.
+func (g *Gene) Features() []*Feature {
+	var fs []*Feature
+	if g.Feature != nil {
+		fs = append(fs, g.Feature)
+	}
+	for _, t := range g.Transcripts {
+		fs = append(fs, t.Features()...)
+	}
+	return fs
+}
+
+// A Transcript is a transcript model reconstructed from the features sharing a
+// transcript_id.
+type Transcript struct {
+	ID   string
+	Gene *Gene
+
+	// Feature is the transcript feature
+	// line, or nil if the transcript has
+	// no transcript line.
+	Feature *Feature
+
+	// Exons and CDS hold the exon and CDS
+	// features of the transcript sorted by
+	// start position.
+	Exons, CDS []*Feature
+
+	// Other holds the remaining features of
+	// the transcript, such as start_codon,
+	// stop_codon and UTR features, sorted
+	// by start position.
+	Other []*Feature
+
+	start, end int
+}
+
+// Name returns the transcript_name attribute of the transcript, or its ID if it has no
+// transcript_name.
+func (t *Transcript) Name() string {
+	for _, f := range t.Features() {
+		if n := f.FeatAttributes.Get("transcript_name"); n != "" {
+			return n
+		}
+	}
+	return t.ID
+}
+
+// Description returns "transcript".
+func (t *Transcript) Description() string    { return "transcript" }
+func (t *Transcript) Start() int             { return t.start }
+func (t *Transcript) End() int               { return t.end }
+func (t *Transcript) Len() int               { return t.end - t.start }
+func (t *Transcript) Location() feat.Feature { return t.Gene }
+
+// Features returns the features of the transcript, the transcript line followed by the
+// exon, CDS and other features ordered by start position.
+func (t *Transcript) Features() []*Feature {
+	var fs []*Feature
+	if t.Feature != nil {
+		fs = append(fs, t.Feature)
+	}
+	parts := make([]*Feature, 0, len(t.Exons)+len(t.CDS)+len(t.Other))
+	parts = append(parts, t.Exons...)
+	parts = append(parts, t.CDS...)
+	parts = append(parts, t.Other...)
+	sort.Stable(byStart(parts))
+	return append(fs, parts...)
+}
+
+// Genes reconstructs the gene models described by fs. Genes are returned in the order
+// their first feature appears in fs. Features of a gene that have no transcript_id, other
+// than gene lines, are ignored. It returns an error if the features of a gene are on
+// different sequences or strands, or if a transcript_id is shared between genes.
+func Genes(fs []*Feature) ([]*Gene, error) {
+	var (
+		genes       []*Gene
+		geneIDs     = make(map[string]*Gene)
+		transcripts = make(map[string]*Transcript)
+	)
+	for _, f := range fs {
+		if f.GeneID == "" {
+			return nil, ErrMissingGeneID
+		}
+		g, ok := geneIDs[f.GeneID]
+		if !ok {
+			g = &Gene{ID: f.GeneID, SeqName: f.SeqName, Strand: f.FeatStrand, start: f.FeatStart, end: f.FeatEnd}
+			geneIDs[f.GeneID] = g
+			genes = append(genes, g)
+		}
+		if f.SeqName != g.SeqName || f.FeatStrand != g.Strand {
+			return nil, fmt.Errorf("gtf: inconsistent location for %s in gene %q", f.Name(), g.ID)
+		}
+		g.start, g.end = min(g.start, f.FeatStart), max(g.end, f.FeatEnd)
+		if f.Feature == "gene" {
+			g.Feature = f
+			continue
+		}
+		if f.TranscriptID == "" {
+			continue
+		}
+
+		t, ok := transcripts[f.TranscriptID]
+		if !ok {
+			t = &Transcript{ID: f.TranscriptID, Gene: g, start: f.FeatStart, end: f.FeatEnd}
+			transcripts[f.TranscriptID] = t
+			g.Transcripts = append(g.Transcripts, t)
+		}
+		if t.Gene != g {
+			return nil, fmt.Errorf("gtf: transcript %q in genes %q and %q", t.ID, t.Gene.ID, g.ID)
+		}
+		t.start, t.end = min(t.start, f.FeatStart), max(t.end, f.FeatEnd)
+		switch f.Feature {
+		case "transcript":
+			t.Feature = f
+		case "exon":
+			t.Exons = append(t.Exons, f)
+		case "CDS":
+			t.CDS = append(t.CDS, f)
+		default:
+			t.Other = append(t.Other, f)
+		}
+	}
+	for _, g := range genes {
+		for _, t := range g.Transcripts {
+			sort.Stable(byStart(t.Exons))
+			sort.Stable(byStart(t.CDS))
+			sort.Stable(byStart(t.Other))
+		}
+	}
+	return genes, nil
+}
+
+type byStart []*Feature
+
+func (f byStart) Len() int           { return len(f) }
+func (f byStart) Less(i, j int) bool { return f[i].FeatStart < f[j].FeatStart }
+func (f byStart) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,218 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package seqio
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+)
+
+// Compression specifies the compression format of a sequence stream.
+type Compression int
+
+const (
+	Uncompressed Compression = iota // No compression.
+	Gzip                            // Gzip compression.
+	BGZF                            // Blocked gzip compression as used by samtools and tabix.
+)
+
+func (c Compression) String() string {
+	switch c {
+	case Uncompressed:
+		return "uncompressed"
+	case Gzip:
+		return "gzip"
+	case BGZF:
+		return "bgzf"
+	}
+	return "unknown"
+}
+
+// Decompress returns an io.Reader holding the decompressed content of r and the compression
+// format of r, detected from the gzip and BGZF magic bytes at the start of the stream. If r is
+// not compressed, its content is returned unaltered. If the compression header is invalid,
+// the returned error is non-nil and the returned io.Reader returns the error on each call to
+// Read, so callers may defer handling of the error until the first read.
+func Decompress(r io.Reader) (io.Reader, Compression, error) {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	magic, _ := br.Peek(2)
+	if len(magic) < 2 || magic[0] != 0x1f || magic[1] != 0x8b {
+		return br, Uncompressed, nil
+	}
+	// The gzip header must be read by
+	// gzip.NewReader, so peeking into
+	// it does not block further.
+	magic, _ = br.Peek(16)
+	c := Gzip
+	if isBGZF(magic) {
+		c = BGZF
+	}
+	gz, err := gzip.NewReader(br)
+	if err != nil {
+		return errReader{err}, c, err
+	}
+	return gz, c, nil
+}
+
+// NewDecompressor returns an io.Reader holding the decompressed content of r as described
+// for Decompress. No data is read from r until the first call to Read, when the compression
+// format is detected. If the compression header is invalid, the error is returned by each
+// call to Read.
+func NewDecompressor(r io.Reader) io.Reader {
+	return &decompressor{r: r}
+}
+
+// decompressor is a lazily initialised decompressing reader.
+type decompressor struct {
+	r   io.Reader
+	dec io.Reader
+}
+
+func (d *decompressor) Read(b []byte) (int, error) {
+	if d.dec == nil {
+		d.dec, _, _ = Decompress(d.r)
+	}
+	return d.dec.Read(b)
+}
+
+// isBGZF returns whether the gzip header prefix h includes the BGZF extra subfield.
+func isBGZF(h []byte) bool {
+	const fextra = 1 << 2
+	return len(h) >= 16 && h[3]&fextra != 0 && h[12] == 'B' && h[13] == 'C' && h[14] == 2 && h[15] == 0
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// NewCompressor returns an io.WriteCloser that writes data to w compressed in the format c.
+// The returned io.WriteCloser must be closed to flush the compressed stream, but closing it
+// does not close w. Streams compressed as BGZF are terminated with the BGZF end-of-file
+// marker block when closed. It returns an error if c is not a known compression format.
+func NewCompressor(w io.Writer, c Compression) (io.WriteCloser, error) {
+	switch c {
+	case Uncompressed:
+		return nopCloser{w}, nil
+	case Gzip:
+		return gzip.NewWriter(w), nil
+	case BGZF:
+		return &bgzfWriter{w: w}, nil
+	}
+	return nil, errors.New("seqio: unknown compression")
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
+
+const (
+	// bgzfBlockSize is the maximum number of
+	// uncompressed bytes held in a BGZF block,
+	// chosen to ensure that incompressible
+	// data fits within the 64kiB block limit.
+	bgzfBlockSize = 0xff00
+
+	// bgzfHeaderSize is the size of the
+	// gzip header of a BGZF block.
+	bgzfHeaderSize = 18
+)
+
+// bgzfEOF is the BGZF end-of-file marker block.
+var bgzfEOF = []byte{
+	0x1f, 0x8b, 0x08, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0xff, 0x06, 0x00, 0x42, 0x43, 0x02, 0x00,
+	0x1b, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00,
+}
+
+// bgzfWriter is a BGZF compressing writer.
+type bgzfWriter struct {
+	w      io.Writer
+	buf    []byte
+	block  bytes.Buffer
+	fw     *flate.Writer
+	closed bool
+	err    error
+}
+
+func (w *bgzfWriter) Write(b []byte) (int, error) {
+	if w.closed {
+		return 0, errors.New("seqio: write to closed compressor")
+	}
+	var n int
+	for len(b) > 0 && w.err == nil {
+		c := copy(w.buf[len(w.buf):cap(w.buf)], b)
+		if c == 0 {
+			if w.buf == nil {
+				w.buf = make([]byte, 0, bgzfBlockSize)
+				continue
+			}
+			w.flush()
+			continue
+		}
+		w.buf = w.buf[:len(w.buf)+c]
+		b = b[c:]
+		n += c
+	}
+	return n, w.err
+}
+
+// flush writes the buffered data as a BGZF block.
+func (w *bgzfWriter) flush() {
+	if w.err != nil || len(w.buf) == 0 {
+		return
+	}
+	w.block.Reset()
+	w.block.Write(make([]byte, bgzfHeaderSize))
+	if w.fw == nil {
+		w.fw, w.err = flate.NewWriter(&w.block, flate.DefaultCompression)
+		if w.err != nil {
+			return
+		}
+	} else {
+		w.fw.Reset(&w.block)
+	}
+	_, w.err = w.fw.Write(w.buf)
+	if w.err != nil {
+		return
+	}
+	w.err = w.fw.Close()
+	if w.err != nil {
+		return
+	}
+	var tail [8]byte
+	binary.LittleEndian.PutUint32(tail[:4], crc32.ChecksumIEEE(w.buf))
+	binary.LittleEndian.PutUint32(tail[4:], uint32(len(w.buf)))
+	w.block.Write(tail[:])
+
+	b := w.block.Bytes()
+	copy(b, bgzfEOF[:16])
+	binary.LittleEndian.PutUint16(b[16:], uint16(len(b)-1))
+	_, w.err = w.w.Write(b)
+	w.buf = w.buf[:0]
+}
+
+// Close flushes any buffered data and writes the BGZF end-of-file marker.
+func (w *bgzfWriter) Close() error {
+	if w.closed {
+		return w.err
+	}
+	w.closed = true
+	w.flush()
+	if w.err != nil {
+		return w.err
+	}
+	_, w.err = w.w.Write(bgzfEOF)
+	return w.err
+}
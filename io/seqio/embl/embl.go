@@ -0,0 +1,434 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package embl provides types to read and write EMBL flat file format files.
+//
+// EMBL entries share the INSDC feature table with GenBank, so features are represented
+// using the types provided by the genbank package.
+package embl
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/io/seqio/genbank"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ seqio.Reader = (*Reader)(nil)
+	_ seqio.Writer = (*Writer)(nil)
+)
+
+// ID holds the ID line fields of an entry that are not described by its sequence.
+type ID struct {
+	// Version is the sequence version,
+	// for example "1".
+	Version string
+
+	// MolType is the molecule type, for
+	// example "genomic DNA" or "mRNA".
+	MolType string
+
+	// Class is the three letter data
+	// class, for example "STD".
+	Class string
+
+	// Division is the three letter
+	// taxonomic division, for example
+	// "PLN".
+	Division string
+}
+
+// A Field is a header field of an entry.
+type Field struct {
+	// Key is the two letter line code
+	// of the field, for example "AC".
+	Key string
+
+	// Value is the value of the field,
+	// with consecutive lines separated by
+	// newlines.
+	Value string
+}
+
+// Fields is a list of header fields.
+type Fields []Field
+
+// Get returns the value of the first field with the given line code, or the empty string if
+// no such field exists.
+func (f Fields) Get(key string) string {
+	for _, field := range f {
+		if field.Key == key {
+			return field.Value
+		}
+	}
+	return ""
+}
+
+// A Record is an EMBL entry.
+type Record struct {
+	// Seq is the sequence of the entry.
+	// Its name, description and
+	// conformation are taken from the
+	// ID and DE lines.
+	Seq seq.Sequence
+
+	ID ID
+
+	// Header holds the header fields
+	// of the entry other than ID, DE and
+	// SQ, in order. Line codes of lines
+	// that are not separated by an XX
+	// line are merged into a single field.
+	Header Fields
+
+	// Features is the feature table
+	// of the entry.
+	Features []*genbank.Feature
+}
+
+const (
+	// prefixWidth is the width of the line
+	// code column of each line.
+	prefixWidth = 5
+
+	// lineWidth is the maximum width of
+	// a wrapped line.
+	lineWidth = 80
+)
+
+// EMBL format reader type.
+type Reader struct {
+	r    *bufio.Reader
+	t    seqio.SequenceAppender
+	line int
+}
+
+// NewReader returns a new EMBL format reader using r. Sequences returned by the Reader are
+// copied from the provided template. Gzip and BGZF compressed input is decompressed
+// transparently. If the compression header of r is invalid, the error is returned by the
+// first call to Read or ReadRecord.
+func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
+	return &Reader{r: bufio.NewReader(seqio.NewDecompressor(r)), t: template}
+}
+
+// Read reads a single entry and returns its sequence. The entry's feature table is
+// discarded; use ReadRecord to obtain it.
+func (r *Reader) Read() (seq.Sequence, error) {
+	rec, err := r.ReadRecord()
+	if err != nil {
+		return nil, err
+	}
+	return rec.Seq, nil
+}
+
+// readLine returns the next line without its line ending.
+func (r *Reader) readLine() (string, error) {
+	l, err := r.r.ReadString('\n')
+	if err == io.EOF && l != "" {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+	r.line++
+	return strings.TrimRight(l, "\r\n"), nil
+}
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("embl: line %d: %s", r.line, fmt.Sprintf(format, args...))
+}
+
+// lineError returns the feature table error err annotated with the current line number.
+func (r *Reader) lineError(err error) error {
+	return r.errorf("%s", strings.TrimPrefix(err.Error(), "genbank: "))
+}
+
+// ReadRecord reads a single entry. It returns io.EOF if no entry remains in the input and
+// io.ErrUnexpectedEOF if the input ends within an entry.
+func (r *Reader) ReadRecord() (*Record, error) {
+	var (
+		rec    *Record
+		s      seqio.SequenceAppender
+		length int
+		fp     *genbank.FeatureParser
+		desc   []string
+		prev   string
+		inSeq  bool
+	)
+	for {
+		l, err := r.readLine()
+		if err != nil {
+			if err == io.EOF && rec != nil {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		if rec == nil {
+			if strings.TrimSpace(l) == "" {
+				continue
+			}
+			if !strings.HasPrefix(l, "ID   ") {
+				return nil, r.errorf("expected ID line: %q", l)
+			}
+			s = r.t.Clone().(seqio.SequenceAppender)
+			rec = &Record{Seq: s}
+			length, err = r.id(rec, l[prefixWidth:])
+			if err != nil {
+				return nil, err
+			}
+			fp = genbank.NewFeatureParser(s)
+			continue
+		}
+		if strings.HasPrefix(l, "//") {
+			break
+		}
+		if inSeq {
+			letters := make([]alphabet.Letter, 0, len(l))
+			for _, c := range []byte(l) {
+				if c != ' ' && c != '\t' && (c < '0' || '9' < c) {
+					letters = append(letters, alphabet.Letter(c))
+				}
+			}
+			err = s.AppendLetters(letters...)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+
+		key, value := l, ""
+		if len(l) > 2 {
+			key, value = l[:2], strings.TrimPrefix(l[2:], "   ")
+		}
+		switch key {
+		case "XX":
+			prev = ""
+			continue
+		case "FH":
+		case "FT":
+			err = fp.Line(value)
+			if err != nil {
+				return nil, r.lineError(err)
+			}
+		case "SQ":
+			inSeq = true
+		case "DE":
+			desc = append(desc, value)
+		default:
+			if key == prev {
+				rec.Header[len(rec.Header)-1].Value += "\n" + value
+			} else {
+				rec.Header = append(rec.Header, Field{Key: key, Value: value})
+			}
+		}
+		prev = key
+	}
+
+	var err error
+	rec.Features, err = fp.Features()
+	if err != nil {
+		return nil, r.lineError(err)
+	}
+	if n := s.Len(); n != 0 && n != length {
+		return nil, r.errorf("sequence length %d does not match ID length %d", n, length)
+	}
+	if desc != nil {
+		err = s.SetDescription(strings.Join(desc, " "))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rec, nil
+}
+
+// id sets the ID line fields of rec from the line content l and returns the sequence length.
+// Both the current format of ID line,
+//
+//	X56734; SV 1; linear; mRNA; STD; PLN; 1859 BP.
+//
+// and the pre-2006 format,
+//
+//	X56734   standard; RNA; PLN; 1859 BP.
+//
+// are accepted.
+func (r *Reader) id(rec *Record, l string) (int, error) {
+	f := strings.Split(strings.TrimSuffix(strings.TrimSpace(l), "."), ";")
+	for i := range f {
+		f[i] = strings.TrimSpace(f[i])
+	}
+	if len(f) < 2 {
+		return 0, r.errorf("short ID line: %q", l)
+	}
+	s := rec.Seq.(seqio.SequenceAppender)
+	name := f[0]
+	if len(f) != 7 {
+		old := strings.Fields(name)
+		if len(old) == 0 {
+			return 0, r.errorf("missing ID name: %q", l)
+		}
+		name = old[0]
+	}
+	err := s.SetName(name)
+	if err != nil {
+		return 0, err
+	}
+	size := strings.Fields(f[len(f)-1])
+	if len(size) != 2 {
+		return 0, r.errorf("invalid ID sequence length: %q", f[len(f)-1])
+	}
+	length, err := strconv.Atoi(size[0])
+	if err != nil {
+		return 0, r.errorf("invalid ID sequence length: %q", f[len(f)-1])
+	}
+	if len(f) != 7 {
+		if len(f) == 4 {
+			rec.ID.MolType, rec.ID.Division = f[1], f[2]
+		}
+		return length, nil
+	}
+	rec.ID = ID{
+		Version:  strings.TrimPrefix(f[1], "SV "),
+		MolType:  f[3],
+		Class:    f[4],
+		Division: f[5],
+	}
+	if f[2] == "circular" {
+		err = s.SetConformation(feat.Circular)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return length, nil
+}
+
+// EMBL format writer type.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter returns a new EMBL format writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes a single sequence as an entry without a feature table and returns the number
+// of bytes written and any error.
+func (w *Writer) Write(s seq.Sequence) (n int, err error) {
+	return w.WriteRecord(&Record{Seq: s})
+}
+
+// WriteRecord writes a single entry and returns the number of bytes written and any error.
+// The ID line is constructed from rec.Seq and rec.ID, using "XXX" for unspecified fields.
+// Header fields are written in order with the DE line, taken from the description of
+// rec.Seq, written before the first field that is not an AC, PR or DT field, and are
+// followed by the feature table and sequence.
+func (w *Writer) WriteRecord(rec *Record) (n int, err error) {
+	s := rec.Seq
+	if s == nil {
+		return 0, errors.New("embl: no sequence")
+	}
+	var buf bytes.Buffer
+
+	id := rec.ID
+	orUnknown := func(s string) string {
+		if s == "" {
+			return "XXX"
+		}
+		return s
+	}
+	topology := "linear"
+	if s.Conformation() == feat.Circular {
+		topology = "circular"
+	}
+	fmt.Fprintf(&buf, "ID   %s; SV %s; %s; %s; %s; %s; %d BP.\n",
+		s.Name(), orUnknown(id.Version), topology, orUnknown(id.MolType), orUnknown(id.Class), orUnknown(id.Division), s.Len())
+
+	desc := Field{Key: "DE", Value: "."}
+	if d := s.Description(); d != "" {
+		desc.Value = strings.Join(genbank.Wrap(d, lineWidth-prefixWidth, ' ', false), "\n")
+	}
+	prev := "ID"
+	wroteDesc := false
+	for _, f := range rec.Header {
+		if !wroteDesc && f.Key != "AC" && f.Key != "PR" && f.Key != "DT" {
+			prev = writeField(&buf, prev, desc)
+			wroteDesc = true
+		}
+		prev = writeField(&buf, prev, f)
+	}
+	if !wroteDesc {
+		writeField(&buf, prev, desc)
+	}
+	buf.WriteString("XX\n")
+
+	if len(rec.Features) != 0 {
+		buf.WriteString("FH   Key             Location/Qualifiers\nFH\n")
+		genbank.WriteFeatures(&buf, "FT   ", rec.Features)
+		buf.WriteString("XX\n")
+	}
+
+	var a, c, g, t int
+	for i := 0; i < s.Len(); i++ {
+		switch s.At(i).L {
+		case 'a', 'A':
+			a++
+		case 'c', 'C':
+			c++
+		case 'g', 'G':
+			g++
+		case 't', 'T':
+			t++
+		}
+	}
+	fmt.Fprintf(&buf, "SQ   Sequence %d BP; %d A; %d C; %d G; %d T; %d other;\n", s.Len(), a, c, g, t, s.Len()-a-c-g-t)
+	line := make([]byte, 0, 65)
+	for i := 0; i < s.Len(); i++ {
+		if i%10 == 0 && i%60 != 0 {
+			line = append(line, ' ')
+		}
+		line = append(line, byte(s.At(i).L))
+		if (i+1)%60 == 0 || i+1 == s.Len() {
+			fmt.Fprintf(&buf, "     %-65s%10d\n", bytes.ToLower(line), i+1)
+			line = line[:0]
+		}
+	}
+	buf.WriteString("//\n")
+
+	return w.w.Write(buf.Bytes())
+}
+
+// writeField writes the header field f to buf, preceded by an XX line if it does not belong
+// to the same block as the field with line code prev, and returns the line code of f.
+func writeField(buf *bytes.Buffer, prev string, f Field) string {
+	if !sameBlock(prev, f.Key) {
+		buf.WriteString("XX\n")
+	}
+	for _, l := range strings.Split(f.Value, "\n") {
+		fmt.Fprintln(buf, strings.TrimRight(fmt.Sprintf("%-*s%s", prefixWidth, f.Key, l), " "))
+	}
+	return f.Key
+}
+
+// sameBlock returns whether a field with the line code key following a field with the line
+// code prev continues the block of prev. Organism lines and the lines of a reference are
+// written as blocks.
+func sameBlock(prev, key string) bool {
+	if key == prev || key == "RN" || prev == "" || key == "" || prev[0] != key[0] {
+		return false
+	}
+	return key[0] == 'O' || key[0] == 'R'
+}
@@ -0,0 +1,135 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embl
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const testEntry = `ID   X56734; SV 1; circular; mRNA; STD; PLN; 130 BP.
+XX
+AC   X56734; S46826;
+XX
+DT   12-SEP-1991 (Rel. 29, Created)
+DT   25-NOV-2005 (Rel. 85, Last updated, Version 11)
+XX
+DE   Trifolium repens mRNA for non-cyanogenic beta-glucosidase, with a wrapped
+DE   description.
+XX
+KW   beta-glucosidase.
+XX
+OS   Trifolium repens (white clover)
+OC   Eukaryota; Viridiplantae; Streptophyta; Embryophyta; Tracheophyta;
+OC   Spermatophyta; Magnoliophyta.
+XX
+RN   [1]
+RA   Oxtoby E., Dunn M.A., Pancoro A., Hughes M.A.;
+RT   ;
+RL   Submitted (22-NOV-1990) to the INSDC.
+XX
+RN   [2]
+RA   Oxtoby E.;
+RL   Plant Mol. Biol. 17(2):209-219(1991).
+XX
+FH   Key             Location/Qualifiers
+FH
+FT   source          1..130
+FT                   /organism="Trifolium repens"
+FT                   /mol_type="mRNA"
+FT   CDS             complement(join(<14..40,50..>90))
+FT                   /product="beta-glucosidase"
+FT                   /codon_start=1
+FT                   /translation="MDFIVAIFALFVISSFTITSTNAVEASTLLDIGNLSRSSFPRGF
+FT                   IFGAGSSAYQ"
+XX
+SQ   Sequence 130 BP; 40 A; 33 C; 27 G; 30 T; 0 other;
+     gatcctccat atacaacggt atctccacct caggtttaga tctcaacaac ggaaccattg        60
+     ccgacatgag acagttaggt atcgtcgaga gttacaagct aaaacgagca gtagtcagct       120
+     ctgcatctga                                                              130
+//
+`
+
+func (s *S) TestReadRecord(c *check.C) {
+	r := NewReader(strings.NewReader(testEntry+testEntry), linear.NewSeq("", nil, alphabet.DNA))
+	rec, err := r.ReadRecord()
+	c.Assert(err, check.Equals, nil)
+
+	c.Check(rec.Seq.Name(), check.Equals, "X56734")
+	c.Check(rec.Seq.Description(), check.Equals, "Trifolium repens mRNA for non-cyanogenic beta-glucosidase, with a wrapped description.")
+	c.Check(rec.Seq.Len(), check.Equals, 130)
+	c.Check(rec.Seq.Conformation(), check.Equals, feat.Circular)
+	c.Check(rec.Seq.(*linear.Seq).Seq[120:].String(), check.Equals, "ctgcatctga")
+	c.Check(rec.ID, check.Equals, ID{Version: "1", MolType: "mRNA", Class: "STD", Division: "PLN"})
+	c.Check(rec.Header.Get("DT"), check.Equals, "12-SEP-1991 (Rel. 29, Created)\n25-NOV-2005 (Rel. 85, Last updated, Version 11)")
+	c.Check(rec.Header.Get("DE"), check.Equals, "")
+	c.Check(len(rec.Header), check.Equals, 12)
+
+	c.Assert(len(rec.Features), check.Equals, 2)
+	cds := rec.Features[1]
+	c.Check(cds.Key, check.Equals, "CDS")
+	c.Check(cds.FeatLocation.String(), check.Equals, "complement(join(<14..40,50..>90))")
+	c.Check(cds.Orientation(), check.Equals, feat.Reverse)
+	c.Check(cds.Start(), check.Equals, 13)
+	c.Check(cds.End(), check.Equals, 90)
+	c.Check(cds.Location(), check.Equals, rec.Seq)
+	c.Check(cds.Qualifiers.Get("translation"), check.Equals, "MDFIVAIFALFVISSFTITSTNAVEASTLLDIGNLSRSSFPRGFIFGAGSSAYQ")
+
+	_, err = r.Read()
+	c.Check(err, check.Equals, nil)
+	_, err = r.Read()
+	c.Check(err, check.Equals, io.EOF)
+
+	old := "ID   AA03518    standard; DNA; FUN; 10 BP.\nSQ   Sequence 10 BP;\n     gatcctccat 10\n//\n"
+	rec, err = NewReader(strings.NewReader(old), linear.NewSeq("", nil, alphabet.DNA)).ReadRecord()
+	c.Assert(err, check.Equals, nil)
+	c.Check(rec.Seq.Name(), check.Equals, "AA03518")
+	c.Check(rec.ID, check.Equals, ID{MolType: "DNA", Division: "FUN"})
+
+	_, err = NewReader(strings.NewReader(testEntry[:200]), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Check(err, check.Equals, io.ErrUnexpectedEOF)
+	_, err = NewReader(strings.NewReader(strings.Replace(testEntry, "; 130 BP.", "; 131 BP.", 1)), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Check(err, check.ErrorMatches, "embl: line 41: sequence length 130 does not match ID length 131")
+	_, err = NewReader(strings.NewReader(strings.Replace(testEntry, "<14..40", "<14..4", 1)), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Check(err, check.ErrorMatches, `embl: line 41: invalid location .*`)
+}
+
+func (s *S) TestWriteRecord(c *check.C) {
+	rec, err := NewReader(strings.NewReader(testEntry), linear.NewSeq("", nil, alphabet.DNA)).ReadRecord()
+	c.Assert(err, check.Equals, nil)
+	var buf bytes.Buffer
+	n, err := NewWriter(&buf).WriteRecord(rec)
+	c.Check(err, check.Equals, nil)
+	c.Check(n, check.Equals, buf.Len())
+	c.Check(buf.String(), check.Equals, testEntry)
+
+	buf.Reset()
+	sq := linear.NewSeq("seq1", alphabet.BytesToLetters([]byte("ACGTNACGTA")), alphabet.DNA)
+	_, err = NewWriter(&buf).Write(sq)
+	c.Check(err, check.Equals, nil)
+	c.Check(buf.String(), check.Equals, `ID   seq1; SV XXX; linear; XXX; XXX; XXX; 10 BP.
+XX
+DE   .
+XX
+SQ   Sequence 10 BP; 3 A; 2 C; 2 G; 2 T; 1 other;
+     acgtnacgta                                                               10
+//
+`)
+}
@@ -6,9 +6,13 @@
 package fai
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/csv"
 	"errors"
+	"fmt"
 	"io"
+	"sort"
 	"strconv"
 )
 
@@ -110,3 +114,103 @@ func ReadFrom(r io.Reader) (idx Index, err error) {
 		}
 	}
 }
+
+// NewIndex returns an Index for the FASTA format stream provided by an io.Reader. Sequence
+// names are taken from the header line up to the first white space. All the lines of each
+// sequence except the last must have the same length. NewIndex returns an error if the lines
+// of a sequence are not of consistent length, a sequence line appears before the first
+// header or a sequence name is not unique.
+func NewIndex(r io.Reader) (Index, error) {
+	var (
+		br     = bufio.NewReader(r)
+		idx    = make(Index)
+		rec    *Record
+		offset int64
+		line   int
+
+		// short is set when a line shorter than
+		// BasesPerLine or an empty line has been
+		// seen for the current record.
+		short bool
+	)
+	add := func() {
+		if rec != nil {
+			idx[rec.Name] = *rec
+		}
+	}
+	for {
+		b, err := br.ReadBytes('\n')
+		if len(b) == 0 && err != nil {
+			if err != io.EOF {
+				return nil, err
+			}
+			break
+		}
+		line++
+		n := len(b)
+		offset += int64(n)
+		if b[0] == '>' {
+			add()
+			name := bytes.Fields(b[1:])
+			if len(name) == 0 {
+				return nil, fmt.Errorf("fai: line %d: missing sequence name", line)
+			}
+			if _, exists := idx[string(name[0])]; exists {
+				return nil, fmt.Errorf("fai: line %d: %v", line, ErrNonUnique)
+			}
+			rec = &Record{Name: string(name[0]), Start: offset}
+			short = false
+			continue
+		}
+		bases := len(bytes.TrimRight(b, "\r\n"))
+		if rec == nil {
+			if bases == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("fai: line %d: sequence before header", line)
+		}
+		switch {
+		case bases == 0:
+			short = true
+		case short:
+			return nil, fmt.Errorf("fai: line %d: inconsistent line length in %q", line, rec.Name)
+		case rec.BasesPerLine == 0:
+			rec.BasesPerLine = bases
+			rec.BytesPerLine = n
+		case bases > rec.BasesPerLine || (bases == rec.BasesPerLine && n != rec.BytesPerLine && err == nil):
+			return nil, fmt.Errorf("fai: line %d: inconsistent line length in %q", line, rec.Name)
+		case bases < rec.BasesPerLine:
+			short = true
+		}
+		rec.Length += bases
+		if err != nil {
+			break
+		}
+	}
+	add()
+	return idx, nil
+}
+
+// WriteTo writes the Index to the provided io.Writer in FAI format, with records in order of
+// their starting seek offset.
+func WriteTo(w io.Writer, idx Index) error {
+	recs := make([]Record, 0, len(idx))
+	for _, r := range idx {
+		recs = append(recs, r)
+	}
+	sort.Sort(byStart(recs))
+	bw := bufio.NewWriter(w)
+	for _, r := range recs {
+		_, err := fmt.Fprintf(bw, "%s\t%d\t%d\t%d\t%d\n", r.Name, r.Length, r.Start, r.BasesPerLine, r.BytesPerLine)
+		if err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+type byStart []Record
+
+func (r byStart) Len() int           { return len(r) }
+func (r byStart) Less(i, j int) bool { return r[i].Start < r[j].Start }
+func (r byStart) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
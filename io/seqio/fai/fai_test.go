@@ -5,6 +5,7 @@
 package fai_test
 
 import (
+	"bytes"
 	"encoding/csv"
 	"strconv"
 	"strings"
@@ -181,3 +182,80 @@ NODE_53327_length_192_cov_1.854167	212	5590	60	61
 		c.Check(idx, check.DeepEquals, t.idx, check.Commentf("Test: %d", i))
 	}
 }
+
+func (s *S) TestNewIndex(c *check.C) {
+	for i, t := range []struct {
+		in  string
+		idx fai.Index
+		err string
+	}{
+		{
+			in:  "",
+			idx: fai.Index{},
+		},
+		{
+			in: ">one description\nACGTA\nCGTAC\nGT\n>two\nACG\r\nTAC\r\n>three\n>four\nACGTA\n\n",
+			idx: fai.Index{
+				"one":   fai.Record{Name: "one", Length: 12, Start: 17, BasesPerLine: 5, BytesPerLine: 6},
+				"two":   fai.Record{Name: "two", Length: 6, Start: 37, BasesPerLine: 3, BytesPerLine: 5},
+				"three": fai.Record{Name: "three", Length: 0, Start: 54},
+				"four":  fai.Record{Name: "four", Length: 5, Start: 60, BasesPerLine: 5, BytesPerLine: 6},
+			},
+		},
+		{
+			in: ">one\nACGTA\nCG",
+			idx: fai.Index{
+				"one": fai.Record{Name: "one", Length: 7, Start: 5, BasesPerLine: 5, BytesPerLine: 6},
+			},
+		},
+		{
+			in:  ">one\nACGTA\nCG\nACGTA\n",
+			err: `fai: line 4: inconsistent line length in "one"`,
+		},
+		{
+			in:  ">one\nACGTA\nACGTAC\n",
+			err: `fai: line 3: inconsistent line length in "one"`,
+		},
+		{
+			in:  ">one\nACGTA\n\nACGTA\n",
+			err: `fai: line 4: inconsistent line length in "one"`,
+		},
+		{
+			in:  ">one\nACGTA\n>one\nACGTA\n",
+			err: `fai: line 3: non-unique record name`,
+		},
+		{
+			in:  "ACGTA\n",
+			err: `fai: line 1: sequence before header`,
+		},
+		{
+			in:  ">\nACGTA\n",
+			err: `fai: line 1: missing sequence name`,
+		},
+	} {
+		idx, err := fai.NewIndex(strings.NewReader(t.in))
+		if t.err != "" {
+			c.Check(err, check.ErrorMatches, t.err, check.Commentf("Test: %d", i))
+			continue
+		}
+		c.Assert(err, check.Equals, nil, check.Commentf("Test: %d", i))
+		c.Check(idx, check.DeepEquals, t.idx, check.Commentf("Test: %d", i))
+
+		var buf bytes.Buffer
+		c.Assert(fai.WriteTo(&buf, idx), check.Equals, nil)
+		got, err := fai.ReadFrom(&buf)
+		c.Assert(err, check.Equals, nil)
+		if len(idx) == 0 {
+			c.Check(got, check.IsNil)
+		} else {
+			c.Check(got, check.DeepEquals, idx)
+		}
+	}
+
+	var buf bytes.Buffer
+	fai.WriteTo(&buf, fai.Index{
+		"b": fai.Record{Name: "b", Length: 5, Start: 20, BasesPerLine: 5, BytesPerLine: 6},
+		"a": fai.Record{Name: "a", Length: 5, Start: 3, BasesPerLine: 5, BytesPerLine: 6},
+	})
+	c.Check(buf.String(), check.Equals, "a\t5\t3\t5\t6\nb\t5\t20\t5\t6\n")
+}
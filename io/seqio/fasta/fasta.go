@@ -38,10 +38,11 @@ type Reader struct {
 }
 
 // Returns a new fasta format reader using f. Sequences returned by the Reader are copied
-// from the provided template.
+// from the provided template. Gzip and BGZF compressed input is decompressed transparently.
+// If the compression header of f is invalid, the error is returned by the first call to Read.
 func NewReader(f io.Reader, template seqio.SequenceAppender) *Reader {
 	return &Reader{
-		r:         bufio.NewReader(f),
+		r:         bufio.NewReader(seqio.NewDecompressor(f)),
 		t:         template,
 		IDPrefix:  []byte(DefaultIDPrefix),
 		SeqPrefix: []byte(DefaultSeqPrefix),
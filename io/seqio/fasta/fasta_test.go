@@ -8,9 +8,13 @@ import (
 	"bytes"
 
 	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio/fai"
+	"github.com/biogo/biogo/seq"
 	"github.com/biogo/biogo/seq/linear"
 
 	"io"
+	"regexp"
+	"strings"
 	"testing"
 
 	"gopkg.in/check.v1"
@@ -113,3 +117,105 @@ func (s *S) TestWriteFasta(c *check.C) {
 	c.Check(n, check.Equals, b.Len())
 	c.Check(string(b.Bytes()), check.Equals, fa)
 }
+
+func (s *S) TestIndexed(c *check.C) {
+	// testaln1 has blank lines within sequences
+	// so cannot be indexed.
+	_, err := fai.NewIndex(strings.NewReader(testaln1))
+	c.Check(err, check.ErrorMatches, `fai: line 4: inconsistent line length in "AK1H_ECOLI/114-431"`)
+
+	for _, fa := range fas[:1] {
+		idx, err := fai.NewIndex(strings.NewReader(fa))
+		c.Assert(err, check.Equals, nil)
+		c.Assert(len(idx), check.Equals, len(expectN))
+
+		r := NewIndexed(strings.NewReader(fa), idx, linear.NewSeq("", nil, alphabet.Protein))
+		for i, n := range expectN {
+			id := strings.Fields(n)[0]
+			c.Check(idx[id].Length, check.Equals, len(expectS[i]))
+			for _, t := range [][2]int{
+				{0, len(expectS[i])},
+				{0, 1},
+				{59, 61},
+				{10, 200},
+				{len(expectS[i]) - 5, len(expectS[i])},
+				{7, 7},
+			} {
+				sq, err := r.Fetch(id, t[0], t[1])
+				c.Assert(err, check.Equals, nil)
+				c.Check(sq.Name(), check.Equals, id)
+				c.Check(sq.Start(), check.Equals, t[0])
+				c.Check(sq.End(), check.Equals, t[1])
+				c.Check(sq.(*linear.Seq).Seq.String(), check.Equals, alphabet.Letters(expectS[i][t[0]:t[1]]).String())
+			}
+		}
+		_, err = r.Fetch("AK_YEAST/134-472", 0, 1000)
+		c.Check(err, check.ErrorMatches, `fasta: range 0\.\.1000 out of range for "AK_YEAST/134-472" of length .*`)
+		_, err = r.Fetch("missing", 0, 1)
+		c.Check(err, check.ErrorMatches, `fasta: no sequence "missing" in index`)
+	}
+}
+
+func (s *S) TestParseRegion(c *check.C) {
+	for _, t := range []struct {
+		in   string
+		want Region
+		str  string
+		err  string
+	}{
+		{in: "chr2", want: Region{Name: "chr2"}, str: "chr2"},
+		{in: "chr2:1,000-2,000", want: Region{Name: "chr2", Start: 999, End: 2000}, str: "chr2:1000-2000"},
+		{in: "chr2:1000", want: Region{Name: "chr2", Start: 999}, str: "chr2:1000"},
+		{in: "chr2:5-5(-)", want: Region{Name: "chr2", Start: 4, End: 5, Strand: seq.Minus}, str: "chr2:5-5(-)"},
+		{in: "chr2(+)", want: Region{Name: "chr2", Strand: seq.Plus}, str: "chr2(+)"},
+		{in: "HLA-A*01:01", want: Region{Name: "HLA-A*01", Start: 0, End: 0}, str: "HLA-A*01"},
+		{in: "HLA-A*01:01:1-10", want: Region{Name: "HLA-A*01:01", Start: 0, End: 10}, str: "HLA-A*01:01:1-10"},
+		{in: "chrUn:abc", want: Region{Name: "chrUn:abc"}, str: "chrUn:abc"},
+		{in: "chr2:0-10", err: `fasta: invalid region start in "chr2:0-10"`},
+		{in: "chr2:10-5", err: `fasta: invalid region end in "chr2:10-5"`},
+		{in: "chr2:10-x", err: `fasta: invalid region end in "chr2:10-x"`},
+		{in: ":1-5", err: `fasta: missing region name`},
+	} {
+		r, err := ParseRegion(t.in)
+		if t.err != "" {
+			c.Check(err, check.ErrorMatches, regexp.QuoteMeta(t.err), check.Commentf("Test: %q", t.in))
+			continue
+		}
+		c.Check(err, check.Equals, nil, check.Commentf("Test: %q", t.in))
+		c.Check(r, check.Equals, t.want, check.Commentf("Test: %q", t.in))
+		c.Check(r.String(), check.Equals, t.str)
+	}
+}
+
+func (s *S) TestFetchRegion(c *check.C) {
+	const fa = ">chr1\nACGTA\nCCGGT\nTT\n>chr2:alt\nGGGAA\n"
+	idx, err := fai.NewIndex(strings.NewReader(fa))
+	c.Assert(err, check.Equals, nil)
+	r := NewIndexed(strings.NewReader(fa), idx, linear.NewSeq("", nil, alphabet.DNA))
+
+	for _, t := range []struct {
+		region string
+		want   string
+		start  int
+	}{
+		{region: "chr1", want: "ACGTACCGGTTT"},
+		{region: "chr1:4-7", want: "TACC", start: 3},
+		{region: "chr1:4-7(-)", want: "GGTA", start: 3},
+		{region: "chr1:10", want: "TTT", start: 9},
+		{region: "chr2:alt", want: "GGGAA"},
+		{region: "chr2:alt:3-5(-)", want: "TTC", start: 2},
+	} {
+		sq, err := r.FetchString(t.region)
+		c.Assert(err, check.Equals, nil, check.Commentf("Test: %q", t.region))
+		c.Check(sq.(*linear.Seq).Seq.String(), check.Equals, t.want, check.Commentf("Test: %q", t.region))
+		c.Check(sq.Start(), check.Equals, t.start)
+	}
+	_, err = r.FetchString("chr1:10-20")
+	c.Check(err, check.ErrorMatches, `fasta: range 9\.\.20 out of range for "chr1" of length 12`)
+	_, err = r.FetchString("chr3:1-2")
+	c.Check(err, check.ErrorMatches, `fasta: no sequence "chr3" in index`)
+
+	p := NewIndexed(strings.NewReader(fa), idx, linear.NewSeq("", nil, alphabet.Protein))
+	_, err = p.FetchString("chr1:1-2(-)")
+	c.Check(err, check.ErrorMatches, "fasta: cannot reverse complement sequence")
+}
@@ -0,0 +1,88 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fasta
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/io/seqio/fai"
+	"github.com/biogo/biogo/seq"
+
+	"fmt"
+	"io"
+)
+
+// Indexed provides random access to the sequences of a FASTA file using a FAI index.
+type Indexed struct {
+	r   io.ReaderAt
+	idx fai.Index
+	t   seqio.SequenceAppender
+}
+
+// NewIndexed returns an Indexed reading from r using the index idx. Sequences returned by
+// the Indexed are copied from the provided template.
+func NewIndexed(r io.ReaderAt, idx fai.Index, template seqio.SequenceAppender) *Indexed {
+	return &Indexed{r: r, idx: idx, t: template}
+}
+
+// Index returns the index used by r.
+func (r *Indexed) Index() fai.Index { return r.idx }
+
+// Fetch returns the subsequence of the sequence named id from start to end, in zero-based
+// half-open coordinates. Only the bytes holding the subsequence are read. The offset of the
+// returned sequence is set to start. It returns an error if id is not in the index or the
+// range is not within the sequence.
+func (r *Indexed) Fetch(id string, start, end int) (seq.Sequence, error) {
+	rec, ok := r.idx[id]
+	if !ok {
+		return nil, fmt.Errorf("fasta: no sequence %q in index", id)
+	}
+	if start < 0 || end < start || end > rec.Length {
+		return nil, fmt.Errorf("fasta: range %d..%d out of range for %q of length %d", start, end, id, rec.Length)
+	}
+
+	s := r.t.Clone().(seqio.SequenceAppender)
+	err := s.SetName(id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.SetOffset(start)
+	if err != nil {
+		return nil, err
+	}
+	if start == end {
+		return s, nil
+	}
+
+	from := rec.Position(start)
+	b := make([]byte, rec.Position(end-1)+1-from)
+	_, err = r.r.ReadAt(b, from)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	l := make([]alphabet.Letter, 0, end-start)
+	for _, c := range b {
+		if c != '\n' && c != '\r' {
+			l = append(l, alphabet.Letter(c))
+		}
+	}
+	if len(l) != end-start {
+		return nil, fmt.Errorf("fasta: index inconsistent with data for %q", id)
+	}
+	err = s.AppendLetters(l...)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Seq returns the complete sequence named id. It returns an error if id is not in the index.
+func (r *Indexed) Seq(id string) (seq.Sequence, error) {
+	rec, ok := r.idx[id]
+	if !ok {
+		return nil, fmt.Errorf("fasta: no sequence %q in index", id)
+	}
+	return r.Fetch(id, 0, rec.Length)
+}
@@ -0,0 +1,134 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fasta
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A Region is a named sequence interval.
+type Region struct {
+	// Name is the name of the sequence.
+	Name string
+
+	// Start and End are the zero-based
+	// half-open bounds of the region. If End
+	// is zero, the region extends to the end
+	// of the sequence.
+	Start, End int
+
+	// Strand is the strand of the region.
+	// Minus strand regions are fetched as
+	// the reverse complement.
+	Strand seq.Strand
+}
+
+// ParseRegion parses a samtools-style region string of the form "name", "name:begin" or
+// "name:begin-end", where begin and end are one-based inclusive positions that may include
+// commas, for example "chr2:1,000-2,000". A region without an end extends to the end of
+// the sequence. The region may be followed by "(+)" or "(-)" to specify the strand. If the
+// text after the last colon is not a valid range, the whole string is taken as the name.
+func ParseRegion(s string) (Region, error) {
+	var r Region
+	switch {
+	case strings.HasSuffix(s, "(+)"):
+		r.Strand = seq.Plus
+		s = s[:len(s)-3]
+	case strings.HasSuffix(s, "(-)"):
+		r.Strand = seq.Minus
+		s = s[:len(s)-3]
+	}
+	r.Name = s
+	i := strings.LastIndex(s, ":")
+	if i < 0 {
+		return r, nil
+	}
+	rng := strings.Replace(s[i+1:], ",", "", -1)
+	begin, end := rng, ""
+	if j := strings.Index(rng, "-"); j >= 0 {
+		begin, end = rng[:j], rng[j+1:]
+	}
+	b, err := strconv.Atoi(begin)
+	if err != nil {
+		return r, nil
+	}
+	r.Name = s[:i]
+	if b < 1 {
+		return Region{}, fmt.Errorf("fasta: invalid region start in %q", s)
+	}
+	r.Start = b - 1
+	if end != "" {
+		r.End, err = strconv.Atoi(end)
+		if err != nil || r.End < b {
+			return Region{}, fmt.Errorf("fasta: invalid region end in %q", s)
+		}
+	}
+	if r.Name == "" {
+		return Region{}, errors.New("fasta: missing region name")
+	}
+	return r, nil
+}
+
+// String returns the samtools-style representation of the region.
+func (r Region) String() string {
+	s := r.Name
+	switch {
+	case r.End != 0:
+		s = fmt.Sprintf("%s:%d-%d", s, r.Start+1, r.End)
+	case r.Start != 0:
+		s = fmt.Sprintf("%s:%d", s, r.Start+1)
+	}
+	if r.Strand == seq.Plus || r.Strand == seq.Minus {
+		s += "(" + r.Strand.String() + ")"
+	}
+	return s
+}
+
+// FetchRegion returns the subsequence described by reg, reverse complemented if reg is on
+// the minus strand. The offset of the returned sequence is set to the start of the region.
+// It returns an error if the region is not in the index, is out of range or if a minus
+// strand region is requested from a sequence whose alphabet cannot be complemented.
+func (r *Indexed) FetchRegion(reg Region) (seq.Sequence, error) {
+	if reg.Strand == seq.Minus {
+		if _, ok := r.t.Alphabet().(alphabet.Complementor); !ok {
+			return nil, errors.New("fasta: cannot reverse complement sequence")
+		}
+	}
+	end := reg.End
+	if end == 0 {
+		rec, ok := r.idx[reg.Name]
+		if !ok {
+			return nil, fmt.Errorf("fasta: no sequence %q in index", reg.Name)
+		}
+		end = rec.Length
+	}
+	s, err := r.Fetch(reg.Name, reg.Start, end)
+	if err != nil {
+		return nil, err
+	}
+	if reg.Strand == seq.Minus {
+		s.RevComp()
+	}
+	return s, nil
+}
+
+// FetchString returns the subsequence described by the region string region as parsed by
+// ParseRegion. If region names a sequence in the index it is taken as that name.
+func (r *Indexed) FetchString(region string) (seq.Sequence, error) {
+	if _, ok := r.idx[region]; ok {
+		return r.FetchRegion(Region{Name: region})
+	}
+	reg, err := ParseRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	return r.FetchRegion(reg)
+}
@@ -34,7 +34,8 @@ type Reader struct {
 }
 
 // Returns a new fastq format reader using r. Sequences returned by the Reader are copied
-// from the provided template.
+// from the provided template. Gzip and BGZF compressed input is decompressed transparently.
+// If the compression header of r is invalid, the error is returned by the first call to Read.
 func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
 	var enc alphabet.Encoding
 	if e, ok := template.(Encoder); ok {
@@ -44,7 +45,7 @@ func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
 	}
 
 	return &Reader{
-		r:   bufio.NewReader(r),
+		r:   bufio.NewReader(seqio.NewDecompressor(r)),
 		t:   template,
 		enc: enc,
 	}
@@ -6,10 +6,13 @@ package fastq
 
 import (
 	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
 	"github.com/biogo/biogo/seq/linear"
 
 	"bytes"
 	"io"
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"gopkg.in/check.v1"
@@ -451,3 +454,115 @@ func (s *S) TestWriteFastq(c *check.C) {
 		}
 	}
 }
+
+func fq(records ...string) seqio.Reader {
+	return NewReader(strings.NewReader(strings.Join(records, "")), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger))
+}
+
+func (s *S) TestMate(c *check.C) {
+	for _, t := range []struct {
+		header string
+		name   string
+		mate   int
+	}{
+		{header: "@read1/1", name: "read1", mate: 1},
+		{header: "@read1/2 extra", name: "read1", mate: 2},
+		{header: "@read1/3", name: "read1/3", mate: 0},
+		{header: "@EAS139:136:FC706VJ:2:2104:15343:197393 1:Y:18:ATCACG", name: "EAS139:136:FC706VJ:2:2104:15343:197393", mate: 1},
+		{header: "@EAS139:136:FC706VJ:2:2104:15343:197393 2:N:0:ATCACG", name: "EAS139:136:FC706VJ:2:2104:15343:197393", mate: 2},
+		{header: "@read1 length=25", name: "read1", mate: 0},
+	} {
+		r, err := fq(t.header + "\nACGT\n+\nIIII\n").Read()
+		c.Assert(err, check.Equals, nil)
+		name, mate := Mate(r)
+		c.Check(name, check.Equals, t.name, check.Commentf("Test: %q", t.header))
+		c.Check(mate, check.Equals, t.mate, check.Commentf("Test: %q", t.header))
+	}
+}
+
+func (s *S) TestPairReader(c *check.C) {
+	const (
+		a1 = "@a/1\nACGT\n+\nIIII\n"
+		a2 = "@a/2\nTTGC\n+\nIIII\n"
+		b1 = "@b 1:N:0:ACGT\nGGGG\n+\nIIII\n"
+		b2 = "@b 2:N:0:ACGT\nCCCC\n+\nIIII\n"
+	)
+	r := NewPairReader(fq(a1, b1), fq(a2, b2))
+	var names []string
+	for {
+		s1, s2, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		names = append(names, s1.Name(), s2.Name())
+	}
+	c.Check(names, check.DeepEquals, []string{"a/1", "a/2", "b", "b"})
+	c.Check(r.Pairs(), check.Equals, 2)
+
+	for _, t := range []struct {
+		r1, r2 seqio.Reader
+		err    string
+	}{
+		{r1: fq(a1, b1), r2: fq(a2), err: `fastq: pair 2: second mate stream ended early: "b 1:N:0:ACGT" ""`},
+		{r1: fq(a1), r2: fq(a2, b2), err: `fastq: pair 2: first mate stream ended early: "" "b 2:N:0:ACGT"`},
+		{r1: fq(a1, b1), r2: fq(b2, a2), err: `fastq: pair 1: mate names do not match: "a/1" "b 2:N:0:ACGT"`},
+		{r1: fq(a2), r2: fq(a1), err: `fastq: pair 1: unexpected mate number: "a/2" "a/1"`},
+	} {
+		r := NewPairReader(t.r1, t.r2)
+		var err error
+		for err == nil {
+			_, _, err = r.Read()
+		}
+		c.Check(err, check.ErrorMatches, t.err)
+		if pe, ok := err.(*PairError); c.Check(ok, check.Equals, true) {
+			c.Check(pe.Record, check.Equals, r.Pairs())
+		}
+	}
+
+	// Interleaved input.
+	in := fq(a1, a2, b1, b2)
+	r = NewPairReader(in, in)
+	var n int
+	for {
+		_, _, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		n++
+	}
+	c.Check(n, check.Equals, 2)
+}
+
+func (s *S) TestInterleave(c *check.C) {
+	const (
+		a1 = "@a/1\nACGT\n+a/1\nIIII\n"
+		a2 = "@a/2\nTTGC\n+a/2\nIIII\n"
+		b1 = "@b 1:N:0:ACGT\nGGGG\n+b 1:N:0:ACGT\nIIII\n"
+		b2 = "@b 2:N:0:ACGT\nCCCC\n+b 2:N:0:ACGT\nIIII\n"
+	)
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.QID = true
+	n, err := Interleave(w, fq(a1, b1), fq(a2, b2))
+	c.Assert(err, check.Equals, nil)
+	c.Check(n, check.Equals, 2)
+	c.Check(buf.String(), check.Equals, a1+a2+b1+b2)
+
+	var buf1, buf2 bytes.Buffer
+	w1, w2 := NewWriter(&buf1), NewWriter(&buf2)
+	w1.QID, w2.QID = true, true
+	n, err = Deinterleave(w1, w2, fq(buf.String()))
+	c.Assert(err, check.Equals, nil)
+	c.Check(n, check.Equals, 2)
+	c.Check(buf1.String(), check.Equals, a1+b1)
+	c.Check(buf2.String(), check.Equals, a2+b2)
+
+	n, err = Deinterleave(NewWriter(ioutil.Discard), NewWriter(ioutil.Discard), fq(a1, a2, b1))
+	c.Check(n, check.Equals, 1)
+	c.Check(err, check.ErrorMatches, `fastq: pair 2: second mate stream ended early: "b 1:N:0:ACGT" ""`)
+	n, err = Interleave(NewWriter(ioutil.Discard), fq(a1, b1), fq(b2, a2))
+	c.Check(n, check.Equals, 0)
+	c.Check(err, check.ErrorMatches, `fastq: pair 1: mate names do not match: .*`)
+}
@@ -0,0 +1,48 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastq
+
+import (
+	"github.com/biogo/biogo/io/seqio"
+
+	"io"
+)
+
+// Interleave writes the pairs read from r1 and r2 to w with the first mate of each pair
+// immediately preceding the second. Mates are validated as described by PairReader.Read.
+// It returns the number of pairs written and any error other than io.EOF.
+func Interleave(w seqio.Writer, r1, r2 seqio.Reader) (n int, err error) {
+	return copyPairs(w, w, NewPairReader(r1, r2))
+}
+
+// Deinterleave writes the first mate of each pair of consecutive sequences read from r to w1
+// and the second to w2. Mates are validated as described by PairReader.Read, so a
+// *PairError is returned if r holds an odd number of sequences. It returns the number of
+// pairs written and any error other than io.EOF.
+func Deinterleave(w1, w2 seqio.Writer, r seqio.Reader) (n int, err error) {
+	return copyPairs(w1, w2, NewPairReader(r, r))
+}
+
+// copyPairs writes the pairs read from r to w1 and w2.
+func copyPairs(w1, w2 seqio.Writer, r *PairReader) (n int, err error) {
+	for {
+		s1, s2, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return n, err
+		}
+		_, err = w1.Write(s1)
+		if err != nil {
+			return n, err
+		}
+		_, err = w2.Write(s2)
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+}
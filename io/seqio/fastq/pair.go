@@ -0,0 +1,127 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastq
+
+import (
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Pair synchronisation errors.
+var (
+	ErrNameMismatch = errors.New("mate names do not match")
+	ErrMateNumber   = errors.New("unexpected mate number")
+	ErrShortFirst   = errors.New("first mate stream ended early")
+	ErrShortSecond  = errors.New("second mate stream ended early")
+)
+
+// A PairError describes a loss of synchronisation between the mates of a paired-end read
+// stream.
+type PairError struct {
+	// Record is the one-based number of the
+	// pair at which the error was detected.
+	Record int
+
+	// Name1 and Name2 are the full names of
+	// the mates. The name of a mate is empty
+	// if its stream ended early.
+	Name1, Name2 string
+
+	// Err is the cause of the error.
+	Err error
+}
+
+func (e *PairError) Error() string {
+	return fmt.Sprintf("fastq: pair %d: %v: %q %q", e.Record, e.Err, e.Name1, e.Name2)
+}
+
+// Mate returns the template name of the read s and its mate number, or zero if the mate
+// number cannot be determined. Mate numbers are recognised from a "/1" or "/2" suffix of the
+// read name, which is removed from the returned name, or from the first field of a Casava
+// 1.8 style description, for example "1:N:0:ATCACG".
+func Mate(s seq.Sequence) (name string, mate int) {
+	name = s.Name()
+	if n := len(name); n > 2 && name[n-2] == '/' && (name[n-1] == '1' || name[n-1] == '2') {
+		return name[:n-2], int(name[n-1] - '0')
+	}
+	desc := s.Description()
+	if len(desc) > 3 && (desc[0] == '1' || desc[0] == '2') && desc[1] == ':' && (desc[2] == 'Y' || desc[2] == 'N') && desc[3] == ':' {
+		return name, int(desc[0] - '0')
+	}
+	return name, 0
+}
+
+// A PairReader reads the mates of paired-end reads from a pair of synchronised sequence
+// streams.
+type PairReader struct {
+	r1, r2 seqio.Reader
+	n      int
+}
+
+// NewPairReader returns a PairReader that reads first mates from r1 and second mates from
+// r2. To read an interleaved stream, r1 and r2 may be the same Reader.
+func NewPairReader(r1, r2 seqio.Reader) *PairReader {
+	return &PairReader{r1: r1, r2: r2}
+}
+
+// Read returns the next pair of mates. The template names of the mates as returned by Mate
+// must be identical and the mate numbers, if present, must be 1 and 2. Read returns io.EOF
+// when both streams end together, and a *PairError if the mates are not consistent or one
+// stream ends before the other.
+func (r *PairReader) Read() (s1, s2 seq.Sequence, err error) {
+	r.n++
+	s1, err = r.r1.Read()
+	if err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+	eof1 := err == io.EOF
+	s2, err = r.r2.Read()
+	if err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+	eof2 := err == io.EOF
+	switch {
+	case eof1 && eof2:
+		r.n--
+		return nil, nil, io.EOF
+	case eof1:
+		return nil, nil, &PairError{Record: r.n, Name2: fullName(s2), Err: ErrShortFirst}
+	case eof2:
+		return nil, nil, &PairError{Record: r.n, Name1: fullName(s1), Err: ErrShortSecond}
+	}
+	err = checkMates(s1, s2)
+	if err != nil {
+		return nil, nil, &PairError{Record: r.n, Name1: fullName(s1), Name2: fullName(s2), Err: err}
+	}
+	return s1, s2, nil
+}
+
+// Pairs returns the number of pairs read by r, including a pair that resulted in an error.
+func (r *PairReader) Pairs() int { return r.n }
+
+// checkMates returns an error if s1 and s2 are not mates.
+func checkMates(s1, s2 seq.Sequence) error {
+	n1, m1 := Mate(s1)
+	n2, m2 := Mate(s2)
+	if n1 != n2 {
+		return ErrNameMismatch
+	}
+	if (m1 != 0 && m1 != 1) || (m2 != 0 && m2 != 2) {
+		return ErrMateNumber
+	}
+	return nil
+}
+
+// fullName returns the name and description of s.
+func fullName(s seq.Sequence) string {
+	if d := s.Description(); d != "" {
+		return s.Name() + " " + d
+	}
+	return s.Name()
+}
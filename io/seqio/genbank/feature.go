@@ -0,0 +1,290 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genbank
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A Qualifier is a feature table qualifier, "/name=value".
+type Qualifier struct {
+	Name, Value string
+
+	// Quoted specifies that Value is written
+	// as a quoted string. A qualifier with
+	// an empty unquoted Value is written as
+	// a flag, for example "/pseudo".
+	Quoted bool
+}
+
+// Qualifiers is a list of feature qualifiers.
+type Qualifiers []Qualifier
+
+// Get returns the value of the first qualifier with the given name, or the empty string
+// if no such qualifier exists.
+func (q Qualifiers) Get(name string) string {
+	v, _ := q.Lookup(name)
+	return v
+}
+
+// Lookup returns the value of the first qualifier with the given name and whether such a
+// qualifier exists.
+func (q Qualifiers) Lookup(name string) (string, bool) {
+	for _, qual := range q {
+		if qual.Name == name {
+			return qual.Value, true
+		}
+	}
+	return "", false
+}
+
+// A Feature is an entry in a feature table.
+type Feature struct {
+	// Key is the feature key, for
+	// example "gene" or "CDS".
+	Key string
+
+	// FeatLocation is the location of the
+	// feature on the entry sequence.
+	FeatLocation *Location
+
+	// Qualifiers holds the qualifiers of
+	// the feature in the order they are
+	// written.
+	Qualifiers Qualifiers
+
+	// Seq is the sequence described by
+	// the entry holding the feature.
+	Seq feat.Feature
+}
+
+// Name returns the value of the gene, locus_tag or label qualifier of the feature, or
+// its key if none of these are present.
+func (f *Feature) Name() string {
+	for _, n := range []string{"gene", "locus_tag", "label"} {
+		if v, ok := f.Qualifiers.Lookup(n); ok {
+			return v
+		}
+	}
+	return f.Key
+}
+
+// Description returns the key of the feature.
+func (f *Feature) Description() string { return f.Key }
+
+func (f *Feature) Start() int                    { return f.FeatLocation.Min() }
+func (f *Feature) End() int                      { return f.FeatLocation.Max() }
+func (f *Feature) Len() int                      { return f.End() - f.Start() }
+func (f *Feature) Location() feat.Feature        { return f.Seq }
+func (f *Feature) Orientation() feat.Orientation { return f.FeatLocation.Orientation() }
+
+const (
+	// keyWidth is the width of the key
+	// column of a feature table line
+	// after the line prefix.
+	keyWidth = 16
+
+	// valueWidth is the maximum width
+	// of a feature table value.
+	valueWidth = 58
+)
+
+// A FeatureParser parses INSDC feature table lines, as used by the GenBank and EMBL formats.
+type FeatureParser struct {
+	features []*Feature
+	loc      string
+	quals    []rawQualifier
+	pending  bool
+	seq      feat.Feature
+
+	// split specifies that the last
+	// qualifier line was broken at
+	// valueWidth rather than at a space.
+	split bool
+}
+
+type rawQualifier struct {
+	name, text string
+}
+
+func (q *rawQualifier) open() bool {
+	return strings.HasPrefix(q.text, `"`) && strings.Count(q.text, `"`)%2 == 1
+}
+
+// NewFeatureParser returns a FeatureParser for the feature table of an entry describing the
+// sequence s. The Seq field of parsed features is set to s.
+func NewFeatureParser(s feat.Feature) *FeatureParser {
+	return &FeatureParser{seq: s}
+}
+
+// Line adds the feature table line l, with its five column line prefix removed, to the
+// features being parsed.
+func (p *FeatureParser) Line(l string) error {
+	if l == "" {
+		return nil
+	}
+	split := p.split
+	p.split = false
+	if l[0] != ' ' {
+		err := p.flush()
+		if err != nil {
+			return err
+		}
+		key, loc := l, ""
+		if len(l) > keyWidth {
+			key, loc = l[:keyWidth], l[keyWidth:]
+		}
+		p.features = append(p.features, &Feature{Key: strings.TrimSpace(key), Seq: p.seq})
+		p.loc = strings.TrimSpace(loc)
+		p.pending = true
+		return nil
+	}
+	if len(p.features) == 0 {
+		return fmt.Errorf("genbank: feature continuation line without feature: %q", l)
+	}
+	t := strings.TrimSpace(l)
+	if split && len(l) > keyWidth && strings.TrimSpace(l[:keyWidth]) == "" {
+		// Keep a space that followed the break.
+		t = strings.TrimRight(l[keyWidth:], " ")
+	}
+	n := len(p.quals)
+	switch {
+	case n != 0 && split:
+		p.quals[n-1].text += t
+		p.split = isSplit(t)
+	case n != 0 && p.quals[n-1].open():
+		q := &p.quals[n-1]
+		if q.name == "translation" {
+			q.text += t
+		} else {
+			q.text += " " + t
+		}
+		p.split = isSplit(t)
+	case strings.HasPrefix(t, "/"):
+		var q rawQualifier
+		if i := strings.Index(t, "="); i >= 0 {
+			q.name, q.text = t[1:i], t[i+1:]
+		} else {
+			q.name = t[1:]
+		}
+		p.quals = append(p.quals, q)
+		p.split = isSplit(t)
+	case n == 0:
+		p.loc += t
+	default:
+		p.quals[n-1].text += " " + t
+		p.split = isSplit(t)
+	}
+	return nil
+}
+
+// isSplit returns whether the qualifier line t was broken at valueWidth by Wrap rather than
+// at a space.
+func isSplit(t string) bool {
+	return len(t) == valueWidth && strings.IndexByte(t[1:], ' ') < 0
+}
+
+// Features completes the parsing of the feature table and returns the parsed features.
+func (p *FeatureParser) Features() ([]*Feature, error) {
+	err := p.flush()
+	if err != nil {
+		return nil, err
+	}
+	return p.features, nil
+}
+
+// flush completes the feature being parsed.
+func (p *FeatureParser) flush() error {
+	if !p.pending {
+		return nil
+	}
+	p.pending = false
+	f := p.features[len(p.features)-1]
+	var err error
+	f.FeatLocation, err = ParseLocation(p.loc)
+	if err != nil {
+		return err
+	}
+	if f.FeatLocation.spansOrigin() {
+		if c, ok := p.seq.(feat.Conformationer); !ok || c.Conformation() != feat.Circular {
+			return fmt.Errorf("genbank: location spanning origin of linear sequence in %s feature: %s", f.Key, p.loc)
+		}
+	}
+	for _, q := range p.quals {
+		qual := Qualifier{Name: q.name, Value: q.text}
+		if strings.HasPrefix(q.text, `"`) {
+			if q.open() {
+				return fmt.Errorf("genbank: unterminated qualifier value in %s feature: /%s=%s", f.Key, q.name, q.text)
+			}
+			qual.Value = strings.Replace(q.text[1:len(q.text)-1], `""`, `"`, -1)
+			qual.Quoted = true
+		}
+		f.Qualifiers = append(f.Qualifiers, qual)
+	}
+	p.loc = ""
+	p.quals = p.quals[:0]
+	p.split = false
+	return nil
+}
+
+// WriteFeatures writes the feature table lines for fs to w with each line prefixed by the five
+// column line prefix, "     " for GenBank and "FT   " for EMBL. It returns the number of
+// bytes written and any error.
+func WriteFeatures(w io.Writer, prefix string, fs []*Feature) (int, error) {
+	var buf bytes.Buffer
+	writeFeatures(&buf, prefix, fs)
+	return w.Write(buf.Bytes())
+}
+
+func writeFeatures(buf *bytes.Buffer, prefix string, fs []*Feature) {
+	indent := prefix + strings.Repeat(" ", keyWidth)
+	for _, f := range fs {
+		for i, l := range Wrap(f.FeatLocation.String(), valueWidth, ',', true) {
+			if i == 0 {
+				fmt.Fprintf(buf, "%s%-*s%s\n", prefix, keyWidth, f.Key, l)
+			} else {
+				fmt.Fprintf(buf, "%s%s\n", indent, l)
+			}
+		}
+		for _, q := range f.Qualifiers {
+			t := "/" + q.Name
+			switch {
+			case q.Quoted:
+				t += `="` + strings.Replace(q.Value, `"`, `""`, -1) + `"`
+			case q.Value != "":
+				t += "=" + q.Value
+			}
+			for _, l := range Wrap(t, valueWidth, ' ', false) {
+				fmt.Fprintf(buf, "%s%s\n", indent, l)
+			}
+		}
+	}
+}
+
+// Wrap splits s into lines no longer than width for writing flat file fields. Lines are
+// broken after the last sep in the line if keep is true, and otherwise at the last sep, which
+// is removed. Lines without a sep are broken at width. When keep is false, a line that would
+// be width long with no sep after its first byte is also broken at width, keeping the sep, so
+// that readers can tell it from a line without a sep.
+func Wrap(s string, width int, sep byte, keep bool) []string {
+	var lines []string
+	for len(s) > width {
+		i, drop := strings.LastIndexByte(s[:width+1], sep), 1
+		if keep {
+			i, drop = strings.LastIndexByte(s[:width], sep)+1, 0
+		}
+		if i <= 0 || (!keep && i == width && strings.IndexByte(s[1:width], sep) < 0) {
+			i, drop = width, 0
+		}
+		lines = append(lines, s[:i])
+		s = s[i+drop:]
+	}
+	return append(lines, s)
+}
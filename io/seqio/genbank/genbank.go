@@ -0,0 +1,431 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package genbank provides types to read and write GenBank flat file format files.
+package genbank
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ seqio.Reader = (*Reader)(nil)
+	_ seqio.Writer = (*Writer)(nil)
+)
+
+// Locus holds the LOCUS line fields of an entry that are not described by its sequence.
+type Locus struct {
+	// MolType is the molecule type, for
+	// example "DNA", "mRNA" or "ss-RNA".
+	MolType string
+
+	// Division is the three letter
+	// GenBank division code.
+	Division string
+
+	// Date is the modification date of
+	// the entry, for example "21-JUN-1999".
+	Date string
+}
+
+// A Field is a header field of an entry.
+type Field struct {
+	// Key is the keyword of the field as
+	// written, including the indentation
+	// of subkeywords, for example "SOURCE"
+	// or "  ORGANISM".
+	Key string
+
+	// Value is the value of the field,
+	// with continuation lines separated by
+	// newlines.
+	Value string
+}
+
+// Fields is a list of header fields.
+type Fields []Field
+
+// Get returns the value of the first field with the given keyword, ignoring indentation,
+// or the empty string if no such field exists.
+func (f Fields) Get(key string) string {
+	for _, field := range f {
+		if strings.TrimSpace(field.Key) == key {
+			return field.Value
+		}
+	}
+	return ""
+}
+
+// A Record is a GenBank entry.
+type Record struct {
+	// Seq is the sequence of the entry.
+	// Its name, description and
+	// conformation are taken from the
+	// LOCUS and DEFINITION lines.
+	Seq seq.Sequence
+
+	Locus Locus
+
+	// Header holds the header fields
+	// of the entry other than LOCUS and
+	// DEFINITION, in order.
+	Header Fields
+
+	// Features is the feature table
+	// of the entry.
+	Features []*Feature
+}
+
+const (
+	// indentWidth is the width of the
+	// keyword column of header lines.
+	indentWidth = 12
+
+	// featurePrefix is the prefix of
+	// feature table lines.
+	featurePrefix = "     "
+)
+
+// GenBank format reader type.
+type Reader struct {
+	r    *bufio.Reader
+	t    seqio.SequenceAppender
+	line int
+}
+
+// NewReader returns a new GenBank format reader using r. Sequences returned by the Reader
+// are copied from the provided template. Gzip and BGZF compressed input is decompressed
+// transparently. If the compression header of r is invalid, the error is returned by the
+// first call to Read or ReadRecord.
+func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
+	return &Reader{r: bufio.NewReader(seqio.NewDecompressor(r)), t: template}
+}
+
+// Read reads a single entry and returns its sequence. The entry's feature table is
+// discarded; use ReadRecord to obtain it.
+func (r *Reader) Read() (seq.Sequence, error) {
+	rec, err := r.ReadRecord()
+	if err != nil {
+		return nil, err
+	}
+	return rec.Seq, nil
+}
+
+// readLine returns the next line without its line ending.
+func (r *Reader) readLine() (string, error) {
+	l, err := r.r.ReadString('\n')
+	if err == io.EOF && l != "" {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+	r.line++
+	return strings.TrimRight(l, "\r\n"), nil
+}
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("genbank: line %d: %s", r.line, fmt.Sprintf(format, args...))
+}
+
+// lineError returns err annotated with the current line number.
+func (r *Reader) lineError(err error) error {
+	return r.errorf("%s", strings.TrimPrefix(err.Error(), "genbank: "))
+}
+
+// ReadRecord reads a single entry. It returns io.EOF if no entry remains in the input and
+// io.ErrUnexpectedEOF if the input ends within an entry.
+func (r *Reader) ReadRecord() (*Record, error) {
+	const (
+		header = iota
+		features
+		sequence
+	)
+	var (
+		rec    *Record
+		s      seqio.SequenceAppender
+		length int
+		fp     *FeatureParser
+		state  = header
+	)
+	for {
+		l, err := r.readLine()
+		if err != nil {
+			if err == io.EOF && rec != nil {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		if rec == nil {
+			if strings.TrimSpace(l) == "" {
+				continue
+			}
+			if !strings.HasPrefix(l, "LOCUS ") {
+				return nil, r.errorf("expected LOCUS line: %q", l)
+			}
+			s = r.t.Clone().(seqio.SequenceAppender)
+			rec = &Record{Seq: s}
+			length, err = r.locus(rec, l)
+			if err != nil {
+				return nil, err
+			}
+			fp = NewFeatureParser(s)
+			continue
+		}
+		if strings.TrimSpace(l) == "//" {
+			return r.finish(rec, length, fp)
+		}
+
+		switch state {
+		case sequence:
+			letters := make([]alphabet.Letter, 0, len(l))
+			for _, c := range []byte(l) {
+				if c != ' ' && c != '\t' && (c < '0' || '9' < c) {
+					letters = append(letters, alphabet.Letter(c))
+				}
+			}
+			err = s.AppendLetters(letters...)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		case features:
+			if strings.HasPrefix(l, " ") || l == "" {
+				if len(l) > len(featurePrefix) {
+					l = l[len(featurePrefix):]
+				}
+				err = fp.Line(l)
+				if err != nil {
+					return nil, r.lineError(err)
+				}
+				continue
+			}
+			rec.Features, err = fp.Features()
+			if err != nil {
+				return nil, r.lineError(err)
+			}
+			state = header
+		}
+
+		switch {
+		case strings.TrimSpace(l) == "":
+		case strings.HasPrefix(l, "FEATURES"):
+			state = features
+		case strings.HasPrefix(l, "ORIGIN"):
+			state = sequence
+		case strings.HasPrefix(l, "BASE COUNT"):
+		case len(l) > indentWidth && strings.TrimSpace(l[:indentWidth]) == "":
+			if len(rec.Header) == 0 {
+				return nil, r.errorf("continuation line without field: %q", l)
+			}
+			rec.Header[len(rec.Header)-1].Value += "\n" + l[indentWidth:]
+		default:
+			f := Field{Key: strings.TrimRight(l, " ")}
+			if len(l) > indentWidth {
+				f = Field{Key: strings.TrimRight(l[:indentWidth], " "), Value: l[indentWidth:]}
+			}
+			rec.Header = append(rec.Header, f)
+		}
+	}
+}
+
+// locus sets the LOCUS line fields of rec from the line l and returns the sequence length.
+func (r *Reader) locus(rec *Record, l string) (int, error) {
+	f := strings.Fields(l)
+	if len(f) < 3 {
+		return 0, r.errorf("short LOCUS line: %q", l)
+	}
+	s := rec.Seq.(seqio.SequenceAppender)
+	err := s.SetName(f[1])
+	if err != nil {
+		return 0, err
+	}
+	length, err := strconv.Atoi(f[2])
+	if err != nil {
+		return 0, r.errorf("invalid LOCUS length: %q", f[2])
+	}
+	f = f[3:]
+	if len(f) != 0 && (f[0] == "bp" || f[0] == "aa") {
+		f = f[1:]
+	}
+	if n := len(f); n != 0 && isDate(f[n-1]) {
+		rec.Locus.Date = f[n-1]
+		f = f[:n-1]
+	}
+	var before []string
+	for i, t := range f {
+		if t == "linear" || t == "circular" {
+			if t == "circular" {
+				err = s.SetConformation(feat.Circular)
+				if err != nil {
+					return 0, err
+				}
+			}
+			before = f[:i]
+			if i+1 < len(f) {
+				rec.Locus.Division = f[i+1]
+			}
+			break
+		}
+	}
+	if before == nil {
+		before = f
+		if len(f) > 1 {
+			rec.Locus.Division = f[len(f)-1]
+		}
+	}
+	if len(before) != 0 {
+		rec.Locus.MolType = before[0]
+	}
+	return length, nil
+}
+
+// isDate returns whether s is formatted as a GenBank date, for example "21-JUN-1999".
+func isDate(s string) bool {
+	return len(s) == 11 && s[2] == '-' && s[6] == '-'
+}
+
+// finish completes the parsing of rec, checking that any sequence read has the length given
+// by the LOCUS line.
+func (r *Reader) finish(rec *Record, length int, fp *FeatureParser) (*Record, error) {
+	var err error
+	rec.Features, err = fp.Features()
+	if err != nil {
+		return nil, r.lineError(err)
+	}
+	if n := rec.Seq.Len(); n != 0 && n != length {
+		return nil, r.errorf("sequence length %d does not match LOCUS length %d", n, length)
+	}
+	for i, f := range rec.Header {
+		if f.Key == "DEFINITION" {
+			err = rec.Seq.(seqio.SequenceAppender).SetDescription(strings.Replace(f.Value, "\n", " ", -1))
+			if err != nil {
+				return nil, err
+			}
+			rec.Header = append(rec.Header[:i], rec.Header[i+1:]...)
+			break
+		}
+	}
+	return rec, nil
+}
+
+// GenBank format writer type.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter returns a new GenBank format writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes a single sequence as an entry without a feature table and returns the number
+// of bytes written and any error.
+func (w *Writer) Write(s seq.Sequence) (n int, err error) {
+	return w.WriteRecord(&Record{Seq: s})
+}
+
+// WriteRecord writes a single entry and returns the number of bytes written and any error.
+// The LOCUS line is constructed from rec.Seq and rec.Locus, using "UNK" and "01-JAN-1980"
+// for an unspecified division and date, and the DEFINITION line from the description of
+// rec.Seq. Header fields are written in order, followed by the feature table and sequence.
+func (w *Writer) WriteRecord(rec *Record) (n int, err error) {
+	s := rec.Seq
+	if s == nil {
+		return 0, errors.New("genbank: no sequence")
+	}
+	var buf bytes.Buffer
+
+	unit, molType := "bp", rec.Locus.MolType
+	switch s.Alphabet().Moltype() {
+	case feat.Protein:
+		unit = "aa"
+	case feat.DNA:
+		if molType == "" {
+			molType = "DNA"
+		}
+	case feat.RNA:
+		if molType == "" {
+			molType = "RNA"
+		}
+	}
+	topology := "linear"
+	if s.Conformation() == feat.Circular {
+		topology = "circular"
+	}
+	division := rec.Locus.Division
+	if division == "" {
+		division = "UNK"
+	}
+	date := rec.Locus.Date
+	if date == "" {
+		date = "01-JAN-1980"
+	}
+	fmt.Fprintf(&buf, "LOCUS       %-16s %11d %s    %-6s  %-8s %s %s\n",
+		s.Name(), s.Len(), unit, molType, topology, division, date)
+
+	desc := s.Description()
+	if desc == "" {
+		desc = "."
+	}
+	writeField(&buf, Field{Key: "DEFINITION", Value: strings.Join(Wrap(desc, 80-indentWidth, ' ', false), "\n")})
+	for _, f := range rec.Header {
+		writeField(&buf, f)
+	}
+
+	if len(rec.Features) != 0 {
+		buf.WriteString("FEATURES             Location/Qualifiers\n")
+		writeFeatures(&buf, featurePrefix, rec.Features)
+	}
+
+	buf.WriteString("ORIGIN\n")
+	for i := 0; i < s.Len(); i++ {
+		switch {
+		case i%60 == 0:
+			if i != 0 {
+				buf.WriteByte('\n')
+			}
+			fmt.Fprintf(&buf, "%9d ", i+1)
+		case i%10 == 0:
+			buf.WriteByte(' ')
+		}
+		buf.WriteByte(byte(lower(s.At(i).L)))
+	}
+	if s.Len() != 0 {
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("//\n")
+
+	return w.w.Write(buf.Bytes())
+}
+
+// writeField writes the header field f to buf.
+func writeField(buf *bytes.Buffer, f Field) {
+	for i, l := range strings.Split(f.Value, "\n") {
+		key := ""
+		if i == 0 {
+			key = f.Key
+		}
+		fmt.Fprintln(buf, strings.TrimRight(fmt.Sprintf("%-*s%s", indentWidth, key, l), " "))
+	}
+}
+
+// lower returns the lower case form of l.
+func lower(l alphabet.Letter) alphabet.Letter {
+	if 'A' <= l && l <= 'Z' {
+		return l + 'a' - 'A'
+	}
+	return l
+}
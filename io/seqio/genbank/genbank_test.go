@@ -0,0 +1,220 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genbank
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const testEntry = `LOCUS       TEST0001                 130 bp    DNA     circular BCT 21-JUN-1999
+DEFINITION  Test plasmid with a wrapped definition line that is longer than the
+            line width.
+ACCESSION   TEST0001
+VERSION     TEST0001.1
+KEYWORDS    .
+SOURCE      Escherichia coli
+  ORGANISM  Escherichia coli
+            Bacteria; Proteobacteria.
+FEATURES             Location/Qualifiers
+     source          1..130
+                     /organism="Escherichia coli"
+                     /mol_type="genomic DNA"
+     gene            <10..>90
+                     /gene="abcA"
+     CDS             join(10..40,50..90)
+                     /gene="abcA"
+                     /codon_start=1
+                     /note="a long note that needs to be wrapped across more
+                     than one line with ""quoted"" text"
+                     /translation="MSTNPKPQRKTKRNTNRRPQDVKFPGGGQIVGGVYLLPRRGPRL
+                     GVRATRKTSERSQPRG"
+     misc_feature    complement(100^101)
+                     /pseudo
+ORIGIN
+        1 gatcctccat atacaacggt atctccacct caggtttaga tctcaacaac ggaaccattg
+       61 ccgacatgag acagttaggt atcgtcgaga gttacaagct aaaacgagca gtagtcagct
+      121 ctgcatctga
+//
+`
+
+func (s *S) TestParseLocation(c *check.C) {
+	for i, t := range []struct {
+		loc      string
+		want     string
+		min, max int
+		orient   feat.Orientation
+		err      bool
+	}{
+		{loc: "467", want: "467", min: 466, max: 467, orient: feat.Forward},
+		{loc: "340..565", want: "340..565", min: 339, max: 565, orient: feat.Forward},
+		{loc: "<345..500", want: "<345..500", min: 344, max: 500, orient: feat.Forward},
+		{loc: "<1..>888", want: "<1..>888", min: 0, max: 888, orient: feat.Forward},
+		{loc: "123^124", want: "123^124", min: 123, max: 123, orient: feat.Forward},
+		{loc: "10^11", want: "10^11", min: 10, max: 10, orient: feat.Forward},
+		{loc: "130^1", want: "130^1", min: 130, max: 130, orient: feat.Forward},
+		{loc: "join(12..78,134..202)", want: "join(12..78,134..202)", min: 11, max: 202, orient: feat.Forward},
+		{loc: "complement(34..126)", want: "complement(34..126)", min: 33, max: 126, orient: feat.Reverse},
+		{
+			loc:  "complement(join(2691..4571, 4918..5163))",
+			want: "complement(join(2691..4571,4918..5163))",
+			min:  2690, max: 5163, orient: feat.Reverse,
+		},
+		{
+			loc:  "join(complement(4918..5163),complement(2691..4571))",
+			want: "join(complement(4918..5163),complement(2691..4571))",
+			min:  2690, max: 5163, orient: feat.Reverse,
+		},
+		{loc: "order(1..10,complement(20..30))", want: "order(1..10,complement(20..30))", min: 0, max: 30, orient: feat.Forward},
+		{loc: "join(1..10,J00194.1:100..202)", want: "join(1..10,J00194.1:100..202)", min: 0, max: 10, orient: feat.Forward},
+		{loc: "", err: true},
+		{loc: "0..10", err: true},
+		{loc: "10..5", err: true},
+		{loc: "10^20", err: true},
+		{loc: "10^10", err: true},
+		{loc: "1^1", err: true},
+		{loc: "join(1..10", err: true},
+		{loc: "join(1..10))", err: true},
+		{loc: "bond(1..10)", err: true},
+		{loc: "complement(1..10,20..30)", err: true},
+	} {
+		l, err := ParseLocation(t.loc)
+		if t.err {
+			c.Check(err, check.NotNil, check.Commentf("Test %d: %q", i, t.loc))
+			continue
+		}
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d: %q", i, t.loc))
+		c.Check(l.String(), check.Equals, t.want, check.Commentf("Test %d", i))
+		c.Check(l.Min(), check.Equals, t.min, check.Commentf("Test %d", i))
+		c.Check(l.Max(), check.Equals, t.max, check.Commentf("Test %d", i))
+		c.Check(l.Orientation(), check.Equals, t.orient, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestReadRecord(c *check.C) {
+	r := NewReader(strings.NewReader("\n"+testEntry+testEntry), linear.NewSeq("", nil, alphabet.DNA))
+	rec, err := r.ReadRecord()
+	c.Assert(err, check.Equals, nil)
+
+	c.Check(rec.Seq.Name(), check.Equals, "TEST0001")
+	c.Check(rec.Seq.Description(), check.Equals, "Test plasmid with a wrapped definition line that is longer than the line width.")
+	c.Check(rec.Seq.Len(), check.Equals, 130)
+	c.Check(rec.Seq.Conformation(), check.Equals, feat.Circular)
+	c.Check(rec.Seq.(*linear.Seq).Seq[120:].String(), check.Equals, "ctgcatctga")
+	c.Check(rec.Locus, check.Equals, Locus{MolType: "DNA", Division: "BCT", Date: "21-JUN-1999"})
+	c.Check(rec.Header.Get("ORGANISM"), check.Equals, "Escherichia coli\nBacteria; Proteobacteria.")
+	c.Check(rec.Header.Get("DEFINITION"), check.Equals, "")
+	c.Check(len(rec.Header), check.Equals, 5)
+
+	c.Assert(len(rec.Features), check.Equals, 4)
+	cds := rec.Features[2]
+	c.Check(cds.Key, check.Equals, "CDS")
+	c.Check(cds.Name(), check.Equals, "abcA")
+	c.Check(cds.Start(), check.Equals, 9)
+	c.Check(cds.End(), check.Equals, 90)
+	c.Check(cds.Location(), check.Equals, rec.Seq)
+	c.Check(cds.Qualifiers.Get("codon_start"), check.Equals, "1")
+	c.Check(cds.Qualifiers.Get("note"), check.Equals, `a long note that needs to be wrapped across more than one line with "quoted" text`)
+	c.Check(cds.Qualifiers.Get("translation"), check.Equals, "MSTNPKPQRKTKRNTNRRPQDVKFPGGGQIVGGVYLLPRRGPRLGVRATRKTSERSQPRG")
+	misc := rec.Features[3]
+	c.Check(misc.Name(), check.Equals, "misc_feature")
+	c.Check(misc.Orientation(), check.Equals, feat.Reverse)
+	c.Check(misc.Qualifiers, check.DeepEquals, Qualifiers{{Name: "pseudo"}})
+
+	_, err = r.Read()
+	c.Check(err, check.Equals, nil)
+	_, err = r.Read()
+	c.Check(err, check.Equals, io.EOF)
+
+	_, err = NewReader(strings.NewReader(testEntry[:200]), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Check(err, check.Equals, io.ErrUnexpectedEOF)
+	_, err = NewReader(strings.NewReader(strings.Replace(testEntry, " 130 bp", " 131 bp", 1)), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Check(err, check.ErrorMatches, "genbank: line 29: sequence length 130 does not match LOCUS length 131")
+	_, err = NewReader(strings.NewReader(strings.Replace(testEntry, "<10..>90", "<10..>9", 1)), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Check(err, check.ErrorMatches, `genbank: line 16: invalid location .*`)
+}
+
+func (s *S) TestFeatureOrigin(c *check.C) {
+	for _, t := range []struct {
+		conform feat.Conformation
+		err     bool
+	}{
+		{conform: feat.Circular},
+		{conform: feat.Linear, err: true},
+	} {
+		sq := linear.NewSeq("", nil, alphabet.DNA)
+		sq.Conform = t.conform
+		p := NewFeatureParser(sq)
+		c.Assert(p.Line("misc_feature    complement(130^1)"), check.Equals, nil)
+		fs, err := p.Features()
+		if t.err {
+			c.Check(err, check.ErrorMatches, "genbank: location spanning origin of linear sequence .*")
+			continue
+		}
+		c.Assert(err, check.Equals, nil)
+		c.Check(fs[0].FeatLocation.String(), check.Equals, "complement(130^1)")
+	}
+}
+
+func (s *S) TestFeatureSplit(c *check.C) {
+	long := strings.Repeat("ACGT", 20)
+	fs := []*Feature{{
+		Key:          "CDS",
+		FeatLocation: &Location{Type: Range, Start: 0, End: 90},
+		Qualifiers: Qualifiers{
+			{Name: "db_xref", Value: "UniProtKB:" + long, Quoted: true},
+			{Name: "note", Value: long + " and " + long[:52] + " " + long, Quoted: true},
+			{Name: "function", Value: long[:51] + " end", Quoted: true},
+			{Name: "label", Value: long + long},
+		},
+	}}
+	var buf bytes.Buffer
+	_, err := WriteFeatures(&buf, featurePrefix, fs)
+	c.Assert(err, check.Equals, nil)
+	p := NewFeatureParser(nil)
+	for _, l := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		c.Check(len(l) <= 80, check.Equals, true, check.Commentf("%q", l))
+		c.Assert(p.Line(l[len(featurePrefix):]), check.Equals, nil)
+	}
+	got, err := p.Features()
+	c.Assert(err, check.Equals, nil)
+	c.Check(got[0].Qualifiers, check.DeepEquals, fs[0].Qualifiers)
+}
+
+func (s *S) TestWriteRecord(c *check.C) {
+	rec, err := NewReader(strings.NewReader(testEntry), linear.NewSeq("", nil, alphabet.DNA)).ReadRecord()
+	c.Assert(err, check.Equals, nil)
+	var buf bytes.Buffer
+	n, err := NewWriter(&buf).WriteRecord(rec)
+	c.Check(err, check.Equals, nil)
+	c.Check(n, check.Equals, buf.Len())
+	c.Check(buf.String(), check.Equals, testEntry)
+
+	buf.Reset()
+	sq := linear.NewSeq("seq1", alphabet.BytesToLetters([]byte("ACGTACGTAC")), alphabet.DNA)
+	_, err = NewWriter(&buf).Write(sq)
+	c.Check(err, check.Equals, nil)
+	c.Check(buf.String(), check.Equals, `LOCUS       seq1                      10 bp    DNA     linear   UNK 01-JAN-1980
+DEFINITION  .
+ORIGIN
+        1 acgtacgtac
+//
+`)
+}
@@ -0,0 +1,317 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genbank
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LocationType specifies the type of a feature location.
+type LocationType int
+
+const (
+	Range      LocationType = iota // A base or span of bases, "467" or "340..565".
+	Between                        // A site between two adjacent bases, "123^124".
+	Join                           // Joined segments, "join(12..78,134..202)".
+	Order                          // Segments in unspecified order, "order(12..78,134..202)".
+	Complement                     // The complementary strand, "complement(34..126)".
+)
+
+var operators = map[string]LocationType{
+	"join":       Join,
+	"order":      Order,
+	"complement": Complement,
+}
+
+func (t LocationType) String() string {
+	switch t {
+	case Range:
+		return "range"
+	case Between:
+		return "between"
+	case Join:
+		return "join"
+	case Order:
+		return "order"
+	case Complement:
+		return "complement"
+	}
+	return "unknown"
+}
+
+// A Location is an INSDC feature table location.
+type Location struct {
+	Type LocationType
+
+	// Accession is the accession of a
+	// remote entry holding a Range or
+	// Between location, and is empty for
+	// locations on the described sequence.
+	Accession string
+
+	// Start and End are the zero-based
+	// half-open bounds of a Range location.
+	// For a Between location, Start and
+	// End are both the position of the
+	// site between two bases.
+	Start, End int
+
+	// Origin specifies that a Between
+	// location is the site between the
+	// last and first bases of a circular
+	// sequence, written "n^1", where n
+	// is Start.
+	Origin bool
+
+	// PartialStart and PartialEnd specify
+	// that a Range extends beyond its Start
+	// or End, written with a "<" or ">".
+	PartialStart, PartialEnd bool
+
+	// Sub holds the operands of a Join,
+	// Order or Complement location.
+	Sub []*Location
+}
+
+// ParseLocation parses the INSDC feature location string s.
+func ParseLocation(s string) (*Location, error) {
+	p := locParser{s: strings.Join(strings.Fields(s), "")}
+	l, err := p.location()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.s) {
+		return nil, fmt.Errorf("genbank: unexpected %q in location %q", p.s[p.pos:], s)
+	}
+	return l, nil
+}
+
+type locParser struct {
+	s   string
+	pos int
+}
+
+func (p *locParser) location() (*Location, error) {
+	i := strings.IndexAny(p.s[p.pos:], "(,)")
+	if i >= 0 && p.s[p.pos+i] == '(' {
+		op := p.s[p.pos : p.pos+i]
+		t, ok := operators[op]
+		if !ok {
+			return nil, fmt.Errorf("genbank: unknown location operator %q in %q", op, p.s)
+		}
+		p.pos += i + 1
+		l := &Location{Type: t}
+		for {
+			sub, err := p.location()
+			if err != nil {
+				return nil, err
+			}
+			l.Sub = append(l.Sub, sub)
+			if p.pos == len(p.s) {
+				return nil, fmt.Errorf("genbank: unterminated %s in %q", op, p.s)
+			}
+			c := p.s[p.pos]
+			p.pos++
+			if c == ')' {
+				break
+			}
+		}
+		if t == Complement && len(l.Sub) != 1 {
+			return nil, fmt.Errorf("genbank: complement with %d operands in %q", len(l.Sub), p.s)
+		}
+		return l, nil
+	}
+	end := len(p.s)
+	if i >= 0 {
+		end = p.pos + i
+	}
+	l, err := parseRange(p.s[p.pos:end])
+	if err != nil {
+		return nil, err
+	}
+	p.pos = end
+	return l, nil
+}
+
+// parseRange parses a simple, possibly remote, location.
+func parseRange(s string) (*Location, error) {
+	l := &Location{Type: Range}
+	if i := strings.Index(s, ":"); i >= 0 {
+		l.Accession, s = s[:i], s[i+1:]
+		if l.Accession == "" {
+			return nil, fmt.Errorf("genbank: missing accession in location %q", s)
+		}
+	}
+	if i := strings.Index(s, "^"); i >= 0 {
+		// The bases either side of a site are adjacent, or are
+		// the last and first bases of a circular sequence.
+		a, errA := strconv.Atoi(s[:i])
+		b, errB := strconv.Atoi(s[i+1:])
+		if errA != nil || errB != nil || a < 1 || !(b == a+1 || (b == 1 && a > 1)) {
+			return nil, fmt.Errorf("genbank: invalid location %q", s)
+		}
+		l.Type = Between
+		l.Start, l.End = a, a
+		l.Origin = b == 1
+		return l, nil
+	}
+	begin, end := s, ""
+	if i := strings.Index(s, ".."); i >= 0 {
+		begin, end = s[:i], s[i+2:]
+	}
+	var err error
+	l.PartialStart, l.Start, err = position(begin, '<')
+	if err != nil {
+		return nil, fmt.Errorf("genbank: invalid location %q", s)
+	}
+	l.Start--
+	if end == "" {
+		l.End = l.Start + 1
+		return l, nil
+	}
+	l.PartialEnd, l.End, err = position(end, '>')
+	if err != nil || l.End <= l.Start {
+		return nil, fmt.Errorf("genbank: invalid location %q", s)
+	}
+	return l, nil
+}
+
+// position parses a one-based position that may be prefixed by the partial marker.
+func position(s string, partial byte) (bool, int, error) {
+	isPartial := len(s) != 0 && s[0] == partial
+	if isPartial {
+		s = s[1:]
+	}
+	p, err := strconv.Atoi(s)
+	if err == nil && p < 1 {
+		err = errors.New("genbank: invalid position")
+	}
+	return isPartial, p, err
+}
+
+// String returns the INSDC representation of the location.
+func (l *Location) String() string {
+	var buf bytes.Buffer
+	l.format(&buf)
+	return buf.String()
+}
+
+func (l *Location) format(buf *bytes.Buffer) {
+	switch l.Type {
+	case Range, Between:
+		if l.Accession != "" {
+			buf.WriteString(l.Accession)
+			buf.WriteByte(':')
+		}
+		if l.Type == Between {
+			next := l.Start + 1
+			if l.Origin {
+				next = 1
+			}
+			fmt.Fprintf(buf, "%d^%d", l.Start, next)
+			return
+		}
+		if l.PartialStart {
+			buf.WriteByte('<')
+		}
+		fmt.Fprint(buf, l.Start+1)
+		if l.End-l.Start == 1 && !l.PartialEnd {
+			return
+		}
+		buf.WriteString("..")
+		if l.PartialEnd {
+			buf.WriteByte('>')
+		}
+		fmt.Fprint(buf, l.End)
+	default:
+		buf.WriteString(l.Type.String())
+		buf.WriteByte('(')
+		for i, sub := range l.Sub {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			sub.format(buf)
+		}
+		buf.WriteByte(')')
+	}
+}
+
+// Min and Max return the zero-based half-open bounds of the segments of the location that
+// lie on the described sequence. If no segment lies on the sequence both are zero.
+func (l *Location) Min() int {
+	min, _, _ := l.bounds()
+	return min
+}
+
+func (l *Location) Max() int {
+	_, max, _ := l.bounds()
+	return max
+}
+
+func (l *Location) bounds() (min, max int, ok bool) {
+	switch l.Type {
+	case Range, Between:
+		if l.Accession != "" {
+			return 0, 0, false
+		}
+		return l.Start, l.End, true
+	}
+	for _, sub := range l.Sub {
+		smin, smax, sok := sub.bounds()
+		if !sok {
+			continue
+		}
+		if !ok || smin < min {
+			min = smin
+		}
+		if !ok || smax > max {
+			max = smax
+		}
+		ok = true
+	}
+	return min, max, ok
+}
+
+// spansOrigin returns whether l holds a Between location spanning the origin of the
+// described sequence.
+func (l *Location) spansOrigin() bool {
+	switch l.Type {
+	case Range:
+		return false
+	case Between:
+		return l.Origin && l.Accession == ""
+	}
+	for _, sub := range l.Sub {
+		if sub.spansOrigin() {
+			return true
+		}
+	}
+	return false
+}
+
+// Orientation returns feat.Reverse if l is a complement location or a join or order of only
+// complement locations, and feat.Forward otherwise.
+func (l *Location) Orientation() feat.Orientation {
+	switch l.Type {
+	case Complement:
+		if len(l.Sub) == 1 {
+			return -l.Sub[0].Orientation()
+		}
+	case Join, Order:
+		for _, sub := range l.Sub {
+			if sub.Orientation() != feat.Reverse {
+				return feat.Forward
+			}
+		}
+		return feat.Reverse
+	}
+	return feat.Forward
+}
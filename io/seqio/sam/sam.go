@@ -0,0 +1,412 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sam provides types to read and write Sequence Alignment/Map text format
+// files. Alignment records are represented by align.SAMRecord values.
+//
+// The specification can be found at https://samtools.github.io/hts-specs/SAMv1.pdf.
+package sam
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/seqio"
+
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type Error struct{ string }
+
+func (e Error) Error() string { return e.string }
+
+var (
+	ErrBadHeader        = Error{"sam: invalid header line"}
+	ErrBadReference     = Error{"sam: invalid reference sequence line"}
+	ErrFieldMissing     = Error{"sam: missing fields"}
+	ErrBadQuality       = Error{"sam: invalid quality"}
+	ErrBadTag           = Error{"sam: invalid optional field"}
+	ErrUnknownReference = Error{"sam: reference not in header"}
+)
+
+const (
+	nameField = iota
+	flagField
+	refField
+	posField
+	mapQField
+	cigarField
+	mateRefField
+	matePosField
+	tempLenField
+	seqField
+	qualField
+	tagField
+)
+
+// A Field is a header field of the form TG:value.
+type Field struct {
+	Tag, Value string
+}
+
+// Fields is a list of header fields.
+type Fields []Field
+
+// Get returns the value of the first field with the given tag and whether the tag was
+// found.
+func (f Fields) Get(tag string) (string, bool) {
+	for _, tv := range f {
+		if tv.Tag == tag {
+			return tv.Value, true
+		}
+	}
+	return "", false
+}
+
+// A Line is a SAM header line other than an @HD or @SQ line. The text of an @CO line is
+// held as the Value of a single Field with an empty Tag.
+type Line struct {
+	// Type is the record type
+	// of the line without the
+	// leading '@', for example
+	// "RG" or "PG".
+	Type string
+
+	Fields Fields
+}
+
+// A Reference is a reference sequence described by an @SQ header line. A Reference is
+// a feat.Feature so that it may be used as the location of alignments.
+type Reference struct {
+	SeqName string
+	Length  int
+
+	// Other holds the @SQ fields
+	// other than SN and LN.
+	Other Fields
+}
+
+func (r *Reference) Start() int             { return 0 }
+func (r *Reference) End() int               { return r.Length }
+func (r *Reference) Len() int               { return r.Length }
+func (r *Reference) Name() string           { return r.SeqName }
+func (r *Reference) Description() string    { return "sam reference" }
+func (r *Reference) Location() feat.Feature { return nil }
+
+// Header is a SAM header.
+type Header struct {
+	// HD holds the fields of the
+	// @HD line, or nil if there is
+	// no @HD line.
+	HD Fields
+
+	References []*Reference
+
+	// Lines holds the remaining
+	// header lines in the order
+	// they were read.
+	Lines []Line
+}
+
+// NewHeader returns a header with an @HD line and an @SQ line for each of the provided
+// reference sequences. Reference lengths are taken from the end of each reference. It
+// returns ErrBadReference if a reference has no name.
+func NewHeader(refs ...feat.Feature) (*Header, error) {
+	h := &Header{HD: Fields{{Tag: "VN", Value: "1.6"}, {Tag: "SO", Value: "unsorted"}}}
+	for _, r := range refs {
+		if r.Name() == "" {
+			return nil, ErrBadReference
+		}
+		h.References = append(h.References, &Reference{SeqName: r.Name(), Length: r.End()})
+	}
+	return h, nil
+}
+
+// Version returns the format version given by the @HD line, or the empty string if it
+// is not given.
+func (h *Header) Version() string {
+	v, _ := h.HD.Get("VN")
+	return v
+}
+
+// Reference returns the reference sequence with the given name, or nil if it is not
+// described by the header.
+func (h *Header) Reference(name string) *Reference {
+	for _, r := range h.References {
+		if r.SeqName == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// parseLine parses the header line l into h.
+func (h *Header) parseLine(l string) error {
+	if len(l) < 3 {
+		return ErrBadHeader
+	}
+	typ := l[1:3]
+	if typ == "CO" {
+		if len(l) > 3 && l[3] != '\t' {
+			return ErrBadHeader
+		}
+		var text string
+		if len(l) > 4 {
+			text = l[4:]
+		}
+		h.Lines = append(h.Lines, Line{Type: typ, Fields: Fields{{Value: text}}})
+		return nil
+	}
+
+	var fs Fields
+	for _, f := range strings.Split(l, "\t")[1:] {
+		if len(f) < 3 || f[2] != ':' || !isTagName(f[:2]) {
+			return ErrBadHeader
+		}
+		fs = append(fs, Field{Tag: f[:2], Value: f[3:]})
+	}
+	switch typ {
+	case "HD":
+		h.HD = fs
+	case "SQ":
+		r := &Reference{Length: -1}
+		for _, f := range fs {
+			switch f.Tag {
+			case "SN":
+				r.SeqName = f.Value
+			case "LN":
+				var err error
+				r.Length, err = strconv.Atoi(f.Value)
+				if err != nil || r.Length < 0 {
+					return ErrBadReference
+				}
+			default:
+				r.Other = append(r.Other, f)
+			}
+		}
+		if r.SeqName == "" || r.Length < 0 {
+			return ErrBadReference
+		}
+		h.References = append(h.References, r)
+	default:
+		h.Lines = append(h.Lines, Line{Type: typ, Fields: fs})
+	}
+	return nil
+}
+
+// WriteTo writes the header to w in SAM format, returning the number of bytes written and
+// any error. The @HD line is written first, followed by the @SQ lines and then the
+// remaining lines in order.
+func (h *Header) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	writeLine := func(typ string, fs Fields) {
+		buf.WriteByte('@')
+		buf.WriteString(typ)
+		for _, f := range fs {
+			buf.WriteByte('\t')
+			if f.Tag != "" {
+				buf.WriteString(f.Tag)
+				buf.WriteByte(':')
+			}
+			buf.WriteString(f.Value)
+		}
+		buf.WriteByte('\n')
+	}
+	if h.HD != nil {
+		writeLine("HD", h.HD)
+	}
+	for _, r := range h.References {
+		writeLine("SQ", append(Fields{
+			{Tag: "SN", Value: r.SeqName},
+			{Tag: "LN", Value: strconv.Itoa(r.Length)},
+		}, r.Other...))
+	}
+	for _, l := range h.Lines {
+		writeLine(l.Type, l.Fields)
+	}
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+// A Reader reads SAM alignment records.
+type Reader struct {
+	r    *bufio.Reader
+	line int
+
+	// Header is the header of
+	// the SAM stream.
+	Header *Header
+}
+
+// NewReader returns a new SAM format reader that reads from r. The header of the stream is
+// read and is available in the returned Reader's Header field. Gzip and BGZF compressed
+// input is decompressed.
+func NewReader(r io.Reader) (*Reader, error) {
+	r, _, err := seqio.Decompress(r)
+	if err != nil {
+		return nil, err
+	}
+	sr := &Reader{r: bufio.NewReader(r), Header: &Header{}}
+	for {
+		b, err := sr.r.Peek(1)
+		if err == io.EOF || err == nil && b[0] != '@' {
+			return sr, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		l, err := sr.readLine()
+		if err != nil {
+			return nil, err
+		}
+		err = sr.Header.parseLine(l)
+		if err != nil {
+			return nil, &csv.ParseError{Line: sr.line, Err: err}
+		}
+	}
+}
+
+// readLine returns the next line of input without its line ending.
+func (r *Reader) readLine() (string, error) {
+	line, err := r.r.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		if err == io.EOF {
+			return "", err
+		}
+		return "", &csv.ParseError{Line: r.line, Err: err}
+	}
+	r.line++
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// Read reads a single alignment record and returns it or an error. Missing query names,
+// sequences and quality strings are returned as empty values, and mate references equal
+// to the record's reference are returned as the reference name.
+func (r *Reader) Read() (*align.SAMRecord, error) {
+	var line string
+	for line == "" {
+		var err error
+		line, err = r.readLine()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fields := strings.Split(line, "\t")
+	if len(fields) < tagField {
+		return nil, &csv.ParseError{Line: r.line, Column: len(fields), Err: ErrFieldMissing}
+	}
+	rec := &align.SAMRecord{
+		Name:    missing(fields[nameField]),
+		Ref:     fields[refField],
+		MateRef: missing(fields[mateRefField]),
+		Seq:     []byte(missing(fields[seqField])),
+	}
+	if rec.MateRef == "=" {
+		rec.MateRef = rec.Ref
+	}
+	if len(rec.Seq) == 0 {
+		rec.Seq = nil
+	}
+
+	flags, err := strconv.ParseUint(fields[flagField], 10, 16)
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: flagField, Err: err}
+	}
+	rec.Flags = align.SAMFlag(flags)
+	pos, err := strconv.Atoi(fields[posField])
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: posField, Err: err}
+	}
+	rec.Pos = pos - 1
+	mapQ, err := strconv.ParseUint(fields[mapQField], 10, 8)
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: mapQField, Err: err}
+	}
+	rec.MapQ = byte(mapQ)
+	rec.Cigar, err = align.ParseCigar(fields[cigarField])
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: cigarField, Err: err}
+	}
+	matePos, err := strconv.Atoi(fields[matePosField])
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: matePosField, Err: err}
+	}
+	rec.MatePos = matePos - 1
+	rec.TempLen, err = strconv.Atoi(fields[tempLenField])
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: tempLenField, Err: err}
+	}
+	if q := fields[qualField]; q != "*" {
+		if len(q) != len(rec.Seq) {
+			return nil, &csv.ParseError{Line: r.line, Column: qualField, Err: ErrBadQuality}
+		}
+		rec.Qual = make([]byte, len(q))
+		for i := range q {
+			if q[i] < '!' || '~' < q[i] {
+				return nil, &csv.ParseError{Line: r.line, Column: qualField, Err: ErrBadQuality}
+			}
+			rec.Qual[i] = q[i] - 33
+		}
+	}
+	for i, t := range fields[tagField:] {
+		if _, err := ParseTag(t); err != nil {
+			return nil, &csv.ParseError{Line: r.line, Column: tagField + i, Err: err}
+		}
+	}
+	if len(fields) > tagField {
+		rec.Tags = fields[tagField:]
+	}
+
+	return rec, nil
+}
+
+// missing returns s, or the empty string if s is the missing value "*".
+func missing(s string) string {
+	if s == "*" {
+		return ""
+	}
+	return s
+}
+
+// A Writer outputs alignment records in SAM format.
+type Writer struct {
+	w      io.Writer
+	Header *Header
+}
+
+// NewWriter returns a new SAM format writer using w. If h is not nil it is written to w
+// before NewWriter returns.
+func NewWriter(w io.Writer, h *Header) (*Writer, error) {
+	if h != nil {
+		_, err := h.WriteTo(w)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &Writer{w: w, Header: h}, nil
+}
+
+// Write writes a single alignment record and returns the number of bytes written and any
+// error. If the Writer's header describes reference sequences, the references of the
+// record must be among them.
+func (w *Writer) Write(r *align.SAMRecord) (n int, err error) {
+	if r.Qual != nil && len(r.Qual) != len(r.Seq) {
+		return 0, ErrBadQuality
+	}
+	if w.Header != nil && len(w.Header.References) != 0 {
+		for _, ref := range []string{r.Ref, r.MateRef} {
+			if ref != "" && ref != "*" && w.Header.Reference(ref) == nil {
+				return 0, fmt.Errorf("%v: %q", ErrUnknownReference, ref)
+			}
+		}
+	}
+	_n, err := r.WriteTo(w.w)
+	return int(_n), err
+}
@@ -0,0 +1,246 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sam
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const testSAM = `@HD	VN:1.6	SO:coordinate
+@SQ	SN:ref	LN:45	M5:d41d8cd98f00b204e9800998ecf8427e
+@RG	ID:rg1	SM:sample1
+@PG	ID:bwa	PN:bwa	VN:0.7.17
+@CO	aligned with default settings
+r001	99	ref	7	30	8M2I4M1D3M	=	37	39	TTAGATAAAGGATACTG	*	RG:Z:rg1
+r002	0	ref	9	30	3S6M1P1I4M	*	0	0	AAAAGATAAGGATA	*
+r003	0	ref	9	30	5S6M	*	0	0	GCCTAAGCTAA	*	SA:Z:ref,29,-,6H5M,17,0;
+r004	0	ref	16	30	6M14N5M	*	0	0	ATAGCTTCAGC	*
+r003	2064	ref	29	17	6H5M	*	0	0	TAGGC	*	SA:Z:ref,9,+,5S6M,30,1;
+r001	147	ref	37	30	9M	=	7	-39	CAGCGGCAT	+,-./0123	NM:i:1	XA:A:x	XF:f:0.5	XB:B:c,-1,2	XH:H:1AE3
+*	4	*	0	255	*	*	0	0	*	*
+`
+
+func (s *S) TestReadHeader(c *check.C) {
+	r, err := NewReader(strings.NewReader(testSAM))
+	c.Assert(err, check.Equals, nil)
+	h := r.Header
+	c.Check(h.Version(), check.Equals, "1.6")
+	c.Check(h.References, check.DeepEquals, []*Reference{{
+		SeqName: "ref", Length: 45,
+		Other: Fields{{Tag: "M5", Value: "d41d8cd98f00b204e9800998ecf8427e"}},
+	}})
+	c.Check(h.Reference("ref").Name(), check.Equals, "ref")
+	c.Check(h.Reference("chr1"), check.IsNil)
+	c.Check(h.Lines, check.DeepEquals, []Line{
+		{Type: "RG", Fields: Fields{{Tag: "ID", Value: "rg1"}, {Tag: "SM", Value: "sample1"}}},
+		{Type: "PG", Fields: Fields{{Tag: "ID", Value: "bwa"}, {Tag: "PN", Value: "bwa"}, {Tag: "VN", Value: "0.7.17"}}},
+		{Type: "CO", Fields: Fields{{Value: "aligned with default settings"}}},
+	})
+
+	for i, t := range []struct {
+		sam string
+		err error
+	}{
+		{sam: "@HD\tVN1.6\n", err: ErrBadHeader},
+		{sam: "@SQ\tSN:ref\n", err: ErrBadReference},
+		{sam: "@SQ\tSN:ref\tLN:x\n", err: ErrBadReference},
+		{sam: "@COx\n", err: ErrBadHeader},
+	} {
+		_, err := NewReader(strings.NewReader(t.sam))
+		perr, ok := err.(*csv.ParseError)
+		c.Assert(ok, check.Equals, true, check.Commentf("Test %d: %v", i, err))
+		c.Check(perr.Line, check.Equals, 1, check.Commentf("Test %d", i))
+		c.Check(perr.Err, check.Equals, t.err, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestRead(c *check.C) {
+	r, err := NewReader(strings.NewReader(testSAM))
+	c.Assert(err, check.Equals, nil)
+	var recs []*align.SAMRecord
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		recs = append(recs, rec)
+	}
+	c.Assert(len(recs), check.Equals, 7)
+
+	rec := recs[0]
+	c.Check(rec.Name, check.Equals, "r001")
+	c.Check(rec.Flags, check.Equals, align.SAMPaired|align.SAMProperPair|align.SAMMateReverse|align.SAMRead1)
+	c.Check(rec.Pos, check.Equals, 6)
+	c.Check(rec.MapQ, check.Equals, byte(30))
+	c.Check(rec.Cigar.String(), check.Equals, "8M2I4M1D3M")
+	c.Check(rec.MateRef, check.Equals, "ref")
+	c.Check(rec.MatePos, check.Equals, 36)
+	c.Check(rec.TempLen, check.Equals, 39)
+	c.Check(string(rec.Seq), check.Equals, "TTAGATAAAGGATACTG")
+	c.Check(rec.Qual, check.IsNil)
+	c.Check(rec.Tags, check.DeepEquals, []string{"RG:Z:rg1"})
+
+	c.Check(recs[1].MateRef, check.Equals, "")
+	c.Check(recs[1].MatePos, check.Equals, -1)
+	c.Check(recs[1].Tags, check.IsNil)
+
+	rec = recs[5]
+	c.Check(rec.TempLen, check.Equals, -39)
+	c.Check(rec.Qual, check.DeepEquals, []byte{10, 11, 12, 13, 14, 15, 16, 17, 18})
+	tags, err := Tags(rec)
+	c.Check(err, check.Equals, nil)
+	c.Check(tags, check.DeepEquals, []Tag{
+		{Name: "NM", Type: 'i', Value: 1},
+		{Name: "XA", Type: 'A', Value: byte('x')},
+		{Name: "XF", Type: 'f', Value: 0.5},
+		{Name: "XB", Type: 'B', Value: []int8{-1, 2}},
+		{Name: "XH", Type: 'H', Value: []byte{0x1a, 0xe3}},
+	})
+
+	rec = recs[6]
+	c.Check(rec.Name, check.Equals, "")
+	c.Check(rec.Flags&align.SAMUnmapped, check.Equals, align.SAMUnmapped)
+	c.Check(rec.Ref, check.Equals, "*")
+	c.Check(rec.Pos, check.Equals, -1)
+	c.Check(rec.Cigar, check.IsNil)
+	c.Check(rec.Seq, check.IsNil)
+
+	for i, t := range []struct {
+		line string
+		col  int
+		err  error
+	}{
+		{line: "r\t0\tref\t1\t30\t1M\t*\t0\t0\tA\n", col: 10, err: ErrFieldMissing},
+		{line: "r\t0\tref\t1\t30\t1M\t*\t0\t0\tA\tII\n", col: qualField, err: ErrBadQuality},
+		{line: "r\t0\tref\t1\t30\t2M\t*\t0\t0\tAC\tI \n", col: qualField, err: ErrBadQuality},
+		{line: "r\t0\tref\t1\t30\t2M\t*\t0\t0\tAC\t\x7fI\n", col: qualField, err: ErrBadQuality},
+		{line: "r\t0\tref\t1\t30\t1M\t*\t0\t0\tA\t*\tNM:i:x\n", col: tagField, err: ErrBadTag},
+	} {
+		r, err := NewReader(strings.NewReader(t.line))
+		c.Assert(err, check.Equals, nil)
+		_, err = r.Read()
+		perr, ok := err.(*csv.ParseError)
+		c.Assert(ok, check.Equals, true, check.Commentf("Test %d: %v", i, err))
+		c.Check(perr.Line, check.Equals, 1, check.Commentf("Test %d", i))
+		c.Check(perr.Column, check.Equals, t.col, check.Commentf("Test %d", i))
+		c.Check(perr.Err, check.Equals, t.err, check.Commentf("Test %d", i))
+	}
+	r, err = NewReader(strings.NewReader("r\t0\tref\t1\t30\t1Q\t*\t0\t0\tA\t*\n"))
+	c.Assert(err, check.Equals, nil)
+	_, err = r.Read()
+	perr, ok := err.(*csv.ParseError)
+	c.Assert(ok, check.Equals, true)
+	c.Check(perr.Column, check.Equals, cigarField)
+	c.Check(perr.Err, check.ErrorMatches, `align: invalid cigar operation 'Q' at position 1`)
+}
+
+func (s *S) TestTags(c *check.C) {
+	for _, t := range []string{
+		"AS:i:-19",
+		"XA:A:!",
+		"XF:f:1e-05",
+		"RG:Z:group one",
+		"XH:H:00FF",
+		"Xc:B:C,0,255",
+		"Xs:B:s,-32768,32767",
+		"XS:B:S,65535",
+		"Xi:B:i,-2147483648",
+		"XI:B:I,4294967295",
+		"Xf:B:f,0.25,-1",
+		"XE:B:c",
+	} {
+		tag, err := ParseTag(t)
+		c.Check(err, check.Equals, nil, check.Commentf("%q", t))
+		c.Check(tag.String(), check.Equals, t)
+	}
+	for _, t := range []string{"AS:i:", "1S:i:1", "AS:q:1", "XA:A:ab", "XH:H:0", "Xc:B:C,256", "Xc:B:q,1", "AS-i:1"} {
+		_, err := ParseTag(t)
+		c.Check(err, check.Equals, ErrBadTag, check.Commentf("%q", t))
+	}
+
+	rec := &align.SAMRecord{Tags: []string{"NM:i:1", "AS:i:10"}}
+	tag, ok, err := LookupTag(rec, "AS")
+	c.Check(err, check.Equals, nil)
+	c.Check(ok, check.Equals, true)
+	c.Check(tag.Value, check.Equals, 10)
+	_, ok, err = LookupTag(rec, "A")
+	c.Check(err, check.Equals, nil)
+	c.Check(ok, check.Equals, false)
+	SetTag(rec, Tag{Name: "AS", Type: 'i', Value: 12})
+	SetTag(rec, Tag{Name: "XZ", Type: 'Z', Value: "x"})
+	c.Check(rec.Tags, check.DeepEquals, []string{"NM:i:1", "AS:i:12", "XZ:Z:x"})
+}
+
+func (s *S) TestWrite(c *check.C) {
+	r, err := NewReader(strings.NewReader(testSAM))
+	c.Assert(err, check.Equals, nil)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, r.Header)
+	c.Assert(err, check.Equals, nil)
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		_, err = w.Write(rec)
+		c.Check(err, check.Equals, nil)
+	}
+	c.Check(buf.String(), check.Equals, testSAM)
+
+	_, err = w.Write(&align.SAMRecord{Ref: "chr1"})
+	c.Check(err, check.ErrorMatches, `sam: reference not in header: "chr1"`)
+	_, err = w.Write(&align.SAMRecord{Ref: "ref", Seq: []byte("AC"), Qual: []byte{30}})
+	c.Check(err, check.Equals, ErrBadQuality)
+
+	// Records produced by the align package can be read back.
+	ref := linear.NewSeq("chr1", alphabet.BytesToLetters([]byte("AAAGGGACGTACGTACCCTTT")), alphabet.DNAgapped)
+	read := linear.NewSeq("read1", alphabet.BytesToLetters([]byte("ACGTACGTAC")), alphabet.DNAgapped)
+	aln, err := align.SW{
+		{0, -1, -1, -1, -1},
+		{-1, 2, -1, -1, -1},
+		{-1, -1, 2, -1, -1},
+		{-1, -1, -1, 2, -1},
+		{-1, -1, -1, -1, 2},
+	}.Align(ref, read)
+	c.Assert(err, check.Equals, nil)
+	rec, err := align.NewSAMRecord(ref, read, aln)
+	c.Assert(err, check.Equals, nil)
+	buf.Reset()
+	h, err := NewHeader(ref)
+	c.Assert(err, check.Equals, nil)
+	w, err = NewWriter(&buf, h)
+	c.Assert(err, check.Equals, nil)
+	_, err = w.Write(rec)
+	c.Assert(err, check.Equals, nil)
+	c.Check(buf.String(), check.Equals, "@HD\tVN:1.6\tSO:unsorted\n@SQ\tSN:chr1\tLN:21\nread1\t0\tchr1\t7\t255\t10M\t*\t0\t0\tACGTACGTAC\t*\tAS:i:20\n")
+	r, err = NewReader(&buf)
+	c.Assert(err, check.Equals, nil)
+	c.Check(r.Header.Reference("chr1").Len(), check.Equals, 21)
+	got, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(got, check.DeepEquals, rec)
+
+	_, err = NewHeader(linear.NewSeq("", nil, alphabet.DNA))
+	c.Check(err, check.Equals, ErrBadReference)
+}
@@ -0,0 +1,246 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sam
+
+import (
+	"github.com/biogo/biogo/align"
+
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A Tag is a typed SAM optional field.
+//
+// The Go type of Value depends on Type:
+//
+//	'A': byte
+//	'i': int
+//	'f': float64
+//	'Z': string
+//	'H': []byte, holding the decoded bytes
+//	'B': []int8, []uint8, []int16, []uint16, []int32, []uint32 or []float32
+type Tag struct {
+	Name  string
+	Type  byte
+	Value interface{}
+}
+
+// ParseTag parses a SAM optional field of the form TAG:TYPE:VALUE.
+func ParseTag(s string) (Tag, error) {
+	if len(s) < 5 || s[2] != ':' || s[4] != ':' || !isTagName(s[:2]) {
+		return Tag{}, ErrBadTag
+	}
+	t := Tag{Name: s[:2], Type: s[3]}
+	v := s[5:]
+	var err error
+	switch t.Type {
+	case 'A':
+		if len(v) != 1 || v[0] < '!' || v[0] > '~' {
+			return Tag{}, ErrBadTag
+		}
+		t.Value = v[0]
+	case 'i':
+		t.Value, err = strconv.Atoi(v)
+	case 'f':
+		t.Value, err = strconv.ParseFloat(v, 32)
+	case 'Z':
+		t.Value = v
+	case 'H':
+		t.Value, err = hex.DecodeString(v)
+	case 'B':
+		t.Value, err = parseArray(v)
+	default:
+		return Tag{}, ErrBadTag
+	}
+	if err != nil {
+		return Tag{}, ErrBadTag
+	}
+	return t, nil
+}
+
+func isTagName(s string) bool {
+	isAlpha := func(b byte) bool { return 'A' <= b && b <= 'Z' || 'a' <= b && b <= 'z' }
+	return isAlpha(s[0]) && (isAlpha(s[1]) || '0' <= s[1] && s[1] <= '9')
+}
+
+// parseArray parses the value of a B type optional field.
+func parseArray(s string) (interface{}, error) {
+	f := strings.Split(s, ",")
+	if len(f[0]) != 1 {
+		return nil, ErrBadTag
+	}
+	sub, f := f[0][0], f[1:]
+	switch sub {
+	case 'f':
+		a := make([]float32, len(f))
+		for i, v := range f {
+			n, err := strconv.ParseFloat(v, 32)
+			if err != nil {
+				return nil, err
+			}
+			a[i] = float32(n)
+		}
+		return a, nil
+	case 'c', 's', 'i':
+		bits := map[byte]int{'c': 8, 's': 16, 'i': 32}[sub]
+		n := make([]int64, len(f))
+		for i, v := range f {
+			var err error
+			n[i], err = strconv.ParseInt(v, 10, bits)
+			if err != nil {
+				return nil, err
+			}
+		}
+		switch sub {
+		case 'c':
+			a := make([]int8, len(n))
+			for i, v := range n {
+				a[i] = int8(v)
+			}
+			return a, nil
+		case 's':
+			a := make([]int16, len(n))
+			for i, v := range n {
+				a[i] = int16(v)
+			}
+			return a, nil
+		default:
+			a := make([]int32, len(n))
+			for i, v := range n {
+				a[i] = int32(v)
+			}
+			return a, nil
+		}
+	case 'C', 'S', 'I':
+		bits := map[byte]int{'C': 8, 'S': 16, 'I': 32}[sub]
+		n := make([]uint64, len(f))
+		for i, v := range f {
+			var err error
+			n[i], err = strconv.ParseUint(v, 10, bits)
+			if err != nil {
+				return nil, err
+			}
+		}
+		switch sub {
+		case 'C':
+			a := make([]uint8, len(n))
+			for i, v := range n {
+				a[i] = uint8(v)
+			}
+			return a, nil
+		case 'S':
+			a := make([]uint16, len(n))
+			for i, v := range n {
+				a[i] = uint16(v)
+			}
+			return a, nil
+		default:
+			a := make([]uint32, len(n))
+			for i, v := range n {
+				a[i] = uint32(v)
+			}
+			return a, nil
+		}
+	}
+	return nil, ErrBadTag
+}
+
+// String returns the SAM text representation of the tag.
+func (t Tag) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s:%c:", t.Name, t.Type)
+	switch v := t.Value.(type) {
+	case byte:
+		if t.Type == 'A' {
+			buf.WriteByte(v)
+			break
+		}
+		fmt.Fprint(&buf, v)
+	case float64:
+		buf.WriteString(strconv.FormatFloat(v, 'g', -1, 32))
+	case []byte:
+		if t.Type == 'H' {
+			fmt.Fprintf(&buf, "%X", v)
+			break
+		}
+		buf.WriteByte('C')
+		for _, e := range v {
+			fmt.Fprintf(&buf, ",%d", e)
+		}
+	case []int8:
+		buf.WriteByte('c')
+		for _, e := range v {
+			fmt.Fprintf(&buf, ",%d", e)
+		}
+	case []int16:
+		buf.WriteByte('s')
+		for _, e := range v {
+			fmt.Fprintf(&buf, ",%d", e)
+		}
+	case []uint16:
+		buf.WriteByte('S')
+		for _, e := range v {
+			fmt.Fprintf(&buf, ",%d", e)
+		}
+	case []int32:
+		buf.WriteByte('i')
+		for _, e := range v {
+			fmt.Fprintf(&buf, ",%d", e)
+		}
+	case []uint32:
+		buf.WriteByte('I')
+		for _, e := range v {
+			fmt.Fprintf(&buf, ",%d", e)
+		}
+	case []float32:
+		buf.WriteByte('f')
+		for _, e := range v {
+			fmt.Fprintf(&buf, ",%s", strconv.FormatFloat(float64(e), 'g', -1, 32))
+		}
+	default:
+		fmt.Fprint(&buf, v)
+	}
+	return buf.String()
+}
+
+// Tags returns the parsed optional fields of r.
+func Tags(r *align.SAMRecord) ([]Tag, error) {
+	tags := make([]Tag, len(r.Tags))
+	for i, s := range r.Tags {
+		t, err := ParseTag(s)
+		if err != nil {
+			return nil, err
+		}
+		tags[i] = t
+	}
+	return tags, nil
+}
+
+// LookupTag returns the parsed optional field of r with the given name and whether it was
+// found.
+func LookupTag(r *align.SAMRecord, name string) (Tag, bool, error) {
+	for _, s := range r.Tags {
+		if strings.HasPrefix(s, name) && len(s) > len(name) && s[len(name)] == ':' {
+			t, err := ParseTag(s)
+			return t, err == nil, err
+		}
+	}
+	return Tag{}, false, nil
+}
+
+// SetTag sets the optional field t in r, replacing any existing field with the same name.
+func SetTag(r *align.SAMRecord, t Tag) {
+	s := t.String()
+	for i, o := range r.Tags {
+		if strings.HasPrefix(o, t.Name) && len(o) > len(t.Name) && o[len(t.Name)] == ':' {
+			r.Tags[i] = s
+			return
+		}
+	}
+	r.Tags = append(r.Tags, s)
+}
@@ -13,6 +13,12 @@ import (
 	"github.com/biogo/biogo/io/seqio/fastq"
 	"github.com/biogo/biogo/seq/linear"
 
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"gopkg.in/check.v1"
@@ -446,3 +452,116 @@ func (s *S) TestReadFastq(c *check.C) {
 	c.Check(obtainNfq, check.DeepEquals, expectNfq)
 	c.Check(obtainQL, check.DeepEquals, expectQL)
 }
+
+func (s *S) TestCompression(c *check.C) {
+	// Large enough to span several BGZF blocks.
+	big := strings.Repeat(testaln0, 100)
+
+	for _, comp := range []seqio.Compression{seqio.Uncompressed, seqio.Gzip, seqio.BGZF} {
+		for _, in := range []string{"", testaln0, big} {
+			var buf bytes.Buffer
+			w, err := seqio.NewCompressor(&buf, comp)
+			c.Assert(err, check.Equals, nil)
+			_, err = io.WriteString(w, in)
+			c.Assert(err, check.Equals, nil)
+			c.Assert(w.Close(), check.Equals, nil)
+
+			if comp == seqio.BGZF {
+				c.Check(bytes.HasSuffix(buf.Bytes(), bgzfEOF), check.Equals, true)
+				checkBGZFBlocks(c, buf.Bytes())
+			}
+			if comp != seqio.Uncompressed {
+				gz, err := gzip.NewReader(bytes.NewReader(buf.Bytes()))
+				c.Assert(err, check.Equals, nil)
+				b, err := ioutil.ReadAll(gz)
+				c.Assert(err, check.Equals, nil)
+				c.Check(string(b) == in, check.Equals, true)
+			}
+
+			r, got, err := seqio.Decompress(bytes.NewReader(buf.Bytes()))
+			c.Assert(err, check.Equals, nil)
+			c.Check(got, check.Equals, comp)
+			b, err := ioutil.ReadAll(r)
+			c.Assert(err, check.Equals, nil)
+			c.Check(string(b) == in, check.Equals, true)
+		}
+
+		var buf bytes.Buffer
+		w, err := seqio.NewCompressor(&buf, comp)
+		c.Assert(err, check.Equals, nil)
+		fw := fasta.NewWriter(w, 60)
+		for i := range expectNfa {
+			_, err := fw.Write(linear.NewSeq(expectNfa[i], expectSfa[i], alphabet.Protein))
+			c.Assert(err, check.Equals, nil)
+		}
+		c.Assert(w.Close(), check.Equals, nil)
+
+		sc := seqio.NewScanner(fasta.NewReader(&buf, linear.NewSeq("", nil, alphabet.Protein)))
+		var n int
+		for sc.Next() {
+			c.Check(sc.Seq().(*linear.Seq).Seq, check.DeepEquals, alphabet.Letters(expectSfa[n]))
+			n++
+		}
+		c.Check(sc.Error(), check.Equals, nil)
+		c.Check(n, check.Equals, len(expectSfa))
+	}
+
+	_, err := seqio.NewCompressor(ioutil.Discard, seqio.Compression(-1))
+	c.Check(err, check.ErrorMatches, "seqio: unknown compression")
+
+	var cr countReader
+	fasta.NewReader(&cr, linear.NewSeq("", nil, alphabet.DNA))
+	c.Check(cr.n, check.Equals, 0)
+	dr := seqio.NewDecompressor(&cr)
+	c.Check(cr.n, check.Equals, 0)
+	_, err = dr.Read(make([]byte, 1))
+	c.Check(err, check.Equals, io.EOF)
+	c.Check(cr.n > 0, check.Equals, true)
+
+	wErr := errors.New("write failed")
+	w, err := seqio.NewCompressor(failWriter{wErr}, seqio.BGZF)
+	c.Assert(err, check.Equals, nil)
+	_, err = w.Write(make([]byte, 1<<17))
+	c.Check(err, check.Equals, wErr)
+	c.Check(w.Close(), check.Equals, wErr)
+
+	r, comp, err := seqio.Decompress(bytes.NewReader([]byte{0x1f, 0x8b, 0x00, 0x00}))
+	c.Check(comp, check.Equals, seqio.Gzip)
+	c.Check(err, check.NotNil)
+	_, rerr := r.Read(make([]byte, 1))
+	c.Check(rerr, check.Equals, err)
+	sc := seqio.NewScanner(fasta.NewReader(bytes.NewReader([]byte{0x1f, 0x8b, 0x00, 0x00}), linear.NewSeq("", nil, alphabet.Protein)))
+	c.Check(sc.Next(), check.Equals, false)
+	c.Check(sc.Error(), check.Equals, err)
+}
+
+// countReader is an empty reader that counts calls to Read.
+type countReader struct{ n int }
+
+func (r *countReader) Read([]byte) (int, error) {
+	r.n++
+	return 0, io.EOF
+}
+
+type failWriter struct{ err error }
+
+func (w failWriter) Write([]byte) (int, error) { return 0, w.err }
+
+var bgzfEOF = []byte{
+	0x1f, 0x8b, 0x08, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0xff, 0x06, 0x00, 0x42, 0x43, 0x02, 0x00,
+	0x1b, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00,
+}
+
+// checkBGZFBlocks checks that b is a sequence of valid BGZF blocks.
+func checkBGZFBlocks(c *check.C, b []byte) {
+	for len(b) > 0 {
+		c.Assert(len(b) >= 18, check.Equals, true)
+		c.Assert(b[12] == 'B' && b[13] == 'C', check.Equals, true)
+		size := int(binary.LittleEndian.Uint16(b[16:])) + 1
+		c.Assert(size <= len(b), check.Equals, true)
+		c.Check(binary.LittleEndian.Uint32(b[size-4:]) <= 0x10000, check.Equals, true)
+		b = b[size:]
+	}
+}
@@ -0,0 +1,499 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package twobit provides types to read and write UCSC 2bit format files.
+//
+// The 2bit format stores nucleotide sequences packed four bases to a byte, with runs of
+// unknown bases and soft-masked regions held as blocks. The specification can be found at
+// https://genome.ucsc.edu/FAQ/FAQformat.html#format7.
+package twobit
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+var (
+	_ seqio.Reader = (*Reader)(nil)
+	_ seqio.Writer = (*Writer)(nil)
+)
+
+var (
+	ErrBadSignature = errors.New("twobit: invalid signature")
+	ErrBadVersion   = errors.New("twobit: unsupported version")
+	ErrBadName      = errors.New("twobit: invalid sequence name")
+	ErrTooLarge     = errors.New("twobit: data too large for 2bit format")
+	ErrClosed       = errors.New("twobit: write to closed writer")
+)
+
+// signature is the 2bit file signature.
+const signature = 0x1a412743
+
+// bases holds the letters encoded by each 2-bit value.
+const bases = "TCAG"
+
+// baseCode maps letters to their 2-bit value and reports whether the letter is
+// a valid base.
+var baseCode = func() [256]int8 {
+	var t [256]int8
+	for i := range t {
+		t[i] = -1
+	}
+	for i, b := range []byte(bases) {
+		t[b] = int8(i)
+		t[b|0x20] = int8(i)
+	}
+	t['U'], t['u'] = t['T'], t['T']
+	return t
+}()
+
+// A block is a zero-based half-open interval of a sequence.
+type block struct {
+	start, end int
+}
+
+// record holds the description of a sequence in a 2bit file.
+type record struct {
+	length     int
+	nBlocks    []block
+	maskBlocks []block
+
+	// dna is the file offset
+	// of the packed bases.
+	dna int64
+}
+
+// Reader provides sequential and random access to the sequences of a 2bit file.
+type Reader struct {
+	r     io.ReaderAt
+	order binary.ByteOrder
+	t     seqio.SequenceAppender
+
+	names   []string
+	offsets map[string]int64
+	records map[string]*record
+	next    int
+
+	// IgnoreMask specifies that
+	// soft-masked bases are returned
+	// in upper case. By default they
+	// are returned in lower case.
+	IgnoreMask bool
+}
+
+// NewReader returns a new 2bit format reader reading from r. The file header and sequence
+// index are read before NewReader returns. Sequences returned by the Reader are copied
+// from the provided template.
+func NewReader(r io.ReaderAt, template seqio.SequenceAppender) (*Reader, error) {
+	var h [16]byte
+	_, err := r.ReadAt(h[:], 0)
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	var order binary.ByteOrder
+	switch {
+	case binary.LittleEndian.Uint32(h[:]) == signature:
+		order = binary.LittleEndian
+	case binary.BigEndian.Uint32(h[:]) == signature:
+		order = binary.BigEndian
+	default:
+		return nil, ErrBadSignature
+	}
+	version := order.Uint32(h[4:])
+	if version > 1 {
+		return nil, ErrBadVersion
+	}
+	n := int(order.Uint32(h[8:]))
+
+	// Check the count before allocating
+	// the index so that a corrupt header
+	// cannot exhaust memory.
+	err = available(r, 16, int64(n)*minIndexEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	tr := &Reader{
+		r:       r,
+		order:   order,
+		t:       template,
+		names:   make([]string, n),
+		offsets: make(map[string]int64, n),
+		records: make(map[string]*record),
+	}
+	sr := io.NewSectionReader(r, 16, math.MaxInt64-16)
+	for i := range tr.names {
+		var l [1]byte
+		_, err = io.ReadFull(sr, l[:])
+		if err != nil {
+			return nil, unexpected(err)
+		}
+		name := make([]byte, l[0])
+		_, err = io.ReadFull(sr, name)
+		if err != nil {
+			return nil, unexpected(err)
+		}
+		var off int64
+		if version == 0 {
+			var o uint32
+			err = binary.Read(sr, order, &o)
+			off = int64(o)
+		} else {
+			var o uint64
+			err = binary.Read(sr, order, &o)
+			off = int64(o)
+		}
+		if err != nil {
+			return nil, unexpected(err)
+		}
+		tr.names[i] = string(name)
+		tr.offsets[string(name)] = off
+	}
+	return tr, nil
+}
+
+// minIndexEntry is the smallest possible size of a sequence
+// index entry: a name length, an empty name and an offset.
+const minIndexEntry = 1 + 4
+
+// available returns io.ErrUnexpectedEOF if the n bytes starting at off are not all
+// present in r. It is used to check counts read from the file before allocating space
+// for the data they describe.
+func available(r io.ReaderAt, off, n int64) error {
+	if n == 0 {
+		return nil
+	}
+	var b [1]byte
+	c, err := r.ReadAt(b[:], off+n-1)
+	if c == 1 {
+		return nil
+	}
+	if err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return unexpected(err)
+}
+
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// Names returns the names of the sequences in the file in index order.
+func (r *Reader) Names() []string { return append([]string(nil), r.names...) }
+
+// Len returns the length of the sequence named id.
+func (r *Reader) Len(id string) (int, error) {
+	rec, err := r.record(id)
+	if err != nil {
+		return 0, err
+	}
+	return rec.length, nil
+}
+
+// record returns the description of the sequence named id, reading it from the file if
+// it has not already been read.
+func (r *Reader) record(id string) (*record, error) {
+	if rec, ok := r.records[id]; ok {
+		return rec, nil
+	}
+	off, ok := r.offsets[id]
+	if !ok {
+		return nil, fmt.Errorf("twobit: no sequence %q in file", id)
+	}
+	sr := io.NewSectionReader(r.r, off, math.MaxInt64-off)
+	var length uint32
+	err := binary.Read(sr, r.order, &length)
+	if err != nil {
+		return nil, unexpected(err)
+	}
+	rec := &record{length: int(length)}
+	rec.nBlocks, err = r.readBlocks(off+4, rec.length)
+	if err != nil {
+		return nil, err
+	}
+	rec.maskBlocks, err = r.readBlocks(off+8+8*int64(len(rec.nBlocks)), rec.length)
+	if err != nil {
+		return nil, err
+	}
+
+	// The packed bases follow the length, the two
+	// block lists and a reserved field.
+	rec.dna = off + 16 + 8*int64(len(rec.nBlocks)+len(rec.maskBlocks))
+	err = available(r.r, rec.dna-4, 4+int64(rec.length+3)/4)
+	if err != nil {
+		return nil, err
+	}
+	r.records[id] = rec
+	return rec, nil
+}
+
+// readBlocks reads a block count followed by the block starts and sizes, starting at off.
+func (r *Reader) readBlocks(off int64, length int) ([]block, error) {
+	sr := io.NewSectionReader(r.r, off, math.MaxInt64-off)
+	var n uint32
+	err := binary.Read(sr, r.order, &n)
+	if err != nil {
+		return nil, unexpected(err)
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	err = available(r.r, off+4, 8*int64(n))
+	if err != nil {
+		return nil, err
+	}
+	starts := make([]uint32, n)
+	sizes := make([]uint32, n)
+	err = binary.Read(sr, r.order, starts)
+	if err != nil {
+		return nil, unexpected(err)
+	}
+	err = binary.Read(sr, r.order, sizes)
+	if err != nil {
+		return nil, unexpected(err)
+	}
+	b := make([]block, n)
+	for i := range b {
+		b[i] = block{start: int(starts[i]), end: int(starts[i]) + int(sizes[i])}
+		if b[i].end > length || i > 0 && b[i].start < b[i-1].end {
+			return nil, errors.New("twobit: invalid block")
+		}
+	}
+	return b, nil
+}
+
+// Read reads the next sequence in index order and returns it and any error. At the end of
+// the index Read returns io.EOF.
+func (r *Reader) Read() (seq.Sequence, error) {
+	if r.next >= len(r.names) {
+		return nil, io.EOF
+	}
+	s, err := r.Seq(r.names[r.next])
+	if err != nil {
+		return nil, err
+	}
+	r.next++
+	return s, nil
+}
+
+// Seq returns the complete sequence named id. It returns an error if id is not in the file.
+func (r *Reader) Seq(id string) (seq.Sequence, error) {
+	rec, err := r.record(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.Fetch(id, 0, rec.length)
+}
+
+// Fetch returns the subsequence of the sequence named id from start to end, in zero-based
+// half-open coordinates. Only the bytes holding the subsequence are read. Unknown bases are
+// returned as 'N' and soft-masked bases are returned in lower case unless IgnoreMask is
+// set. The offset of the returned sequence is set to start. It returns an error if id is
+// not in the file or the range is not within the sequence.
+func (r *Reader) Fetch(id string, start, end int) (seq.Sequence, error) {
+	rec, err := r.record(id)
+	if err != nil {
+		return nil, err
+	}
+	if start < 0 || end < start || end > rec.length {
+		return nil, fmt.Errorf("twobit: range %d..%d out of range for %q of length %d", start, end, id, rec.length)
+	}
+
+	s := r.t.Clone().(seqio.SequenceAppender)
+	err = s.SetName(id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.SetOffset(start)
+	if err != nil {
+		return nil, err
+	}
+	if start == end {
+		return s, nil
+	}
+
+	first := start / 4
+	packed := make([]byte, (end+3)/4-first)
+	n, err := r.r.ReadAt(packed, rec.dna+int64(first))
+	if n != len(packed) {
+		if err == nil || err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	l := make([]alphabet.Letter, end-start)
+	for i := range l {
+		p := start + i
+		l[i] = alphabet.Letter(bases[packed[p/4-first]>>uint(6-2*(p%4))&0x3])
+	}
+	for _, b := range overlapping(rec.nBlocks, start, end) {
+		for p := max(b.start, start); p < min(b.end, end); p++ {
+			l[p-start] = 'N'
+		}
+	}
+	if !r.IgnoreMask {
+		for _, b := range overlapping(rec.maskBlocks, start, end) {
+			for p := max(b.start, start); p < min(b.end, end); p++ {
+				l[p-start] |= 0x20
+			}
+		}
+	}
+	err = s.AppendLetters(l...)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// overlapping returns the blocks of the sorted blocks b that overlap start to end.
+func overlapping(b []block, start, end int) []block {
+	i := sort.Search(len(b), func(i int) bool { return b[i].end > start })
+	j := i + sort.Search(len(b)-i, func(j int) bool { return b[i+j].start >= end })
+	return b[i:j]
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Writer writes sequences in 2bit format. Since the 2bit index precedes the sequence data,
+// sequences are held by the Writer until Close is called.
+type Writer struct {
+	w       io.Writer
+	names   []string
+	records [][]byte
+	closed  bool
+}
+
+// NewWriter returns a new 2bit format writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write encodes a single sequence and returns the number of bytes it will occupy in the
+// output and any error. Letters other than A, C, G, T and U are written as unknown bases,
+// and lower case letters are written as soft-masked. The sequence data is not written to
+// the underlying io.Writer until Close is called.
+func (w *Writer) Write(s seq.Sequence) (int, error) {
+	if w.closed {
+		return 0, ErrClosed
+	}
+	name := s.Name()
+	if name == "" || len(name) > math.MaxUint8 {
+		return 0, ErrBadName
+	}
+	if uint64(s.Len()) > math.MaxUint32 {
+		return 0, ErrTooLarge
+	}
+
+	var (
+		nBlocks, maskBlocks []block
+		packed              = make([]byte, (s.Len()+3)/4)
+	)
+	addBlock := func(b []block, p int) []block {
+		if len(b) != 0 && b[len(b)-1].end == p {
+			b[len(b)-1].end++
+			return b
+		}
+		return append(b, block{start: p, end: p + 1})
+	}
+	for i := 0; i < s.Len(); i++ {
+		l := byte(s.At(s.Start() + i).L)
+		c := baseCode[l]
+		if c < 0 {
+			nBlocks = addBlock(nBlocks, i)
+			c = 0
+		}
+		if 'a' <= l && l <= 'z' {
+			maskBlocks = addBlock(maskBlocks, i)
+		}
+		packed[i/4] |= byte(c) << uint(6-2*(i%4))
+	}
+
+	var buf bytes.Buffer
+	le := binary.LittleEndian
+	binary.Write(&buf, le, uint32(s.Len()))
+	writeBlocks(&buf, nBlocks)
+	writeBlocks(&buf, maskBlocks)
+	binary.Write(&buf, le, uint32(0))
+	buf.Write(packed)
+
+	w.names = append(w.names, name)
+	w.records = append(w.records, buf.Bytes())
+	return buf.Len(), nil
+}
+
+// writeBlocks writes the block count, starts and sizes of b to buf.
+func writeBlocks(buf *bytes.Buffer, b []block) {
+	le := binary.LittleEndian
+	binary.Write(buf, le, uint32(len(b)))
+	for _, bl := range b {
+		binary.Write(buf, le, uint32(bl.start))
+	}
+	for _, bl := range b {
+		binary.Write(buf, le, uint32(bl.end-bl.start))
+	}
+}
+
+// Close writes the 2bit header, index and the sequences written to the Writer to the
+// underlying io.Writer. Close does not close the underlying io.Writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+
+	le := binary.LittleEndian
+	var buf bytes.Buffer
+	binary.Write(&buf, le, [4]uint32{signature, 0, uint32(len(w.names)), 0})
+	off := int64(16)
+	for _, n := range w.names {
+		off += int64(1 + len(n) + 4)
+	}
+	for i, n := range w.names {
+		if off > math.MaxUint32 {
+			return ErrTooLarge
+		}
+		buf.WriteByte(byte(len(n)))
+		buf.WriteString(n)
+		binary.Write(&buf, le, uint32(off))
+		off += int64(len(w.records[i]))
+	}
+	_, err := w.w.Write(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	for _, rec := range w.records {
+		_, err = w.w.Write(rec)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
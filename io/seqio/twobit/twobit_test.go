@@ -0,0 +1,177 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package twobit
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+var testSeqs = []struct {
+	name string
+	seq  string
+}{
+	{name: "chr1", seq: "ACGTNNNNacgtACGTTTGCAnnnGCa"},
+	{name: "chr2", seq: "GATTACA"},
+	{name: "chrM", seq: "NNNN"},
+	{name: "empty", seq: ""},
+}
+
+func (s *S) write(c *check.C) []byte {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, t := range testSeqs {
+		_, err := w.Write(linear.NewSeq(t.name, alphabet.BytesToLetters([]byte(t.seq)), alphabet.DNA))
+		c.Assert(err, check.Equals, nil)
+	}
+	c.Assert(w.Close(), check.Equals, nil)
+	return buf.Bytes()
+}
+
+func letters(sq seq.Sequence) string {
+	b := make([]byte, 0, sq.Len())
+	for i := sq.Start(); i < sq.End(); i++ {
+		b = append(b, byte(sq.At(i).L))
+	}
+	return string(b)
+}
+
+func (s *S) TestReadWrite(c *check.C) {
+	b := s.write(c)
+	c.Check(binary.LittleEndian.Uint32(b), check.Equals, uint32(signature))
+
+	r, err := NewReader(bytes.NewReader(b), linear.NewSeq("", nil, alphabet.DNA))
+	c.Assert(err, check.Equals, nil)
+	c.Check(r.Names(), check.DeepEquals, []string{"chr1", "chr2", "chrM", "empty"})
+	for _, t := range testSeqs {
+		sq, err := r.Read()
+		c.Assert(err, check.Equals, nil)
+		c.Check(sq.Name(), check.Equals, t.name)
+		c.Check(sq.Start(), check.Equals, 0)
+		c.Check(letters(sq), check.Equals, t.seq)
+	}
+	_, err = r.Read()
+	c.Check(err, check.Equals, io.EOF)
+
+	r.IgnoreMask = true
+	sq, err := r.Seq("chr1")
+	c.Assert(err, check.Equals, nil)
+	c.Check(letters(sq), check.Equals, "ACGTNNNNACGTACGTTTGCANNNGCA")
+
+	// Big-endian files are accepted.
+	var be bytes.Buffer
+	binary.Write(&be, binary.BigEndian, [4]uint32{signature, 0, 1, 0})
+	be.WriteString("\x02ch")
+	binary.Write(&be, binary.BigEndian, [6]uint32{23, 6, 1, 2, 2, 0})
+	binary.Write(&be, binary.BigEndian, uint32(0))
+	be.Write([]byte{0x10, 0xe0})
+	r, err = NewReader(bytes.NewReader(be.Bytes()), linear.NewSeq("", nil, alphabet.DNA))
+	c.Assert(err, check.Equals, nil)
+	c.Check(r.Names(), check.DeepEquals, []string{"ch"})
+	sq, err = r.Seq("ch")
+	c.Assert(err, check.Equals, nil)
+	c.Check(letters(sq), check.Equals, "TCNNGA")
+}
+
+func (s *S) TestFetch(c *check.C) {
+	r, err := NewReader(bytes.NewReader(s.write(c)), linear.NewSeq("", nil, alphabet.DNA))
+	c.Assert(err, check.Equals, nil)
+	for _, t := range []struct {
+		id         string
+		start, end int
+		want       string
+	}{
+		{id: "chr1", start: 0, end: 27, want: testSeqs[0].seq},
+		{id: "chr1", start: 2, end: 10, want: "GTNNNNac"},
+		{id: "chr1", start: 5, end: 6, want: "N"},
+		{id: "chr1", start: 10, end: 23, want: "gtACGTTTGCAnn"},
+		{id: "chr1", start: 26, end: 27, want: "a"},
+		{id: "chr1", start: 13, end: 13, want: ""},
+		{id: "chr2", start: 3, end: 7, want: "TACA"},
+	} {
+		sq, err := r.Fetch(t.id, t.start, t.end)
+		c.Assert(err, check.Equals, nil)
+		c.Check(sq.Name(), check.Equals, t.id)
+		c.Check(sq.Start(), check.Equals, t.start)
+		c.Check(letters(sq), check.Equals, t.want, check.Commentf("%s:%d-%d", t.id, t.start, t.end))
+	}
+
+	_, err = r.Fetch("chr2", 3, 8)
+	c.Check(err, check.ErrorMatches, `twobit: range 3..8 out of range for "chr2" of length 7`)
+	_, err = r.Fetch("chr2", -1, 2)
+	c.Check(err, check.NotNil)
+	_, err = r.Fetch("chr3", 0, 1)
+	c.Check(err, check.ErrorMatches, `twobit: no sequence "chr3" in file`)
+	n, err := r.Len("chr1")
+	c.Check(err, check.Equals, nil)
+	c.Check(n, check.Equals, 27)
+}
+
+func (s *S) TestErrors(c *check.C) {
+	b := s.write(c)
+
+	bad := append([]byte(nil), b...)
+	bad[0] = 0
+	_, err := NewReader(bytes.NewReader(bad), linear.NewSeq("", nil, alphabet.DNA))
+	c.Check(err, check.Equals, ErrBadSignature)
+
+	bad = append([]byte(nil), b...)
+	bad[4] = 2
+	_, err = NewReader(bytes.NewReader(bad), linear.NewSeq("", nil, alphabet.DNA))
+	c.Check(err, check.Equals, ErrBadVersion)
+
+	_, err = NewReader(bytes.NewReader(b[:20]), linear.NewSeq("", nil, alphabet.DNA))
+	c.Check(err, check.Equals, io.ErrUnexpectedEOF)
+
+	// Counts that cannot fit in the file are rejected before allocation.
+	bad = append([]byte(nil), b...)
+	binary.LittleEndian.PutUint32(bad[8:], 0x7fffffff)
+	_, err = NewReader(bytes.NewReader(bad), linear.NewSeq("", nil, alphabet.DNA))
+	c.Check(err, check.Equals, io.ErrUnexpectedEOF)
+	bad = append([]byte(nil), b...)
+	off := binary.LittleEndian.Uint32(bad[16+1+4:])
+	binary.LittleEndian.PutUint32(bad[off+4:], 0x7fffffff)
+	r, err := NewReader(bytes.NewReader(bad), linear.NewSeq("", nil, alphabet.DNA))
+	c.Assert(err, check.Equals, nil)
+	_, err = r.Seq("chr1")
+	c.Check(err, check.Equals, io.ErrUnexpectedEOF)
+
+	// Truncated sequence data is an error.
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	_, err = w.Write(linear.NewSeq("chr1", alphabet.BytesToLetters([]byte(testSeqs[0].seq)), alphabet.DNA))
+	c.Assert(err, check.Equals, nil)
+	c.Assert(w.Close(), check.Equals, nil)
+	r, err = NewReader(bytes.NewReader(buf.Bytes()[:buf.Len()-2]), linear.NewSeq("", nil, alphabet.DNA))
+	c.Assert(err, check.Equals, nil)
+	_, err = r.Fetch("chr1", 0, 4)
+	c.Check(err, check.Equals, io.ErrUnexpectedEOF)
+	r, err = NewReader(bytes.NewReader(b[:len(b)-8]), linear.NewSeq("", nil, alphabet.DNA))
+	c.Assert(err, check.Equals, nil)
+	_, err = r.Seq("empty")
+	c.Check(err, check.Equals, io.ErrUnexpectedEOF)
+
+	w = NewWriter(&bytes.Buffer{})
+	_, err = w.Write(linear.NewSeq("", nil, alphabet.DNA))
+	c.Check(err, check.Equals, ErrBadName)
+	c.Check(w.Close(), check.Equals, nil)
+	_, err = w.Write(linear.NewSeq("a", nil, alphabet.DNA))
+	c.Check(err, check.Equals, ErrClosed)
+}
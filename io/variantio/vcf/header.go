@@ -0,0 +1,427 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vcf
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ValueType is the type of the values of an INFO or FORMAT field.
+type ValueType int
+
+const (
+	String ValueType = iota
+	Integer
+	Float
+	Flag
+	Character
+)
+
+var valueTypes = [...]string{
+	String:    "String",
+	Integer:   "Integer",
+	Float:     "Float",
+	Flag:      "Flag",
+	Character: "Character",
+}
+
+func (t ValueType) String() string {
+	if t < 0 || int(t) >= len(valueTypes) {
+		return fmt.Sprintf("ValueType(%d)", int(t))
+	}
+	return valueTypes[t]
+}
+
+func parseValueType(s string) (ValueType, bool) {
+	for t, n := range valueTypes {
+		if n == s {
+			return ValueType(t), true
+		}
+	}
+	return 0, false
+}
+
+// Special values of the Number of a Definition.
+const (
+	NumberA       = -1 // One value per alternate allele.
+	NumberR       = -2 // One value per allele, including the reference.
+	NumberG       = -3 // One value per possible genotype.
+	NumberUnknown = -4 // The number of values varies or is unknown.
+)
+
+func parseNumber(s string) (int, bool) {
+	switch s {
+	case "A":
+		return NumberA, true
+	case "R":
+		return NumberR, true
+	case "G":
+		return NumberG, true
+	case ".":
+		return NumberUnknown, true
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil && n >= 0
+}
+
+func formatNumber(n int) string {
+	switch n {
+	case NumberA:
+		return "A"
+	case NumberR:
+		return "R"
+	case NumberG:
+		return "G"
+	case NumberUnknown:
+		return "."
+	}
+	return strconv.Itoa(n)
+}
+
+// A Field is a key-value pair.
+type Field struct {
+	Key, Value string
+}
+
+// Fields is a list of key-value pairs.
+type Fields []Field
+
+// Get returns the value of the first field with the given key and whether the key was
+// found.
+func (f Fields) Get(key string) (string, bool) {
+	for _, kv := range f {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return "", false
+}
+
+// A Definition describes an INFO or FORMAT field.
+type Definition struct {
+	ID string
+
+	// Number is the number of values
+	// of the field, or one of NumberA,
+	// NumberR, NumberG or NumberUnknown.
+	Number int
+
+	Type        ValueType
+	Description string
+
+	// Other holds any additional
+	// key-value pairs of the
+	// definition, such as Source
+	// and Version.
+	Other Fields
+}
+
+// A Filter describes a FILTER value.
+type Filter struct {
+	ID          string
+	Description string
+	Other       Fields
+}
+
+// A Contig describes a reference sequence. A Contig is the location of the variants
+// on the sequence.
+type Contig struct {
+	ID string
+
+	// Length is the length of the
+	// sequence, or zero if it is
+	// not known.
+	Length int
+
+	Other Fields
+}
+
+func (c *Contig) Start() int             { return 0 }
+func (c *Contig) End() int               { return c.Length }
+func (c *Contig) Len() int               { return c.Length }
+func (c *Contig) Name() string           { return c.ID }
+func (c *Contig) Description() string    { return "vcf contig" }
+func (c *Contig) Location() feat.Feature { return nil }
+
+// Header holds the meta-information and sample names of a VCF file.
+type Header struct {
+	// Version is the value of the
+	// fileformat line, for example
+	// "VCFv4.2".
+	Version string
+
+	Contigs []*Contig
+	Infos   []*Definition
+	Formats []*Definition
+	Filters []*Filter
+
+	// Meta holds the remaining
+	// meta-information lines in the
+	// order they were read. Structured
+	// values retain their angle
+	// brackets.
+	Meta Fields
+
+	// Samples holds the sample
+	// names in column order.
+	Samples []string
+}
+
+// Contig returns the contig with the given ID, or nil if it is not defined.
+func (h *Header) Contig(id string) *Contig {
+	for _, c := range h.Contigs {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// Info returns the definition of the INFO field with the given ID, or nil if it is not
+// defined.
+func (h *Header) Info(id string) *Definition { return definition(h.Infos, id) }
+
+// Format returns the definition of the FORMAT field with the given ID, or nil if it is not
+// defined.
+func (h *Header) Format(id string) *Definition { return definition(h.Formats, id) }
+
+func definition(defs []*Definition, id string) *Definition {
+	for _, d := range defs {
+		if d.ID == id {
+			return d
+		}
+	}
+	return nil
+}
+
+// SampleIndex returns the column index of the named sample, or -1 if the sample is not
+// present.
+func (h *Header) SampleIndex(name string) int {
+	for i, s := range h.Samples {
+		if s == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseMeta parses the meta-information line "##key=value" into h.
+func (h *Header) parseMeta(line string) error {
+	kv := strings.SplitN(line[2:], "=", 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return ErrBadMetaLine
+	}
+	key, value := kv[0], kv[1]
+	switch key {
+	case "fileformat":
+		h.Version = value
+		return nil
+	case "INFO", "FORMAT", "FILTER", "contig":
+	default:
+		h.Meta = append(h.Meta, Field{Key: key, Value: value})
+		return nil
+	}
+
+	fs, err := parseStructured(value)
+	if err != nil {
+		return err
+	}
+	id, ok := fs.Get("ID")
+	if !ok || id == "" {
+		return ErrBadMetaLine
+	}
+	switch key {
+	case "INFO", "FORMAT":
+		d := &Definition{ID: id}
+		var number, typ bool
+		for _, f := range fs {
+			switch f.Key {
+			case "ID":
+			case "Number":
+				d.Number, number = parseNumber(f.Value)
+			case "Type":
+				d.Type, typ = parseValueType(f.Value)
+			case "Description":
+				d.Description = f.Value
+			default:
+				d.Other = append(d.Other, f)
+			}
+		}
+		if !number || !typ {
+			return ErrBadDefinition
+		}
+		if key == "INFO" {
+			h.Infos = append(h.Infos, d)
+		} else {
+			h.Formats = append(h.Formats, d)
+		}
+	case "FILTER":
+		f := &Filter{ID: id}
+		for _, kv := range fs {
+			switch kv.Key {
+			case "ID":
+			case "Description":
+				f.Description = kv.Value
+			default:
+				f.Other = append(f.Other, kv)
+			}
+		}
+		h.Filters = append(h.Filters, f)
+	case "contig":
+		c := &Contig{ID: id}
+		for _, kv := range fs {
+			switch kv.Key {
+			case "ID":
+			case "length":
+				c.Length, err = strconv.Atoi(kv.Value)
+				if err != nil || c.Length < 0 {
+					return ErrBadMetaLine
+				}
+			default:
+				c.Other = append(c.Other, kv)
+			}
+		}
+		h.Contigs = append(h.Contigs, c)
+	}
+	return nil
+}
+
+// parseStructured parses a structured meta-information value of the form
+// <key=value,key="quoted value",...>.
+func parseStructured(s string) (Fields, error) {
+	if len(s) < 2 || s[0] != '<' || s[len(s)-1] != '>' {
+		return nil, ErrBadMetaLine
+	}
+	s = s[1 : len(s)-1]
+	var fs Fields
+	for s != "" {
+		i := strings.IndexByte(s, '=')
+		if i <= 0 {
+			return nil, ErrBadMetaLine
+		}
+		key := s[:i]
+		s = s[i+1:]
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			var buf []byte
+			i = 1
+			for ; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+				}
+				buf = append(buf, s[i])
+			}
+			if i == len(s) {
+				return nil, ErrBadMetaLine
+			}
+			value, s = string(buf), s[i+1:]
+		} else {
+			i = strings.IndexByte(s, ',')
+			if i < 0 {
+				i = len(s)
+			}
+			value, s = s[:i], s[i:]
+		}
+		fs = append(fs, Field{Key: key, Value: value})
+		if s == "" {
+			break
+		}
+		if s[0] != ',' {
+			return nil, ErrBadMetaLine
+		}
+		s = s[1:]
+	}
+	return fs, nil
+}
+
+// writeStructured writes fs to buf as a structured meta-information value. Description,
+// Source and Version values, and values that could not otherwise be parsed, are quoted.
+func writeStructured(buf *bytes.Buffer, fs Fields) {
+	buf.WriteByte('<')
+	for i, f := range fs {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(f.Key)
+		buf.WriteByte('=')
+		switch {
+		case f.Key == "Description", f.Key == "Source", f.Key == "Version",
+			f.Value == "", strings.ContainsAny(f.Value, `,"<>\ `):
+			buf.WriteByte('"')
+			for j := 0; j < len(f.Value); j++ {
+				if c := f.Value[j]; c == '"' || c == '\\' {
+					buf.WriteByte('\\')
+				}
+				buf.WriteByte(f.Value[j])
+			}
+			buf.WriteByte('"')
+		default:
+			buf.WriteString(f.Value)
+		}
+	}
+	buf.WriteByte('>')
+}
+
+// WriteTo writes the header to w in VCF format, returning the number of bytes written and
+// any error. The fileformat line is written first, followed by the Meta lines, the contig,
+// INFO, FILTER and FORMAT definitions and finally the column header line.
+func (h *Header) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	if h.Version != "" {
+		fmt.Fprintf(&buf, "##fileformat=%s\n", h.Version)
+	}
+	for _, m := range h.Meta {
+		fmt.Fprintf(&buf, "##%s=%s\n", m.Key, m.Value)
+	}
+	for _, c := range h.Contigs {
+		buf.WriteString("##contig=")
+		fs := Fields{{Key: "ID", Value: c.ID}}
+		if c.Length > 0 {
+			fs = append(fs, Field{Key: "length", Value: strconv.Itoa(c.Length)})
+		}
+		writeStructured(&buf, append(fs, c.Other...))
+		buf.WriteByte('\n')
+	}
+	writeDefinitions(&buf, "INFO", h.Infos)
+	for _, f := range h.Filters {
+		buf.WriteString("##FILTER=")
+		writeStructured(&buf, append(Fields{
+			{Key: "ID", Value: f.ID},
+			{Key: "Description", Value: f.Description},
+		}, f.Other...))
+		buf.WriteByte('\n')
+	}
+	writeDefinitions(&buf, "FORMAT", h.Formats)
+	buf.WriteString("#CHROM\tPOS\tID\tREF\tALT\tQUAL\tFILTER\tINFO")
+	if len(h.Samples) != 0 {
+		buf.WriteString("\tFORMAT")
+		for _, s := range h.Samples {
+			buf.WriteByte('\t')
+			buf.WriteString(s)
+		}
+	}
+	buf.WriteByte('\n')
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+func writeDefinitions(buf *bytes.Buffer, key string, defs []*Definition) {
+	for _, d := range defs {
+		fmt.Fprintf(buf, "##%s=", key)
+		writeStructured(buf, append(Fields{
+			{Key: "ID", Value: d.ID},
+			{Key: "Number", Value: formatNumber(d.Number)},
+			{Key: "Type", Value: d.Type.String()},
+			{Key: "Description", Value: d.Description},
+		}, d.Other...))
+		buf.WriteByte('\n')
+	}
+}
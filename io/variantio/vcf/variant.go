@@ -0,0 +1,458 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vcf
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Missing values are represented in typed INFO and FORMAT values by MissingInt for Integer
+// values, NaN for Float values, '.' for Character values and "." for String values.
+const MissingInt = math.MinInt32
+
+// A Variant represents a VCF data line.
+type Variant struct {
+	// Chrom is the name of the
+	// sequence holding the variant.
+	Chrom string
+
+	// Pos is the zero-based position
+	// of the first base of Ref.
+	Pos int
+
+	// ID holds the identifiers of
+	// the variant, or nil if there
+	// are none.
+	ID []string
+
+	// Ref and Alt are the reference
+	// and alternate alleles. Alt is
+	// nil if there are no alternate
+	// alleles.
+	Ref string
+	Alt []string
+
+	// Qual is the quality of the
+	// variant. A nil value indicates
+	// the quality is missing.
+	Qual *float64
+
+	// Filter holds the filters the
+	// variant failed, or "PASS". It is
+	// nil if filters have not been
+	// applied.
+	Filter []string
+
+	// Info holds the INFO fields of
+	// the variant. Flag fields have
+	// an empty value.
+	Info Fields
+
+	// Format holds the FORMAT keys and
+	// Samples holds the values of each
+	// sample in the order of the keys.
+	// Trailing values of a sample may
+	// be omitted.
+	Format  []string
+	Samples [][]string
+
+	// Header is the header describing
+	// the variant's fields.
+	Header *Header
+}
+
+// Start returns the zero-based start position of the variant.
+func (v *Variant) Start() int { return v.Pos }
+
+// End returns the end position of the variant. The end is given by the INFO END field if
+// it is present, and otherwise by the length of the reference allele.
+func (v *Variant) End() int {
+	if e, ok := v.Info.Get("END"); ok {
+		if end, err := strconv.Atoi(e); err == nil {
+			return end
+		}
+	}
+	return v.Pos + len(v.Ref)
+}
+
+// Len returns the length of the variant.
+func (v *Variant) Len() int { return v.End() - v.Start() }
+
+// Name returns the identifiers of the variant, or its one-based chromosome position if it
+// has none.
+func (v *Variant) Name() string {
+	if len(v.ID) == 0 {
+		return fmt.Sprintf("%s:%d", v.Chrom, feat.ZeroToOne(v.Pos))
+	}
+	return strings.Join(v.ID, ";")
+}
+
+func (v *Variant) Description() string { return "vcf variant" }
+
+// Location returns the Contig holding the variant. If the contig is not defined by the
+// variant's header, a Contig of unknown length is returned.
+func (v *Variant) Location() feat.Feature {
+	if v.Header != nil {
+		if c := v.Header.Contig(v.Chrom); c != nil {
+			return c
+		}
+	}
+	return &Contig{ID: v.Chrom}
+}
+
+// IsMultiallelic returns whether the variant has more than one alternate allele.
+func (v *Variant) IsMultiallelic() bool { return len(v.Alt) > 1 }
+
+// InfoValue returns the typed value of the named INFO field according to its definition in
+// the variant's header. Flag fields are returned as a bool, and other fields are returned
+// as an []int, []float64, []byte or []string for Integer, Float, Character and String
+// values respectively. If the field is not present and is not a Flag, a nil value is
+// returned. It is an error for the field not to be defined or for the number of values to
+// not match the definition.
+func (v *Variant) InfoValue(key string) (interface{}, error) {
+	var d *Definition
+	if v.Header != nil {
+		d = v.Header.Info(key)
+	}
+	if d == nil {
+		return nil, ErrUndefinedField
+	}
+	s, ok := v.Info.Get(key)
+	if d.Type == Flag {
+		return ok, nil
+	}
+	if !ok {
+		return nil, nil
+	}
+	return d.parse(s, len(v.Alt), -1)
+}
+
+// Sample returns the raw value of the FORMAT field key for the sample at column index i,
+// and whether the value is present.
+func (v *Variant) Sample(i int, key string) (string, bool) {
+	if i < 0 || i >= len(v.Samples) {
+		return "", false
+	}
+	for j, k := range v.Format {
+		if k == key {
+			if j < len(v.Samples[i]) {
+				return v.Samples[i][j], true
+			}
+			break
+		}
+	}
+	return "", false
+}
+
+// FormatValue returns the typed value of the FORMAT field key for the sample at column
+// index i, according to the field definition in the variant's header. Values are typed
+// as described for InfoValue. If the value is not present, a nil value is returned.
+func (v *Variant) FormatValue(i int, key string) (interface{}, error) {
+	var d *Definition
+	if v.Header != nil {
+		d = v.Header.Format(key)
+	}
+	if d == nil {
+		return nil, ErrUndefinedField
+	}
+	s, ok := v.Sample(i, key)
+	if !ok {
+		return nil, nil
+	}
+	ploidy := -1
+	if gt, err := v.Genotype(i); err == nil {
+		ploidy = len(gt.Alleles)
+	}
+	return d.parse(s, len(v.Alt), ploidy)
+}
+
+// Genotype returns the parsed GT field of the sample at column index i.
+func (v *Variant) Genotype(i int) (Genotype, error) {
+	s, ok := v.Sample(i, "GT")
+	if !ok {
+		return Genotype{}, ErrNoGenotype
+	}
+	return ParseGenotype(s)
+}
+
+// parse returns the typed values held in s. The number of alternate alleles and the
+// ploidy are used to check the number of values. A negative ploidy indicates the ploidy is
+// not known, in which case NumberG values are not checked.
+func (d *Definition) parse(s string, alts, ploidy int) (interface{}, error) {
+	vals := strings.Split(s, ",")
+	if s != "." {
+		want := d.Number
+		switch d.Number {
+		case NumberA:
+			want = alts
+		case NumberR:
+			want = alts + 1
+		case NumberG:
+			want = -1
+			if ploidy >= 0 {
+				want = genotypeCount(alts+1, ploidy)
+			}
+		case NumberUnknown:
+			want = -1
+		}
+		if want >= 0 && len(vals) != want {
+			return nil, ErrBadValueCount
+		}
+	}
+	switch d.Type {
+	case Integer:
+		a := make([]int, len(vals))
+		for i, f := range vals {
+			if f == "." {
+				a[i] = MissingInt
+				continue
+			}
+			n, err := strconv.Atoi(f)
+			if err != nil {
+				return nil, err
+			}
+			a[i] = n
+		}
+		return a, nil
+	case Float:
+		a := make([]float64, len(vals))
+		for i, f := range vals {
+			if f == "." {
+				a[i] = math.NaN()
+				continue
+			}
+			n, err := strconv.ParseFloat(f, 64)
+			if err != nil {
+				return nil, err
+			}
+			a[i] = n
+		}
+		return a, nil
+	case Character:
+		a := make([]byte, len(vals))
+		for i, f := range vals {
+			if len(f) != 1 {
+				return nil, ErrBadValue
+			}
+			a[i] = f[0]
+		}
+		return a, nil
+	case String:
+		return vals, nil
+	case Flag:
+		return true, nil
+	}
+	return nil, ErrBadValue
+}
+
+// genotypeCount returns the number of unordered genotypes of the given ploidy that can be
+// formed from n alleles.
+func genotypeCount(n, ploidy int) int {
+	c := 1
+	for i := 1; i <= ploidy; i++ {
+		c = c * (n + i - 1) / i
+	}
+	return c
+}
+
+// GenotypeIndex returns the index of the diploid genotype a/b in the ordering used by
+// Number=G fields.
+func GenotypeIndex(a, b int) int {
+	if a > b {
+		a, b = b, a
+	}
+	return b*(b+1)/2 + a
+}
+
+// MissingAllele is the allele index of an allele that was not called.
+const MissingAllele = -1
+
+// A Genotype is a sample genotype call.
+type Genotype struct {
+	// Alleles holds the called allele
+	// indices, where 0 is the reference
+	// allele and MissingAllele indicates
+	// a missing call.
+	Alleles []int
+
+	// Phased indicates the alleles
+	// are phased.
+	Phased bool
+}
+
+// ParseGenotype parses a VCF GT value.
+func ParseGenotype(s string) (Genotype, error) {
+	if s == "" {
+		return Genotype{}, ErrBadGenotype
+	}
+	var g Genotype
+	for i := 0; ; {
+		j := strings.IndexAny(s[i:], "/|")
+		if j < 0 {
+			j = len(s)
+		} else {
+			j += i
+		}
+		if s[i:j] == "." {
+			g.Alleles = append(g.Alleles, MissingAllele)
+		} else {
+			a, err := strconv.Atoi(s[i:j])
+			if err != nil || a < 0 {
+				return Genotype{}, ErrBadGenotype
+			}
+			g.Alleles = append(g.Alleles, a)
+		}
+		if j == len(s) {
+			break
+		}
+		if i == 0 {
+			g.Phased = true
+		}
+		g.Phased = g.Phased && s[j] == '|'
+		i = j + 1
+	}
+	return g, nil
+}
+
+func (g Genotype) String() string {
+	sep := "/"
+	if g.Phased {
+		sep = "|"
+	}
+	var b bytes.Buffer
+	for i, a := range g.Alleles {
+		if i != 0 {
+			b.WriteString(sep)
+		}
+		if a == MissingAllele {
+			b.WriteByte('.')
+		} else {
+			b.WriteString(strconv.Itoa(a))
+		}
+	}
+	return b.String()
+}
+
+// Split returns the variant split into one variant per alternate allele. The values of
+// INFO and FORMAT fields with Number A, R or G are reduced to those describing the
+// reference allele and the retained alternate allele. Genotype calls of other alternate
+// alleles are recoded as reference calls. Alleles are not normalised. A variant with
+// fewer than two alternate alleles is returned unaltered.
+func (v *Variant) Split() ([]*Variant, error) {
+	if len(v.Alt) < 2 {
+		return []*Variant{v}, nil
+	}
+	vs := make([]*Variant, len(v.Alt))
+	for i, alt := range v.Alt {
+		a := i + 1
+		s := *v
+		s.ID = append([]string(nil), v.ID...)
+		s.Alt = []string{alt}
+		s.Filter = append([]string(nil), v.Filter...)
+		s.Format = append([]string(nil), v.Format...)
+
+		s.Info = nil
+		for _, f := range v.Info {
+			var d *Definition
+			if v.Header != nil {
+				d = v.Header.Info(f.Key)
+			}
+			val, err := splitValue(d, f.Value, a, len(v.Alt), 2)
+			if err != nil {
+				return nil, fmt.Errorf("vcf: %s INFO %s: %v", v.Name(), f.Key, err)
+			}
+			s.Info = append(s.Info, Field{Key: f.Key, Value: val})
+		}
+
+		s.Samples = make([][]string, len(v.Samples))
+		for j, sample := range v.Samples {
+			ploidy := 2
+			if gt, err := v.Genotype(j); err == nil {
+				ploidy = len(gt.Alleles)
+			}
+			s.Samples[j] = make([]string, len(sample))
+			for k, val := range sample {
+				key := v.Format[k]
+				if key == "GT" {
+					gt, err := ParseGenotype(val)
+					if err != nil {
+						return nil, fmt.Errorf("vcf: %s sample %d: %v", v.Name(), j, err)
+					}
+					for l, c := range gt.Alleles {
+						switch c {
+						case MissingAllele, 0:
+						case a:
+							gt.Alleles[l] = 1
+						default:
+							gt.Alleles[l] = 0
+						}
+					}
+					s.Samples[j][k] = gt.String()
+					continue
+				}
+				var d *Definition
+				if v.Header != nil {
+					d = v.Header.Format(key)
+				}
+				val, err := splitValue(d, val, a, len(v.Alt), ploidy)
+				if err != nil {
+					return nil, fmt.Errorf("vcf: %s sample %d FORMAT %s: %v", v.Name(), j, key, err)
+				}
+				s.Samples[j][k] = val
+			}
+		}
+		vs[i] = &s
+	}
+	return vs, nil
+}
+
+// splitValue returns the values of s described by d that apply to the reference allele
+// and alternate allele a of alts alternate alleles. Values with no definition, or that are
+// missing, are returned unaltered.
+func splitValue(d *Definition, s string, a, alts, ploidy int) (string, error) {
+	if d == nil || s == "." {
+		return s, nil
+	}
+	var idx []int
+	switch d.Number {
+	case NumberA:
+		idx = []int{a - 1}
+	case NumberR:
+		idx = []int{0, a}
+	case NumberG:
+		switch ploidy {
+		case 1:
+			idx = []int{0, a}
+		case 2:
+			idx = []int{GenotypeIndex(0, 0), GenotypeIndex(0, a), GenotypeIndex(a, a)}
+		default:
+			return "", ErrBadPloidy
+		}
+	default:
+		return s, nil
+	}
+	vals := strings.Split(s, ",")
+	want := alts
+	switch d.Number {
+	case NumberR:
+		want = alts + 1
+	case NumberG:
+		want = genotypeCount(alts+1, ploidy)
+	}
+	if len(vals) != want {
+		return "", ErrBadValueCount
+	}
+	split := make([]string, len(idx))
+	for i, j := range idx {
+		split[i] = vals[j]
+	}
+	return strings.Join(split, ","), nil
+}
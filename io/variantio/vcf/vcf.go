@@ -0,0 +1,306 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package vcf provides types to read and write Variant Call Format files.
+//
+// The specification can be found at https://samtools.github.io/hts-specs/VCFv4.3.pdf.
+package vcf
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/io/seqio"
+
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ featio.Reader = (*Reader)(nil)
+	_ featio.Writer = (*Writer)(nil)
+)
+
+type Error struct{ string }
+
+func (e Error) Error() string { return e.string }
+
+var (
+	ErrMissingHeader  = Error{"vcf: missing header line"}
+	ErrBadHeaderLine  = Error{"vcf: invalid header line"}
+	ErrBadMetaLine    = Error{"vcf: invalid meta-information line"}
+	ErrBadDefinition  = Error{"vcf: invalid field definition"}
+	ErrFieldCount     = Error{"vcf: wrong number of fields"}
+	ErrBadPosition    = Error{"vcf: invalid position"}
+	ErrMissingRef     = Error{"vcf: missing reference allele"}
+	ErrBadInfo        = Error{"vcf: invalid INFO field"}
+	ErrUndefinedField = Error{"vcf: undefined field"}
+	ErrBadValue       = Error{"vcf: invalid value"}
+	ErrBadValueCount  = Error{"vcf: wrong number of values"}
+	ErrBadGenotype    = Error{"vcf: invalid genotype"}
+	ErrNoGenotype     = Error{"vcf: no genotype"}
+	ErrBadPloidy      = Error{"vcf: unsupported ploidy"}
+	ErrSampleCount    = Error{"vcf: wrong number of samples"}
+	ErrNotHandled     = Error{"vcf: type not handled"}
+)
+
+const (
+	chromField = iota
+	posField
+	idField
+	refField
+	altField
+	qualField
+	filterField
+	infoField
+	formatField
+)
+
+var headerFields = []string{"#CHROM", "POS", "ID", "REF", "ALT", "QUAL", "FILTER", "INFO", "FORMAT"}
+
+// A Reader reads VCF variants.
+type Reader struct {
+	r      *bufio.Reader
+	line   int
+	fields int
+
+	// Header is the header of
+	// the VCF stream.
+	Header *Header
+}
+
+// NewReader returns a new VCF format reader that reads from r. The header of the stream is
+// read and is available in the returned Reader's Header field. Gzip and BGZF compressed
+// input is decompressed.
+func NewReader(r io.Reader) (*Reader, error) {
+	r, _, err := seqio.Decompress(r)
+	if err != nil {
+		return nil, err
+	}
+	vr := &Reader{r: bufio.NewReader(r), Header: &Header{}}
+	for {
+		line, err := vr.readLine()
+		if err != nil {
+			if err == io.EOF {
+				err = &csv.ParseError{Line: vr.line, Err: ErrMissingHeader}
+			}
+			return nil, err
+		}
+		if strings.HasPrefix(line, "##") {
+			err = vr.Header.parseMeta(line)
+			if err != nil {
+				return nil, &csv.ParseError{Line: vr.line, Err: err}
+			}
+			continue
+		}
+		if !strings.HasPrefix(line, "#") {
+			return nil, &csv.ParseError{Line: vr.line, Err: ErrMissingHeader}
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < infoField+1 {
+			return nil, &csv.ParseError{Line: vr.line, Err: ErrBadHeaderLine}
+		}
+		for i, f := range fields {
+			if i > formatField {
+				break
+			}
+			if f != headerFields[i] {
+				return nil, &csv.ParseError{Line: vr.line, Column: i, Err: ErrBadHeaderLine}
+			}
+		}
+		if len(fields) > formatField+1 {
+			vr.Header.Samples = fields[formatField+1:]
+		}
+		vr.fields = len(fields)
+		return vr, nil
+	}
+}
+
+// readLine returns the next line of input without its line ending, skipping empty lines.
+func (r *Reader) readLine() (string, error) {
+	for {
+		line, err := r.r.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			if err == io.EOF {
+				return "", err
+			}
+			return "", &csv.ParseError{Line: r.line, Err: err}
+		}
+		r.line++
+		line = strings.TrimRight(line, "\r\n")
+		if line != "" {
+			return line, nil
+		}
+	}
+}
+
+// Read reads a single variant and returns it as a *Variant, or an error.
+func (r *Reader) Read() (feat.Feature, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Split(line, "\t")
+	if len(fields) != r.fields && (len(r.Header.Samples) != 0 || len(fields) < infoField+1 || len(fields) > formatField+1) {
+		return nil, &csv.ParseError{Line: r.line, Column: len(fields), Err: ErrFieldCount}
+	}
+	v := &Variant{
+		Chrom:  fields[chromField],
+		ID:     splitMissing(fields[idField], ";"),
+		Ref:    fields[refField],
+		Alt:    splitMissing(fields[altField], ","),
+		Filter: splitMissing(fields[filterField], ";"),
+		Header: r.Header,
+	}
+	pos, err := strconv.Atoi(fields[posField])
+	if err != nil {
+		return nil, &csv.ParseError{Line: r.line, Column: posField, Err: err}
+	}
+	if pos < 0 {
+		return nil, &csv.ParseError{Line: r.line, Column: posField, Err: ErrBadPosition}
+	}
+	v.Pos = feat.OneToZero(pos)
+	if v.Ref == "" || v.Ref == "." {
+		return nil, &csv.ParseError{Line: r.line, Column: refField, Err: ErrMissingRef}
+	}
+	if fields[qualField] != "." {
+		q, err := strconv.ParseFloat(fields[qualField], 64)
+		if err != nil {
+			return nil, &csv.ParseError{Line: r.line, Column: qualField, Err: err}
+		}
+		v.Qual = &q
+	}
+	if fields[infoField] != "." {
+		for _, f := range strings.Split(fields[infoField], ";") {
+			kv := strings.SplitN(f, "=", 2)
+			if kv[0] == "" {
+				return nil, &csv.ParseError{Line: r.line, Column: infoField, Err: ErrBadInfo}
+			}
+			var val string
+			if len(kv) == 2 {
+				val = kv[1]
+			}
+			v.Info = append(v.Info, Field{Key: kv[0], Value: val})
+		}
+	}
+	if len(fields) > formatField {
+		v.Format = strings.Split(fields[formatField], ":")
+		v.Samples = make([][]string, len(fields)-formatField-1)
+		for i, s := range fields[formatField+1:] {
+			vals := strings.Split(s, ":")
+			if len(vals) > len(v.Format) {
+				return nil, &csv.ParseError{Line: r.line, Column: formatField + 1 + i, Err: ErrBadValueCount}
+			}
+			v.Samples[i] = vals
+		}
+	}
+
+	return v, nil
+}
+
+// splitMissing returns s split by sep, or nil if s is the missing value ".".
+func splitMissing(s, sep string) []string {
+	if s == "." {
+		return nil
+	}
+	return strings.Split(s, sep)
+}
+
+// A Writer outputs variants in VCF format.
+type Writer struct {
+	w         io.Writer
+	Header    *Header
+	Precision int
+}
+
+// NewWriter returns a new VCF format writer using w. The header h is written to w before
+// NewWriter returns.
+func NewWriter(w io.Writer, h *Header) (*Writer, error) {
+	_, err := h.WriteTo(w)
+	if err != nil {
+		return nil, err
+	}
+	return &Writer{w: w, Header: h, Precision: -1}, nil
+}
+
+// Write writes a single variant and returns the number of bytes written and any error.
+// Variants must have a sample for each sample in the Writer's header. All other
+// feat.Feature types return an ErrNotHandled.
+func (w *Writer) Write(f feat.Feature) (n int, err error) {
+	v, ok := f.(*Variant)
+	if !ok {
+		return 0, ErrNotHandled
+	}
+	if v.Ref == "" {
+		return 0, ErrMissingRef
+	}
+	if len(v.Samples) != len(w.Header.Samples) {
+		return 0, ErrSampleCount
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(v.Chrom)
+	buf.WriteByte('\t')
+	buf.WriteString(strconv.Itoa(feat.ZeroToOne(v.Pos)))
+	buf.WriteByte('\t')
+	joinMissing(&buf, v.ID, ";")
+	buf.WriteByte('\t')
+	buf.WriteString(v.Ref)
+	buf.WriteByte('\t')
+	joinMissing(&buf, v.Alt, ",")
+	buf.WriteByte('\t')
+	switch {
+	case v.Qual == nil || math.IsNaN(*v.Qual):
+		buf.WriteByte('.')
+	default:
+		buf.WriteString(strconv.FormatFloat(*v.Qual, 'f', w.Precision, 64))
+	}
+	buf.WriteByte('\t')
+	joinMissing(&buf, v.Filter, ";")
+	buf.WriteByte('\t')
+	if len(v.Info) == 0 {
+		buf.WriteByte('.')
+	}
+	for i, f := range v.Info {
+		if i != 0 {
+			buf.WriteByte(';')
+		}
+		buf.WriteString(f.Key)
+		if f.Value != "" {
+			buf.WriteByte('=')
+			buf.WriteString(f.Value)
+		}
+	}
+	if len(w.Header.Samples) != 0 {
+		buf.WriteByte('\t')
+		joinMissing(&buf, v.Format, ":")
+		for _, s := range v.Samples {
+			buf.WriteByte('\t')
+			joinMissing(&buf, s, ":")
+		}
+	}
+	buf.WriteByte('\n')
+	return w.w.Write(buf.Bytes())
+}
+
+// joinMissing writes the elements of a joined by sep to buf, or the missing value "." if a
+// is empty.
+func joinMissing(buf *bytes.Buffer, a []string, sep string) {
+	if len(a) == 0 {
+		buf.WriteByte('.')
+		return
+	}
+	for i, s := range a {
+		if i != 0 {
+			buf.WriteString(sep)
+		}
+		buf.WriteString(s)
+	}
+}
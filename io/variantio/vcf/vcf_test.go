@@ -0,0 +1,301 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vcf
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"encoding/csv"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const testVCF = `##fileformat=VCFv4.3
+##fileDate=20090805
+##source=myImputationProgramV3.1
+##ALT=<ID=DEL,Description="Deletion">
+##contig=<ID=20,length=62435964,assembly=B36,species="Homo sapiens">
+##INFO=<ID=NS,Number=1,Type=Integer,Description="Number of Samples With Data">
+##INFO=<ID=AF,Number=A,Type=Float,Description="Allele Frequency">
+##INFO=<ID=AA,Number=1,Type=String,Description="Ancestral Allele, \"AA\"">
+##INFO=<ID=DB,Number=0,Type=Flag,Description="dbSNP membership, build 129">
+##INFO=<ID=END,Number=1,Type=Integer,Description="End position">
+##FILTER=<ID=q10,Description="Quality below 10">
+##FORMAT=<ID=GT,Number=1,Type=String,Description="Genotype">
+##FORMAT=<ID=DP,Number=1,Type=Integer,Description="Read Depth">
+##FORMAT=<ID=AD,Number=R,Type=Integer,Description="Allelic depths">
+##FORMAT=<ID=PL,Number=G,Type=Integer,Description="Phred-scaled genotype likelihoods">
+#CHROM	POS	ID	REF	ALT	QUAL	FILTER	INFO	FORMAT	NA00001	NA00002
+20	14370	rs6054257	G	A	29	PASS	NS=3;AF=0.5;DB	GT:DP:AD:PL	0|0:1:1,0:0,10,100	1|0:8:4,4:50,0,50
+20	1110696	rs6040355;rs1	A	G,T	67	PASS	NS=2;AF=0.333,0.667;AA=T	GT:DP:AD:PL	1|2:6:0,3,3:90,60,50,30,0,40	2/2:.:0,0,5:.
+20	1230237	.	T	.	47	.	.	GT:DP	0|0:7	./.
+20	1234567	.	GTC	<DEL>	3.5	q10	END=1234600	GT	0/1	.
+`
+
+func (s *S) TestReadHeader(c *check.C) {
+	r, err := NewReader(strings.NewReader(testVCF))
+	c.Assert(err, check.Equals, nil)
+	h := r.Header
+	c.Check(h.Version, check.Equals, "VCFv4.3")
+	c.Check(h.Meta, check.DeepEquals, Fields{
+		{Key: "fileDate", Value: "20090805"},
+		{Key: "source", Value: "myImputationProgramV3.1"},
+		{Key: "ALT", Value: `<ID=DEL,Description="Deletion">`},
+	})
+	c.Check(h.Contigs, check.DeepEquals, []*Contig{{
+		ID: "20", Length: 62435964,
+		Other: Fields{{Key: "assembly", Value: "B36"}, {Key: "species", Value: "Homo sapiens"}},
+	}})
+	c.Check(len(h.Infos), check.Equals, 5)
+	c.Check(h.Info("AF"), check.DeepEquals, &Definition{ID: "AF", Number: NumberA, Type: Float, Description: "Allele Frequency"})
+	c.Check(h.Info("AA").Description, check.Equals, `Ancestral Allele, "AA"`)
+	c.Check(h.Info("DB").Type, check.Equals, Flag)
+	c.Check(h.Info("XX"), check.IsNil)
+	c.Check(h.Format("PL").Number, check.Equals, NumberG)
+	c.Check(h.Filters, check.DeepEquals, []*Filter{{ID: "q10", Description: "Quality below 10"}})
+	c.Check(h.Samples, check.DeepEquals, []string{"NA00001", "NA00002"})
+	c.Check(h.SampleIndex("NA00002"), check.Equals, 1)
+	c.Check(h.SampleIndex("NA00003"), check.Equals, -1)
+}
+
+func readAll(c *check.C, vcf string) []*Variant {
+	r, err := NewReader(strings.NewReader(vcf))
+	c.Assert(err, check.Equals, nil)
+	var vs []*Variant
+	for {
+		v, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		vs = append(vs, v.(*Variant))
+	}
+	return vs
+}
+
+func (s *S) TestRead(c *check.C) {
+	vs := readAll(c, testVCF)
+	c.Assert(len(vs), check.Equals, 4)
+
+	v := vs[0]
+	c.Check(v.Name(), check.Equals, "rs6054257")
+	c.Check(v.Start(), check.Equals, 14369)
+	c.Check(v.End(), check.Equals, 14370)
+	c.Check(v.Location(), check.Equals, feat.Feature(v.Header.Contigs[0]))
+	c.Check(*v.Qual, check.Equals, 29.)
+	c.Check(v.Filter, check.DeepEquals, []string{"PASS"})
+	ns, err := v.InfoValue("NS")
+	c.Check(err, check.Equals, nil)
+	c.Check(ns, check.DeepEquals, []int{3})
+	db, err := v.InfoValue("DB")
+	c.Check(err, check.Equals, nil)
+	c.Check(db, check.Equals, true)
+	aa, err := v.InfoValue("AA")
+	c.Check(err, check.Equals, nil)
+	c.Check(aa, check.IsNil)
+	_, err = v.InfoValue("XX")
+	c.Check(err, check.Equals, ErrUndefinedField)
+	gt, err := v.Genotype(1)
+	c.Check(err, check.Equals, nil)
+	c.Check(gt, check.DeepEquals, Genotype{Alleles: []int{1, 0}, Phased: true})
+	pl, err := v.FormatValue(1, "PL")
+	c.Check(err, check.Equals, nil)
+	c.Check(pl, check.DeepEquals, []int{50, 0, 50})
+
+	v = vs[1]
+	c.Check(v.ID, check.DeepEquals, []string{"rs6040355", "rs1"})
+	c.Check(v.IsMultiallelic(), check.Equals, true)
+	af, err := v.InfoValue("AF")
+	c.Check(err, check.Equals, nil)
+	c.Check(af, check.DeepEquals, []float64{0.333, 0.667})
+	aa, err = v.InfoValue("AA")
+	c.Check(err, check.Equals, nil)
+	c.Check(aa, check.DeepEquals, []string{"T"})
+	dp, err := v.FormatValue(1, "DP")
+	c.Check(err, check.Equals, nil)
+	c.Check(dp, check.DeepEquals, []int{MissingInt})
+	pl, err = v.FormatValue(1, "PL")
+	c.Check(err, check.Equals, nil)
+	c.Check(pl, check.DeepEquals, []int{MissingInt})
+	gt, err = v.Genotype(1)
+	c.Check(err, check.Equals, nil)
+	c.Check(gt, check.DeepEquals, Genotype{Alleles: []int{2, 2}})
+
+	v = vs[2]
+	c.Check(v.Name(), check.Equals, "20:1230237")
+	c.Check(v.Alt, check.IsNil)
+	c.Check(v.Filter, check.IsNil)
+	c.Check(v.Info, check.IsNil)
+	_, err = v.InfoValue("AF")
+	c.Check(err, check.Equals, nil)
+	gt, err = v.Genotype(1)
+	c.Check(err, check.Equals, nil)
+	c.Check(gt.String(), check.Equals, "./.")
+	c.Check(gt.Alleles, check.DeepEquals, []int{MissingAllele, MissingAllele})
+	_, ok := v.Sample(1, "DP")
+	c.Check(ok, check.Equals, false)
+
+	v = vs[3]
+	c.Check(v.Start(), check.Equals, 1234566)
+	c.Check(v.End(), check.Equals, 1234600)
+	gt, err = v.Genotype(1)
+	c.Check(err, check.Equals, nil)
+	c.Check(gt, check.DeepEquals, Genotype{Alleles: []int{MissingAllele}})
+}
+
+func (s *S) TestReadErrors(c *check.C) {
+	const header = "##fileformat=VCFv4.3\n##INFO=<ID=AF,Number=A,Type=Float,Description=\"AF\">\n#CHROM\tPOS\tID\tREF\tALT\tQUAL\tFILTER\tINFO\n"
+	for i, t := range []struct {
+		vcf  string
+		line int
+		err  error
+	}{
+		{vcf: "##fileformat=VCFv4.3\n", line: 1, err: ErrMissingHeader},
+		{vcf: "##INFO=<ID=AF,Number=A>\n", line: 1, err: ErrBadDefinition},
+		{vcf: "##INFO=<ID=AF,Number=A,Type=Float,Description=\"AF>\n", line: 1, err: ErrBadMetaLine},
+		{vcf: "##contig=<length=10>\n", line: 1, err: ErrBadMetaLine},
+		{vcf: "#CHROM\tPOS\tID\tREF\tALT\tQUAL\tFILTER\n", line: 1, err: ErrBadHeaderLine},
+		{vcf: "#CHROM\tPOS\tID\tREF\tALT\tQUAL\tFILTER\tINFO\tSAMPLE\n", line: 1, err: ErrBadHeaderLine},
+	} {
+		_, err := NewReader(strings.NewReader(t.vcf))
+		perr, ok := err.(*csv.ParseError)
+		c.Assert(ok, check.Equals, true, check.Commentf("Test %d: %v", i, err))
+		c.Check(perr.Line, check.Equals, t.line, check.Commentf("Test %d", i))
+		c.Check(perr.Err, check.Equals, t.err, check.Commentf("Test %d", i))
+	}
+
+	for i, t := range []struct {
+		line string
+		err  error
+	}{
+		{line: "1\t10\t.\tA\tG\t.\t.\n", err: ErrFieldCount},
+		{line: "1\t-1\t.\tA\tG\t.\t.\t.\n", err: ErrBadPosition},
+		{line: "1\t10\t.\t.\tG\t.\t.\t.\n", err: ErrMissingRef},
+		{line: "1\t10\t.\tA\tG\t.\t.\tAF=1;=2\n", err: ErrBadInfo},
+	} {
+		r, err := NewReader(strings.NewReader(header + t.line))
+		c.Assert(err, check.Equals, nil)
+		_, err = r.Read()
+		perr, ok := err.(*csv.ParseError)
+		c.Assert(ok, check.Equals, true, check.Commentf("Test %d: %v", i, err))
+		c.Check(perr.Line, check.Equals, 4, check.Commentf("Test %d", i))
+		c.Check(perr.Err, check.Equals, t.err, check.Commentf("Test %d", i))
+	}
+
+	v := readAll(c, header+"1\t10\t.\tA\tG,T\t.\t.\tAF=0.5\n")[0]
+	_, err := v.InfoValue("AF")
+	c.Check(err, check.Equals, ErrBadValueCount)
+}
+
+func (s *S) TestGenotype(c *check.C) {
+	for _, t := range []struct {
+		gt   string
+		want Genotype
+		err  error
+	}{
+		{gt: "0/1", want: Genotype{Alleles: []int{0, 1}}},
+		{gt: "1|0", want: Genotype{Alleles: []int{1, 0}, Phased: true}},
+		{gt: "1", want: Genotype{Alleles: []int{1}}},
+		{gt: ".|1|2", want: Genotype{Alleles: []int{MissingAllele, 1, 2}, Phased: true}},
+		{gt: "0|1/2", want: Genotype{Alleles: []int{0, 1, 2}}},
+		{gt: "", err: ErrBadGenotype},
+		{gt: "0/", err: ErrBadGenotype},
+		{gt: "a/1", err: ErrBadGenotype},
+	} {
+		g, err := ParseGenotype(t.gt)
+		c.Check(err, check.Equals, t.err, check.Commentf("%q", t.gt))
+		if err != nil {
+			continue
+		}
+		c.Check(g, check.DeepEquals, t.want, check.Commentf("%q", t.gt))
+	}
+	c.Check(GenotypeIndex(0, 0), check.Equals, 0)
+	c.Check(GenotypeIndex(1, 0), check.Equals, 1)
+	c.Check(GenotypeIndex(1, 1), check.Equals, 2)
+	c.Check(GenotypeIndex(0, 2), check.Equals, 3)
+	c.Check(GenotypeIndex(2, 2), check.Equals, 5)
+}
+
+func (s *S) TestSplit(c *check.C) {
+	vs := readAll(c, testVCF)
+	split, err := vs[0].Split()
+	c.Check(err, check.Equals, nil)
+	c.Check(split, check.DeepEquals, []*Variant{vs[0]})
+
+	v := vs[1]
+	split, err = v.Split()
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(split), check.Equals, 2)
+	for i, want := range []struct {
+		alt     string
+		info    Fields
+		samples [][]string
+	}{
+		{
+			alt:     "G",
+			info:    Fields{{Key: "NS", Value: "2"}, {Key: "AF", Value: "0.333"}, {Key: "AA", Value: "T"}},
+			samples: [][]string{{"1|0", "6", "0,3", "90,60,50"}, {"0/0", ".", "0,0", "."}},
+		},
+		{
+			alt:     "T",
+			info:    Fields{{Key: "NS", Value: "2"}, {Key: "AF", Value: "0.667"}, {Key: "AA", Value: "T"}},
+			samples: [][]string{{"0|1", "6", "0,3", "90,30,40"}, {"1/1", ".", "0,5", "."}},
+		},
+	} {
+		c.Check(split[i].Alt, check.DeepEquals, []string{want.alt})
+		c.Check(split[i].Info, check.DeepEquals, want.info)
+		c.Check(split[i].Samples, check.DeepEquals, want.samples)
+		c.Check(split[i].Ref, check.Equals, "A")
+		c.Check(split[i].Pos, check.Equals, v.Pos)
+	}
+	c.Check(v.Alt, check.DeepEquals, []string{"G", "T"})
+
+	v.Samples[0][3] = "90,60"
+	_, err = v.Split()
+	c.Check(err, check.ErrorMatches, "vcf: rs6040355;rs1 sample 0 FORMAT PL: vcf: wrong number of values")
+}
+
+func (s *S) TestWrite(c *check.C) {
+	r, err := NewReader(strings.NewReader(testVCF))
+	c.Assert(err, check.Equals, nil)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, r.Header)
+	c.Assert(err, check.Equals, nil)
+	for {
+		v, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		_, err = w.Write(v)
+		c.Check(err, check.Equals, nil)
+	}
+	c.Check(buf.String(), check.Equals, testVCF)
+
+	buf.Reset()
+	w, err = NewWriter(&buf, &Header{Version: "VCFv4.2"})
+	c.Assert(err, check.Equals, nil)
+	q := math.NaN()
+	n, err := w.Write(&Variant{Chrom: "1", Pos: 9, Ref: "A", Qual: &q, Info: Fields{{Key: "DB"}}})
+	c.Check(err, check.Equals, nil)
+	c.Check(n, check.Equals, 18)
+	_, err = w.Write(&Variant{Chrom: "1", Pos: 9, Ref: "A", Samples: [][]string{{"0"}}})
+	c.Check(err, check.Equals, ErrSampleCount)
+	_, err = w.Write(&Contig{ID: "1"})
+	c.Check(err, check.Equals, ErrNotHandled)
+	c.Check(buf.String(), check.Equals, "##fileformat=VCFv4.2\n#CHROM\tPOS\tID\tREF\tALT\tQUAL\tFILTER\tINFO\n1\t10\t.\tA\t.\t.\t.\tDB\n")
+}
@@ -0,0 +1,303 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package primer
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// letters returns the letters of s. It returns an error if s is not a nucleic acid sequence
+// or contains letters that are not IUPAC nucleotide codes.
+func letters(s seq.Sequence) (alphabet.Letters, error) {
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, ErrNotNucleic
+	}
+	l := make(alphabet.Letters, 0, s.Len())
+	for i := s.Start(); i < s.End(); i++ {
+		b := s.At(i).L
+		if alphabet.Expand(b) == nil {
+			return nil, fmt.Errorf("primer: invalid base %q at position %d", b, i)
+		}
+		l = append(l, b)
+	}
+	return l, nil
+}
+
+// Degeneracy returns the number of distinct concrete sequences represented by the
+// degenerate primer p. It returns an error if p is not a nucleic acid sequence or contains
+// letters that are not IUPAC nucleotide codes.
+func Degeneracy(p seq.Sequence) (int, error) {
+	l, err := letters(p)
+	if err != nil {
+		return 0, err
+	}
+	n := 1
+	for _, b := range l {
+		n *= len(alphabet.Expand(b))
+	}
+	return n, nil
+}
+
+// Expand returns the concrete sequences represented by the degenerate primer p, in
+// lexical order of the bases a, c, g and t at each degenerate position and preserving the
+// case of each letter. The number of sequences returned is given by Degeneracy and grows
+// exponentially with the number of degenerate positions. It returns an error if p is not a
+// nucleic acid sequence or contains letters that are not IUPAC nucleotide codes.
+func Expand(p seq.Sequence) ([]alphabet.Letters, error) {
+	l, err := letters(p)
+	if err != nil {
+		return nil, err
+	}
+	e := []alphabet.Letters{make(alphabet.Letters, 0, len(l))}
+	for _, b := range l {
+		bases := alphabet.Expand(b)
+		next := make([]alphabet.Letters, 0, len(e)*len(bases))
+		for _, v := range e {
+			for _, c := range bases {
+				next = append(next, append(v[:len(v):len(v)], c))
+			}
+		}
+		e = next
+	}
+	return e, nil
+}
+
+// A Binding is a primer binding site on a template.
+type Binding struct {
+	// Primer is the bound primer.
+	Primer seq.Sequence
+
+	// From and To are the positions of the
+	// site on the top strand of the template.
+	From, To int
+
+	// Strand is the template strand that the
+	// primer sequence matches. Primers matching
+	// the Plus strand anneal to the bottom strand
+	// and are extended toward the end of the
+	// template, and primers matching the Minus
+	// strand are extended toward its start.
+	Strand seq.Strand
+
+	// Mismatches is the number of template
+	// bases not matched by the primer.
+	Mismatches int
+}
+
+// A PCR holds the parameters of an in silico polymerase chain reaction.
+type PCR struct {
+	// MaxMismatches is the maximum number of
+	// mismatches allowed between a primer and
+	// a binding site.
+	MaxMismatches int
+
+	// ThreePrime is the number of bases at
+	// the 3' end of a primer that must match
+	// the template for the primer to be
+	// extended.
+	ThreePrime int
+
+	// MinLen and MaxLen are the limits of the
+	// product length. If MaxLen is zero, the
+	// product length is not limited.
+	MinLen, MaxLen int
+}
+
+// Bindings returns the binding sites of primer on both strands of the linear template,
+// sorted by position. Degenerate primer and template bases match any of the bases they
+// represent. It returns an error if either sequence is not a nucleic acid sequence or the
+// primer contains letters that are not IUPAC nucleotide codes.
+func (p PCR) Bindings(template, primer seq.Sequence) ([]Binding, error) {
+	pl, err := letters(primer)
+	if err != nil {
+		return nil, err
+	}
+	if m := template.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, ErrNotNucleic
+	}
+	if len(pl) == 0 {
+		return nil, ErrShortSeq
+	}
+	rc := revComp(pl)
+
+	var sites []Binding
+	for pos := template.Start(); pos+len(pl) <= template.End(); pos++ {
+		if n, ok := p.mismatches(template, pos, pl, len(pl)-p.ThreePrime, len(pl)); ok {
+			sites = append(sites, Binding{Primer: primer, From: pos, To: pos + len(pl), Strand: seq.Plus, Mismatches: n})
+		}
+		if n, ok := p.mismatches(template, pos, rc, 0, p.ThreePrime); ok {
+			sites = append(sites, Binding{Primer: primer, From: pos, To: pos + len(pl), Strand: seq.Minus, Mismatches: n})
+		}
+	}
+	return sites, nil
+}
+
+// mismatches returns the number of mismatches between the letters l and template starting
+// at pos and whether the site is a binding site. Bases l[from:to] must match exactly.
+func (p PCR) mismatches(template seq.Sequence, pos int, l alphabet.Letters, from, to int) (int, bool) {
+	var n int
+	for i, b := range l {
+		if alphabet.Match(b, template.At(pos+i).L) {
+			continue
+		}
+		if from <= i && i < to {
+			return 0, false
+		}
+		n++
+		if n > p.MaxMismatches {
+			return 0, false
+		}
+	}
+	return n, true
+}
+
+// An Amplicon is a predicted PCR product.
+type Amplicon struct {
+	// Loc is the template.
+	Loc feat.Feature
+
+	// From and To are the positions of the
+	// amplified region on the top strand of
+	// the template.
+	From, To int
+
+	// Forward and Reverse are the primer
+	// binding sites at the start and end of
+	// the product.
+	Forward, Reverse Binding
+
+	// Seq is the top strand sequence of the
+	// product. Within the binding sites, bases
+	// are taken from the primers except where
+	// a degenerate primer base matches the
+	// template base.
+	Seq *linear.Seq
+}
+
+func (a *Amplicon) Name() string {
+	if a == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s:%d..%d", a.Loc.Name(), a.From, a.To)
+}
+
+// Description returns the string "amplicon".
+func (a *Amplicon) Description() string    { return "amplicon" }
+func (a *Amplicon) Start() int             { return a.From }
+func (a *Amplicon) End() int               { return a.To }
+func (a *Amplicon) Len() int               { return a.To - a.From }
+func (a *Amplicon) Location() feat.Feature { return a.Loc }
+
+// Amplify returns the products of PCR on the linear template using the given primers,
+// sorted by position. Any primer may prime from either strand, so products flanked by two
+// copies of the same primer are reported. Products in which the primer binding sites overlap
+// are not reported. It returns an error if no primers are given, if either sequence is not a
+// nucleic acid sequence or a primer contains letters that are not IUPAC nucleotide codes.
+func (p PCR) Amplify(template seq.Sequence, primers ...seq.Sequence) ([]*Amplicon, error) {
+	if len(primers) == 0 {
+		return nil, errors.New("primer: no primers")
+	}
+	var fwd, rev []Binding
+	for _, pr := range primers {
+		sites, err := p.Bindings(template, pr)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range sites {
+			if b.Strand == seq.Plus {
+				fwd = append(fwd, b)
+			} else {
+				rev = append(rev, b)
+			}
+		}
+	}
+
+	var amplicons []*Amplicon
+	for _, f := range fwd {
+		for _, r := range rev {
+			if r.From < f.To {
+				continue
+			}
+			n := r.To - f.From
+			if n < p.MinLen || (p.MaxLen != 0 && n > p.MaxLen) {
+				continue
+			}
+			amplicons = append(amplicons, &Amplicon{
+				Loc:     template,
+				From:    f.From,
+				To:      r.To,
+				Forward: f,
+				Reverse: r,
+				Seq:     product(template, f, r),
+			})
+		}
+	}
+	sort.Stable(byPosition(amplicons))
+	return amplicons, nil
+}
+
+// product returns the sequence of the product primed at the binding sites f and r.
+func product(template seq.Sequence, f, r Binding) *linear.Seq {
+	fl, _ := letters(f.Primer)
+	rl, _ := letters(r.Primer)
+	rl = revComp(rl)
+	l := make(alphabet.Letters, 0, r.To-f.From)
+	for i := f.From; i < r.To; i++ {
+		t := template.At(i).L
+		switch {
+		case i < f.To:
+			t = primed(fl[i-f.From], t)
+		case i >= r.From:
+			t = primed(rl[i-r.From], t)
+		}
+		l = append(l, t)
+	}
+	s := linear.NewSeq(fmt.Sprintf("%s:%d..%d", template.Name(), f.From, r.To), l, template.Alphabet())
+	s.Offset = f.From
+	return s
+}
+
+// primed returns the product base for the primer base p annealed to the template base t.
+func primed(p, t alphabet.Letter) alphabet.Letter {
+	if len(alphabet.Expand(p)) > 1 && alphabet.Match(p, t) {
+		return t
+	}
+	return p
+}
+
+// revComp returns the reverse complement of the IUPAC letters l, preserving case and with
+// 'u' read as 't'.
+func revComp(l alphabet.Letters) alphabet.Letters {
+	comp := alphabet.DNAredundant.ComplementTable()
+	rc := make(alphabet.Letters, len(l))
+	for i, b := range l {
+		switch b {
+		case 'u':
+			b = 't'
+		case 'U':
+			b = 'T'
+		}
+		rc[len(l)-1-i] = comp[b]
+	}
+	return rc
+}
+
+type byPosition []*Amplicon
+
+func (a byPosition) Len() int { return len(a) }
+func (a byPosition) Less(i, j int) bool {
+	if a[i].From != a[j].From {
+		return a[i].From < a[j].From
+	}
+	return a[i].To < a[j].To
+}
+func (a byPosition) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
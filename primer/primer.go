@@ -0,0 +1,327 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package primer provides oligonucleotide primer design utilities including melting
+// temperature calculation and detection of secondary structure.
+package primer
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrNotNucleic = errors.New("primer: sequence is not nucleic acid")
+	ErrShortSeq   = errors.New("primer: sequence too short")
+)
+
+// bases returns the lower case bases of s with 'u' read as 't'. It returns an error if s
+// is not a nucleic acid sequence or if concrete is true and s contains letters other than
+// a, c, g, t and u.
+func bases(s seq.Sequence, concrete bool) ([]byte, error) {
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, ErrNotNucleic
+	}
+	b := make([]byte, 0, s.Len())
+	for i := s.Start(); i < s.End(); i++ {
+		l := byte(s.At(i).L) | ('a' - 'A')
+		if l == 'u' {
+			l = 't'
+		}
+		if concrete && complement(l) == 0 {
+			return nil, fmt.Errorf("primer: ambiguous base %q at position %d", s.At(i).L, i)
+		}
+		b = append(b, l)
+	}
+	return b, nil
+}
+
+// complement returns the Watson-Crick complement of the normalised base b, or zero if b is
+// not a concrete base.
+func complement(b byte) byte {
+	switch b {
+	case 'a':
+		return 't'
+	case 'c':
+		return 'g'
+	case 'g':
+		return 'c'
+	case 't':
+		return 'a'
+	}
+	return 0
+}
+
+// Wallace returns the melting temperature of s in degrees Celsius calculated using the
+// Wallace rule, 2°C for each A or T and 4°C for each G or C. The Wallace rule is only
+// appropriate for oligonucleotides shorter than about 14 bases. It returns an error if s is
+// not a nucleic acid sequence or contains ambiguous bases.
+func Wallace(s seq.Sequence) (float64, error) {
+	b, err := bases(s, true)
+	if err != nil {
+		return 0, err
+	}
+	var tm float64
+	for _, l := range b {
+		switch l {
+		case 'a', 't':
+			tm += 2
+		case 'c', 'g':
+			tm += 4
+		}
+	}
+	return tm, nil
+}
+
+// thermo holds nearest-neighbor enthalpy and entropy values in kcal/mol and cal/K/mol.
+type thermo struct {
+	dH, dS float64
+}
+
+// unified holds the unified nearest-neighbor parameters for Watson-Crick pairs from
+// SantaLucia (1998) keyed by the 5'-3' dinucleotide of one strand.
+var unified = map[string]thermo{
+	"aa": {-7.9, -22.2}, "tt": {-7.9, -22.2},
+	"at": {-7.2, -20.4},
+	"ta": {-7.2, -21.3},
+	"ca": {-8.5, -22.7}, "tg": {-8.5, -22.7},
+	"gt": {-8.4, -22.4}, "ac": {-8.4, -22.4},
+	"ct": {-7.8, -21.0}, "ag": {-7.8, -21.0},
+	"ga": {-8.2, -22.2}, "tc": {-8.2, -22.2},
+	"cg": {-10.6, -27.2},
+	"gc": {-9.8, -24.4},
+	"gg": {-8.0, -19.9}, "cc": {-8.0, -19.9},
+}
+
+var (
+	initGC   = thermo{0.1, -2.8}
+	initAT   = thermo{2.3, 4.1}
+	symmetry = thermo{0, -1.4}
+)
+
+// gasConstant is the gas constant in cal/K/mol.
+const gasConstant = 1.987
+
+// NearestNeighbor is the nearest-neighbor melting temperature calculator type using the
+// unified parameters and entropic salt correction of:
+//
+//	A unified view of polymer, dumbbell, and oligonucleotide DNA nearest-neighbor thermodynamics.
+//	 John SantaLucia Jr. Proc Natl Acad Sci USA 95:1460–1465 (1998).
+//
+// Divalent cations are accounted for by conversion to a sodium equivalent concentration of
+// [Na+] + 120 * sqrt([Mg2+] - [dNTP]).
+type NearestNeighbor struct {
+	// Na, Mg and DNTP are the concentrations of
+	// monovalent cations, Mg2+ and dNTPs in mM.
+	Na, Mg, DNTP float64
+
+	// Conc is the total concentration of
+	// strands in nM. The primer and its
+	// target are assumed to be equimolar,
+	// as is done by Primer3, unless the
+	// primer is self-complementary.
+	Conc float64
+}
+
+// DefaultConditions are typical PCR reaction conditions: 50 mM Na+, 1.5 mM Mg2+, 0.2 mM
+// dNTPs and 250 nM total strand concentration.
+var DefaultConditions = NearestNeighbor{Na: 50, Mg: 1.5, DNTP: 0.2, Conc: 250}
+
+// Tm returns the melting temperature of s in degrees Celsius. The effective strand
+// concentration is Conc/4 for equimolar non-self-complementary strands and Conc for
+// self-complementary sequences. It returns an error if s is not a nucleic acid sequence, is
+// shorter than two bases or contains ambiguous bases, or if the cation or strand
+// concentration is not positive.
+func (p NearestNeighbor) Tm(s seq.Sequence) (float64, error) {
+	dH, dS, err := p.thermodynamics(s)
+	if err != nil {
+		return 0, err
+	}
+	if p.Conc <= 0 {
+		return 0, errors.New("primer: primer concentration not positive")
+	}
+	b, _ := bases(s, true)
+	x := 4.
+	if isSelfComplementary(b) {
+		x = 1
+	}
+	return dH*1000/(dS+gasConstant*math.Log(p.Conc*1e-9/x)) - 273.15, nil
+}
+
+// DeltaG returns the Gibbs free energy change of duplex formation of s with its complement
+// in kcal/mol at temp degrees Celsius. It returns an error if s is not a nucleic acid
+// sequence, is shorter than two bases or contains ambiguous bases, or if the cation
+// concentration is not positive.
+func (p NearestNeighbor) DeltaG(s seq.Sequence, temp float64) (float64, error) {
+	dH, dS, err := p.thermodynamics(s)
+	if err != nil {
+		return 0, err
+	}
+	return dH - (temp+273.15)*dS/1000, nil
+}
+
+// thermodynamics returns the salt corrected enthalpy and entropy of duplex formation of s.
+func (p NearestNeighbor) thermodynamics(s seq.Sequence) (dH, dS float64, err error) {
+	b, err := bases(s, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(b) < 2 {
+		return 0, 0, ErrShortSeq
+	}
+	na := p.Na
+	if free := p.Mg - p.DNTP; free > 0 {
+		na += 120 * math.Sqrt(free)
+	}
+	if na <= 0 {
+		return 0, 0, errors.New("primer: cation concentration not positive")
+	}
+
+	for i := 0; i+1 < len(b); i++ {
+		t := unified[string(b[i:i+2])]
+		dH += t.dH
+		dS += t.dS
+	}
+	for _, end := range []byte{b[0], b[len(b)-1]} {
+		t := initAT
+		if end == 'c' || end == 'g' {
+			t = initGC
+		}
+		dH += t.dH
+		dS += t.dS
+	}
+	if isSelfComplementary(b) {
+		dH += symmetry.dH
+		dS += symmetry.dS
+	}
+	dS += 0.368 * float64(len(b)-1) * math.Log(na/1000)
+
+	return dH, dS, nil
+}
+
+// isSelfComplementary returns whether b is equal to its reverse complement.
+func isSelfComplementary(b []byte) bool {
+	for i, j := 0, len(b)-1; i <= j; i, j = i+1, j-1 {
+		if b[i] != complement(b[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+// GCClamp returns the number of G and C bases in the n bases at the 3' end of s. It returns
+// an error if s is not a nucleic acid sequence.
+func GCClamp(s seq.Sequence, n int) (int, error) {
+	b, err := bases(s, false)
+	if err != nil {
+		return 0, err
+	}
+	var gc int
+	for i := len(b) - 1; i >= 0 && i >= len(b)-n; i-- {
+		if b[i] == 'c' || b[i] == 'g' {
+			gc++
+		}
+	}
+	return gc, nil
+}
+
+// A Duplex is a run of consecutive Watson-Crick base pairs between two antiparallel
+// sequences, A and B. The base at position A+i of the first sequence pairs with the base
+// at position B-i of the second sequence for i from zero to Len-1. Positions are relative
+// to the start of the sequences.
+type Duplex struct {
+	A, B int
+	Len  int
+}
+
+// Hairpins returns the hairpin stems of s with at least minStem base pairs enclosing a loop
+// of at least minLoop bases. Only the longest stem is reported for each run of pairs, and
+// stems are reported in order of their 5' position. It returns an error if s is not a
+// nucleic acid sequence.
+func Hairpins(s seq.Sequence, minStem, minLoop int) ([]Duplex, error) {
+	b, err := bases(s, false)
+	if err != nil {
+		return nil, err
+	}
+	var hairpins []Duplex
+	for _, d := range duplexes(b, b, minStem) {
+		// Trim the stem to leave a loop of
+		// at least minLoop bases.
+		for d.Len >= minStem && (d.B-d.Len+1)-(d.A+d.Len) < minLoop {
+			d.Len--
+		}
+		if d.Len >= minStem && d.A < d.B {
+			hairpins = append(hairpins, d)
+		}
+	}
+	return hairpins, nil
+}
+
+// Dimers returns the duplexes of at least minLen base pairs that can form between a and b.
+// Duplexes are reported in order of their position in a. It returns an error if either
+// sequence is not a nucleic acid sequence.
+func Dimers(a, b seq.Sequence, minLen int) ([]Duplex, error) {
+	ab, err := bases(a, false)
+	if err != nil {
+		return nil, err
+	}
+	bb, err := bases(b, false)
+	if err != nil {
+		return nil, err
+	}
+	return duplexes(ab, bb, minLen), nil
+}
+
+// SelfDimers returns the duplexes of at least minLen base pairs that can form between two
+// copies of s. Each duplex is reported once, with A less than or equal to the position of
+// the 5' base of the second copy in the duplex. It returns an error if s is not a nucleic
+// acid sequence.
+func SelfDimers(s seq.Sequence, minLen int) ([]Duplex, error) {
+	d, err := Dimers(s, s, minLen)
+	if err != nil {
+		return nil, err
+	}
+	var self []Duplex
+	for _, x := range d {
+		if x.A <= x.B-x.Len+1 {
+			self = append(self, x)
+		}
+	}
+	return self, nil
+}
+
+// duplexes returns the maximal runs of at least minLen complementary bases between a and
+// the antiparallel b.
+func duplexes(a, b []byte, minLen int) []Duplex {
+	if minLen < 1 {
+		minLen = 1
+	}
+	var d []Duplex
+	for i := range a {
+		for j := range b {
+			// Only report maximal runs.
+			if i > 0 && j+1 < len(b) && pairs(a[i-1], b[j+1]) {
+				continue
+			}
+			n := 0
+			for i+n < len(a) && j-n >= 0 && pairs(a[i+n], b[j-n]) {
+				n++
+			}
+			if n >= minLen {
+				d = append(d, Duplex{A: i, B: j, Len: n})
+			}
+		}
+	}
+	return d
+}
+
+// pairs returns whether the normalised bases x and y form a Watson-Crick pair.
+func pairs(x, y byte) bool {
+	c := complement(x)
+	return c != 0 && c == y
+}
@@ -0,0 +1,85 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package primer
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleNearestNeighbor_Tm() {
+	p := linear.NewSeq("M13R", alphabet.BytesToLetters([]byte("CAGGAAACAGCTATGAC")), alphabet.DNA)
+
+	wallace, err := Wallace(p)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	nn, err := DefaultConditions.Tm(p)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	clamp, err := GCClamp(p, 5)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Wallace:%.1f NN:%.1f clamp:%d\n", wallace, nn, clamp)
+	// Output:
+	// Wallace:50.0 NN:52.3 clamp:2
+}
+
+func ExampleHairpins() {
+	p := linear.NewSeq("hairpin", alphabet.BytesToLetters([]byte("ATGCCGCAAATTGCGGCTTA")), alphabet.DNA)
+
+	hairpins, err := Hairpins(p, 4, 3)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, h := range hairpins {
+		fmt.Printf("stem %d..%d/%d..%d\n", h.A, h.A+h.Len, h.B-h.Len+1, h.B+1)
+	}
+
+	dimers, err := SelfDimers(p, 6)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(dimers)
+	// Output:
+	// stem 2..8/11..17
+	// [{2 16 7}]
+}
+
+func ExamplePCR_Amplify() {
+	template := linear.NewSeq("template", alphabet.BytesToLetters([]byte(
+		"ttttACGTGGCAaaaaaaaaaaGGATCCgggggTTGCATGGcccc",
+	)), alphabet.DNA)
+	fwd := linear.NewSeq("fwd", alphabet.BytesToLetters([]byte("ACRTGGCA")), alphabet.DNAredundant)
+	rev := linear.NewSeq("rev", alphabet.BytesToLetters([]byte("cCATGCAA")), alphabet.DNAredundant)
+
+	variants, err := Expand(fwd)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("fwd variants:", variants)
+
+	amplicons, err := PCR{MaxMismatches: 1, ThreePrime: 5}.Amplify(template, fwd, rev)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, a := range amplicons {
+		fmt.Printf("%s %d bp %s\n", a.Name(), a.Len(), a.Seq.Seq)
+	}
+	// Output:
+	// fwd variants: [ACATGGCA ACGTGGCA]
+	// template:4..41 37 bp ACGTGGCAaaaaaaaaaaGGATCCgggggTTGCATGg
+}
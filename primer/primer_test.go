@@ -0,0 +1,172 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package primer
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func dna(s string) seq.Sequence {
+	return linear.NewSeq("", alphabet.BytesToLetters([]byte(s)), alphabet.DNA)
+}
+
+func (s *S) TestNearestNeighbor(c *check.C) {
+	// Values at 1 M Na+ calculated from the ΔG°37
+	// parameters of SantaLucia (1998) Table 2.
+	p := NearestNeighbor{Na: 1000, Conc: 1}
+	for _, t := range []struct {
+		seq  string
+		dG37 float64
+	}{
+		{seq: "CGCGAATTCGCG", dG37: -16.25},
+		{seq: "GGACTGACG", dG37: -10.26},
+	} {
+		dg, err := p.DeltaG(dna(t.seq), 37)
+		c.Assert(err, check.Equals, nil)
+		c.Check(math.Abs(dg-t.dG37) < 0.1, check.Equals, true, check.Commentf("%s: %f", t.seq, dg))
+	}
+
+	// Tm increases with salt and primer concentration.
+	s1, err := NearestNeighbor{Na: 50, Conc: 250}.Tm(dna("AGCGGATAACAATTTCACACAGGA"))
+	c.Assert(err, check.Equals, nil)
+	s2, err := NearestNeighbor{Na: 50, Mg: 2, Conc: 250}.Tm(dna("AGCGGATAACAATTTCACACAGGA"))
+	c.Assert(err, check.Equals, nil)
+	s3, err := NearestNeighbor{Na: 50, Mg: 2, Conc: 1000}.Tm(dna("AGCGGATAACAATTTCACACAGGA"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(s1 < s2 && s2 < s3, check.Equals, true, check.Commentf("%f %f %f", s1, s2, s3))
+
+	_, err = DefaultConditions.Tm(dna("ACGTNACGT"))
+	c.Check(err, check.ErrorMatches, `primer: ambiguous base 'N' at position 4`)
+	_, err = DefaultConditions.Tm(dna("A"))
+	c.Check(err, check.Equals, ErrShortSeq)
+	_, err = NearestNeighbor{Conc: 250}.Tm(dna("ACGT"))
+	c.Check(err, check.ErrorMatches, "primer: cation concentration not positive")
+	_, err = Wallace(linear.NewSeq("", alphabet.BytesToLetters([]byte("MKV")), alphabet.Protein))
+	c.Check(err, check.Equals, ErrNotNucleic)
+}
+
+func (s *S) TestSecondaryStructure(c *check.C) {
+	// Stems are shortened to leave the loop.
+	h, err := Hairpins(dna("GGGGAACCCC"), 3, 3)
+	c.Assert(err, check.Equals, nil)
+	c.Check(h, check.DeepEquals, []Duplex{{A: 0, B: 8, Len: 3}, {A: 0, B: 9, Len: 3}, {A: 1, B: 9, Len: 3}})
+	h, err = Hairpins(dna("GGGGAACCCC"), 4, 3)
+	c.Assert(err, check.Equals, nil)
+	c.Check(h, check.IsNil)
+
+	d, err := Dimers(dna("AAAACCCGGG"), dna("TTTTTTTTTT"), 4)
+	c.Assert(err, check.Equals, nil)
+	c.Check(d, check.DeepEquals, []Duplex{
+		{A: 0, B: 3, Len: 4}, {A: 0, B: 4, Len: 4}, {A: 0, B: 5, Len: 4},
+		{A: 0, B: 6, Len: 4}, {A: 0, B: 7, Len: 4}, {A: 0, B: 8, Len: 4},
+		{A: 0, B: 9, Len: 4},
+	})
+	d, err = SelfDimers(dna("ACGTACGT"), 8)
+	c.Assert(err, check.Equals, nil)
+	c.Check(d, check.DeepEquals, []Duplex{{A: 0, B: 7, Len: 8}})
+
+	n, err := GCClamp(dna("AAAAGCTGC"), 3)
+	c.Assert(err, check.Equals, nil)
+	c.Check(n, check.Equals, 2)
+}
+
+func (s *S) TestExpand(c *check.C) {
+	n, err := Degeneracy(dna("ACRYN"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(n, check.Equals, 16)
+
+	e, err := Expand(dna("AcRt"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(e, check.DeepEquals, []alphabet.Letters{
+		alphabet.Letters("AcAt"), alphabet.Letters("AcGt"),
+	})
+	e, err = Expand(dna("SW"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(e, check.DeepEquals, []alphabet.Letters{
+		alphabet.Letters("CA"), alphabet.Letters("CT"), alphabet.Letters("GA"), alphabet.Letters("GT"),
+	})
+
+	_, err = Expand(dna("ACXT"))
+	c.Check(err, check.ErrorMatches, `primer: invalid base 'X' at position 2`)
+}
+
+func (s *S) TestAmplify(c *check.C) {
+	template := linear.NewSeq("template", alphabet.BytesToLetters([]byte(
+		"ttttACGTGGCAaaaaaaaaaaGGATCCgggggTTGCATGGcccc",
+	)), alphabet.DNA)
+	fwd := dna("ACGTGGCA")
+	rev := dna("CCATGCAA")
+
+	b, err := PCR{}.Bindings(template, fwd)
+	c.Assert(err, check.Equals, nil)
+	c.Check(b, check.DeepEquals, []Binding{{Primer: fwd, From: 4, To: 12, Strand: seq.Plus}})
+	b, err = PCR{}.Bindings(template, rev)
+	c.Assert(err, check.Equals, nil)
+	c.Check(b, check.DeepEquals, []Binding{{Primer: rev, From: 33, To: 41, Strand: seq.Minus}})
+
+	// Mismatches are allowed away from the 3' end.
+	mm := dna("ACcTGGCA")
+	b, err = PCR{}.Bindings(template, mm)
+	c.Assert(err, check.Equals, nil)
+	c.Check(b, check.HasLen, 0)
+	b, err = PCR{MaxMismatches: 1, ThreePrime: 3}.Bindings(template, mm)
+	c.Assert(err, check.Equals, nil)
+	c.Check(b, check.DeepEquals, []Binding{{Primer: mm, From: 4, To: 12, Strand: seq.Plus, Mismatches: 1}})
+	b, err = PCR{MaxMismatches: 1, ThreePrime: 3}.Bindings(template, dna("ACGTGGgA"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(b, check.HasLen, 0)
+	b, err = PCR{MaxMismatches: 1, ThreePrime: 3}.Bindings(template, dna("gCATGCAA"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(b, check.HasLen, 1)
+	b, err = PCR{MaxMismatches: 1, ThreePrime: 3}.Bindings(template, dna("CCATGCAt"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(b, check.HasLen, 0)
+
+	a, err := PCR{MaxMismatches: 1, ThreePrime: 3}.Amplify(template, mm, dna("gCATGCAA"))
+	c.Assert(err, check.Equals, nil)
+	c.Assert(a, check.HasLen, 1)
+	c.Check(a[0].Name(), check.Equals, "template:4..41")
+	c.Check(a[0].Len(), check.Equals, 37)
+	c.Check(a[0].Seq.Seq, check.DeepEquals, alphabet.Letters("ACcTGGCAaaaaaaaaaaGGATCCgggggTTGCATGc"))
+	c.Check(a[0].Seq.Start(), check.Equals, 4)
+
+	// Degenerate primers take the template base.
+	a, err = PCR{}.Amplify(template, dna("ACNTGGCA"), rev)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(a, check.HasLen, 1)
+	c.Check(a[0].Seq.Seq[:8], check.DeepEquals, alphabet.Letters("ACGTGGCA"))
+
+	a, err = PCR{MinLen: 38}.Amplify(template, fwd, rev)
+	c.Assert(err, check.Equals, nil)
+	c.Check(a, check.HasLen, 0)
+	a, err = PCR{MaxLen: 36}.Amplify(template, fwd, rev)
+	c.Assert(err, check.Equals, nil)
+	c.Check(a, check.HasLen, 0)
+
+	// A single primer can amplify from inverted repeats.
+	ir := linear.NewSeq("ir", alphabet.BytesToLetters([]byte("ACGTGGCAttttTGCCACGT")), alphabet.DNA)
+	a, err = PCR{}.Amplify(ir, fwd)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(a, check.HasLen, 1)
+	c.Check(a[0].Forward.Primer, check.Equals, fwd)
+	c.Check(a[0].Reverse.Primer, check.Equals, fwd)
+
+	_, err = PCR{}.Amplify(template)
+	c.Check(err, check.ErrorMatches, "primer: no primers")
+}
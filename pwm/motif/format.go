@@ -0,0 +1,264 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package motif
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadJASPAR returns the motifs in the JASPAR count matrix format read from r. Each motif
+// is introduced by a header line of the form ">ID name" followed by four rows of counts.
+// Rows may be labelled with their base and have their counts enclosed in brackets, for
+// example "A [ 4 19 0 ]", and unlabelled rows are taken to be in a, c, g, t order. A matrix
+// without a header is read as a motif without an ID.
+func ReadJASPAR(r io.Reader) ([]*Motif, error) {
+	var (
+		motifs []*Motif
+		cur    *Motif
+		rows   [4][]float64
+		seen   [4]bool
+		n      int
+		line   int
+	)
+	finish := func() error {
+		if cur == nil {
+			return nil
+		}
+		if n != 4 {
+			return fmt.Errorf("motif: line %d: incomplete matrix for %q", line, cur.ID)
+		}
+		l := len(rows[0])
+		for _, r := range rows[1:] {
+			if len(r) != l {
+				return fmt.Errorf("motif: line %d: ragged matrix for %q", line, cur.ID)
+			}
+		}
+		cur.Counts = make([][4]float64, l)
+		for b, r := range rows {
+			for i, v := range r {
+				cur.Counts[i][b] = v
+			}
+		}
+		motifs = append(motifs, cur)
+		cur, rows, seen, n = nil, [4][]float64{}, [4]bool{}, 0
+		return nil
+	}
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line++
+		text := strings.TrimSpace(sc.Text())
+		if text == "" {
+			continue
+		}
+		if text[0] == '>' {
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			f := strings.Fields(text[1:])
+			cur = &Motif{}
+			if len(f) > 0 {
+				cur.ID = f[0]
+				cur.Name = strings.Join(f[1:], " ")
+			}
+			continue
+		}
+		if cur == nil {
+			cur = &Motif{}
+		}
+		if n == 4 {
+			return nil, fmt.Errorf("motif: line %d: too many rows for %q", line, cur.ID)
+		}
+		b := n
+		if idx := baseIndex(alphabet.Letter(text[0])); idx >= 0 {
+			b = idx
+			text = text[1:]
+		}
+		if seen[b] {
+			return nil, fmt.Errorf("motif: line %d: duplicate row for %c", line, "ACGT"[b])
+		}
+		text = strings.NewReplacer("[", " ", "]", " ").Replace(text)
+		row, err := parseFloats(strings.Fields(text))
+		if err != nil {
+			return nil, fmt.Errorf("motif: line %d: %v", line, err)
+		}
+		rows[b] = row
+		seen[b] = true
+		n++
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	return motifs, nil
+}
+
+// ReadMEME returns the motifs and background base distribution in the MEME minimal motif
+// format read from r. Counts are calculated from the letter-probability matrix of each motif
+// and its number of sites, which is taken to be 20 if not given. If the input does not
+// specify background letter frequencies, Uniform is returned. It returns an error if the
+// alphabet of the motifs is not DNA or RNA.
+func ReadMEME(r io.Reader) ([]*Motif, Background, error) {
+	var (
+		motifs []*Motif
+		cur    *Motif
+		bg     = Uniform
+		inBg   bool
+		bgSeen int
+
+		// rows is the number of matrix rows
+		// remaining to be read for cur.
+		rows   int
+		nsites float64
+
+		line int
+	)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line++
+		text := strings.TrimSpace(sc.Text())
+		switch {
+		case rows > 0:
+			if text == "" {
+				continue
+			}
+			p, err := parseFloats(strings.Fields(text))
+			if err != nil {
+				return nil, Background{}, fmt.Errorf("motif: line %d: %v", line, err)
+			}
+			if len(p) != 4 {
+				return nil, Background{}, fmt.Errorf("motif: line %d: expected 4 probabilities, got %d", line, len(p))
+			}
+			var c [4]float64
+			for b, v := range p {
+				c[b] = v * nsites
+			}
+			cur.Counts = append(cur.Counts, c)
+			rows--
+		case inBg:
+			if text == "" {
+				if bgSeen > 0 {
+					inBg = false
+				}
+				continue
+			}
+			f := strings.Fields(text)
+			if len(f)%2 != 0 {
+				return nil, Background{}, fmt.Errorf("motif: line %d: malformed background", line)
+			}
+			for i := 0; i < len(f); i += 2 {
+				b := -1
+				if len(f[i]) == 1 {
+					b = baseIndex(alphabet.Letter(f[i][0]))
+				}
+				if b < 0 {
+					return nil, Background{}, fmt.Errorf("motif: line %d: unknown letter %q", line, f[i])
+				}
+				v, err := strconv.ParseFloat(f[i+1], 64)
+				if err != nil {
+					return nil, Background{}, fmt.Errorf("motif: line %d: %v", line, err)
+				}
+				bg[b] = v
+				bgSeen++
+			}
+			if bgSeen >= 4 {
+				inBg = false
+			}
+		case strings.HasPrefix(text, "ALPHABET="):
+			switch a := strings.ToUpper(strings.TrimSpace(strings.TrimPrefix(text, "ALPHABET="))); a {
+			case "ACGT", "ACGU":
+			default:
+				return nil, Background{}, fmt.Errorf("motif: line %d: unsupported alphabet %q", line, a)
+			}
+		case strings.HasPrefix(text, "Background letter frequencies"):
+			inBg = true
+		case strings.HasPrefix(text, "MOTIF"):
+			f := strings.Fields(text)
+			if len(f) < 2 {
+				return nil, Background{}, fmt.Errorf("motif: line %d: missing motif identifier", line)
+			}
+			cur = &Motif{ID: f[1], Name: strings.Join(f[2:], " ")}
+			motifs = append(motifs, cur)
+		case strings.HasPrefix(text, "letter-probability matrix:"):
+			if cur == nil {
+				return nil, Background{}, fmt.Errorf("motif: line %d: matrix without motif", line)
+			}
+			params, err := parseParams(strings.TrimPrefix(text, "letter-probability matrix:"))
+			if err != nil {
+				return nil, Background{}, fmt.Errorf("motif: line %d: %v", line, err)
+			}
+			if a, ok := params["alength"]; ok && a != 4 {
+				return nil, Background{}, fmt.Errorf("motif: line %d: unsupported alphabet length %v", line, a)
+			}
+			w, ok := params["w"]
+			if !ok || w < 1 {
+				return nil, Background{}, fmt.Errorf("motif: line %d: missing motif width", line)
+			}
+			rows = int(w)
+			nsites = 20
+			if n, ok := params["nsites"]; ok {
+				nsites = n
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, Background{}, err
+	}
+	if rows > 0 {
+		return nil, Background{}, fmt.Errorf("motif: line %d: incomplete matrix for %q", line, cur.ID)
+	}
+	if bgSeen > 0 && bgSeen != 4 {
+		return nil, Background{}, errors.New("motif: incomplete background")
+	}
+	bg, err := bg.normalised()
+	if err != nil {
+		return nil, Background{}, err
+	}
+	for _, m := range motifs {
+		if m.Len() == 0 {
+			return nil, Background{}, fmt.Errorf("motif: no matrix for %q", m.ID)
+		}
+	}
+	return motifs, bg, nil
+}
+
+// parseParams parses the "key= value" pairs of a MEME matrix header.
+func parseParams(s string) (map[string]float64, error) {
+	f := strings.Fields(strings.Replace(s, "=", "= ", -1))
+	p := make(map[string]float64)
+	for i := 0; i+1 < len(f); i += 2 {
+		if !strings.HasSuffix(f[i], "=") {
+			return nil, fmt.Errorf("malformed parameter %q", f[i])
+		}
+		v, err := strconv.ParseFloat(f[i+1], 64)
+		if err != nil {
+			return nil, err
+		}
+		p[strings.TrimSuffix(f[i], "=")] = v
+	}
+	return p, nil
+}
+
+// parseFloats returns the values of the fields f.
+func parseFloats(f []string) ([]float64, error) {
+	v := make([]float64, len(f))
+	for i, s := range f {
+		var err error
+		v[i], err = strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
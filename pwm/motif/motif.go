@@ -0,0 +1,378 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package motif provides log-odds position weight matrices for scoring and scanning nucleic
+// acid sequences for motif occurrences, and readers for the JASPAR and MEME motif formats.
+package motif
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"math"
+)
+
+// granularity is the resolution in bits of PWM weights.
+const granularity = 1e-3
+
+var ErrNotNucleic = errors.New("motif: sequence is not nucleic acid")
+
+// A Background holds the frequencies of the bases a, c, g and t in that order.
+type Background [4]float64
+
+// Uniform is the equiprobable background base distribution.
+var Uniform = Background{0.25, 0.25, 0.25, 0.25}
+
+// BackgroundOf returns the frequencies of the unambiguous bases in the sequences, with u read
+// as t. It returns an error if a sequence is not a nucleic acid sequence or if the sequences
+// contain no unambiguous bases.
+func BackgroundOf(s ...seq.Sequence) (Background, error) {
+	var (
+		bg Background
+		n  int
+	)
+	for _, r := range s {
+		if !isNucleic(r) {
+			return Background{}, ErrNotNucleic
+		}
+		for i := r.Start(); i < r.End(); i++ {
+			if b := baseIndex(r.At(i).L); b >= 0 {
+				bg[b]++
+				n++
+			}
+		}
+	}
+	if n == 0 {
+		return Background{}, errors.New("motif: no unambiguous bases")
+	}
+	for i := range bg {
+		bg[i] /= float64(n)
+	}
+	return bg, nil
+}
+
+// normalised returns b scaled to sum to one, or Uniform if b is the zero Background. It
+// returns an error if any frequency is not positive.
+func (b Background) normalised() (Background, error) {
+	if b == (Background{}) {
+		return Uniform, nil
+	}
+	var sum float64
+	for _, f := range b {
+		if !(f > 0) {
+			return Background{}, errors.New("motif: background frequency not positive")
+		}
+		sum += f
+	}
+	for i := range b {
+		b[i] /= sum
+	}
+	return b, nil
+}
+
+// A Motif is a position frequency matrix describing a sequence motif.
+type Motif struct {
+	// ID and Name are the identifier and
+	// name of the motif.
+	ID, Name string
+
+	// Counts holds the observed counts of
+	// the bases a, c, g and t at each
+	// position of the motif. Counts need
+	// not be integers.
+	Counts [][4]float64
+}
+
+// Len returns the length of the motif.
+func (m *Motif) Len() int { return len(m.Counts) }
+
+// A PWM is a log-odds position weight matrix.
+type PWM struct {
+	// weights holds the log-odds weights
+	// in units of granularity.
+	weights [][4]int
+	bg      Background
+
+	// minSum is the lowest score in units
+	// of granularity and tail holds the
+	// probability under the background of
+	// scoring at least minSum+i units.
+	minSum int
+	tail   []float64
+}
+
+// NewPWM returns a PWM for the motif m scored against the background base distribution bg.
+// If bg is the zero Background, Uniform is used. The probability of base b at each position
+// is estimated as (count_b + pseudo*bg_b) / (total + pseudo) and the weight of b is the log2
+// ratio of that probability to bg_b, rounded to 0.001 bits so that the distribution of
+// scores, and so p-values, can be calculated exactly. It returns an error if m is empty, a
+// background frequency or pseudo is negative, or a base has a zero probability at any
+// position, which can be avoided by giving a positive pseudo-count.
+func NewPWM(m *Motif, bg Background, pseudo float64) (*PWM, error) {
+	if m.Len() == 0 {
+		return nil, errors.New("motif: empty motif")
+	}
+	if pseudo < 0 {
+		return nil, errors.New("motif: negative pseudo-count")
+	}
+	bg, err := bg.normalised()
+	if err != nil {
+		return nil, err
+	}
+	w := make([][4]int, m.Len())
+	for i, c := range m.Counts {
+		var n float64
+		for _, v := range c {
+			if v < 0 {
+				return nil, fmt.Errorf("motif: negative count at position %d", i)
+			}
+			n += v
+		}
+		for b, v := range c {
+			p := (v + pseudo*bg[b]) / (n + pseudo)
+			if !(p > 0) {
+				return nil, fmt.Errorf("motif: zero probability for %c at position %d", "acgt"[b], i)
+			}
+			w[i][b] = int(math.Floor(math.Log2(p/bg[b])/granularity + 0.5))
+		}
+	}
+	pwm := &PWM{weights: w, bg: bg}
+	pwm.distribution()
+	return pwm, nil
+}
+
+// distribution calculates the distribution of scores of sequences drawn from the
+// background.
+func (m *PWM) distribution() {
+	dist := []float64{1}
+	m.minSum = 0
+	for _, w := range m.weights {
+		lo, hi := minMax(w)
+		next := make([]float64, len(dist)+hi-lo)
+		for k, p := range dist {
+			if p == 0 {
+				continue
+			}
+			for b, v := range w {
+				next[k+v-lo] += p * m.bg[b]
+			}
+		}
+		dist = next
+		m.minSum += lo
+	}
+	m.tail = make([]float64, len(dist)+1)
+	for k := len(dist) - 1; k >= 0; k-- {
+		m.tail[k] = m.tail[k+1] + dist[k]
+	}
+}
+
+// minMax returns the minimum and maximum of the weights w.
+func minMax(w [4]int) (min, max int) {
+	min, max = w[0], w[0]
+	for _, v := range w[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return min, max
+}
+
+// Len returns the length of the motif described by m.
+func (m *PWM) Len() int { return len(m.weights) }
+
+// Background returns the background base distribution used by m.
+func (m *PWM) Background() Background { return m.bg }
+
+// Weight returns the log-odds weight of the base l at position i of the motif. It returns
+// NaN if l is not an unambiguous base.
+func (m *PWM) Weight(i int, l alphabet.Letter) float64 {
+	b := baseIndex(l)
+	if b < 0 {
+		return math.NaN()
+	}
+	return float64(m.weights[i][b]) * granularity
+}
+
+// MinScore returns the lowest score attainable by m.
+func (m *PWM) MinScore() float64 {
+	var s int
+	for _, w := range m.weights {
+		min, _ := minMax(w)
+		s += min
+	}
+	return float64(s) * granularity
+}
+
+// MaxScore returns the highest score attainable by m.
+func (m *PWM) MaxScore() float64 {
+	var s int
+	for _, w := range m.weights {
+		_, max := minMax(w)
+		s += max
+	}
+	return float64(s) * granularity
+}
+
+// PValue returns the probability that a sequence drawn from the background scores at least
+// score.
+func (m *PWM) PValue(score float64) float64 {
+	// Allow for floating point error in
+	// scores that lie on the weight grid.
+	k := math.Ceil(score/granularity-1e-6) - float64(m.minSum)
+	switch {
+	case k <= 0:
+		return 1
+	case k >= float64(len(m.tail)):
+		return 0
+	}
+	return math.Min(m.tail[int(k)], 1)
+}
+
+// Threshold returns the lowest score for which PValue returns a value no greater than p. It
+// returns +Inf if no score is that improbable.
+func (m *PWM) Threshold(p float64) float64 {
+	for k, t := range m.tail[:len(m.tail)-1] {
+		if t <= p {
+			return float64(k+m.minSum) * granularity
+		}
+	}
+	return math.Inf(1)
+}
+
+// Score returns the score of the motif occurring at position pos of s on the given strand.
+// On the minus strand the motif is read on the reverse complement of the bases from pos to
+// pos+m.Len(). It returns an error if s is not a nucleic acid sequence, the motif does not
+// fit within s at pos, or the bases include an ambiguous base.
+func (m *PWM) Score(s seq.Sequence, pos int, strand seq.Strand) (float64, error) {
+	if !isNucleic(s) {
+		return 0, ErrNotNucleic
+	}
+	if pos < s.Start() || pos+m.Len() > s.End() {
+		return 0, errors.New("motif: position out of range")
+	}
+	b := make([]int, m.Len())
+	for i := range b {
+		b[i] = baseIndex(s.At(pos + i).L)
+	}
+	score, ok := m.score(b, strand)
+	if !ok {
+		return 0, fmt.Errorf("motif: ambiguous base in %d..%d", pos, pos+m.Len())
+	}
+	return score, nil
+}
+
+// score returns the score of the base indices b on the given strand and whether all the
+// bases are unambiguous.
+func (m *PWM) score(b []int, strand seq.Strand) (float64, bool) {
+	var score int
+	n := len(m.weights)
+	for i, x := range b[:n] {
+		if x < 0 {
+			return 0, false
+		}
+		if strand == seq.Minus {
+			score += m.weights[n-1-i][3-x]
+		} else {
+			score += m.weights[i][x]
+		}
+	}
+	return float64(score) * granularity, true
+}
+
+// A Hit is an occurrence of a motif in a sequence.
+type Hit struct {
+	// Loc is the scanned sequence.
+	Loc feat.Feature
+
+	// From and To are the positions of the
+	// motif occurrence on the top strand.
+	From, To int
+
+	// Strand is the strand holding the motif.
+	Strand seq.Strand
+
+	// Score is the log-odds score of the
+	// occurrence and PValue is its p-value
+	// under the background distribution.
+	Score, PValue float64
+}
+
+func (h *Hit) Name() string {
+	if h == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s:%d..%d(%v)", h.Loc.Name(), h.From, h.To, h.Strand)
+}
+
+// Description returns the string "motif".
+func (h *Hit) Description() string    { return "motif" }
+func (h *Hit) Start() int             { return h.From }
+func (h *Hit) End() int               { return h.To }
+func (h *Hit) Len() int               { return h.To - h.From }
+func (h *Hit) Location() feat.Feature { return h.Loc }
+
+// Scan returns the occurrences of the motif on both strands of s scoring at least minScore,
+// sorted by position with plus strand hits preceding minus strand hits at the same position.
+// Windows including ambiguous bases are not scored. A p-value threshold can be applied by
+// passing the score returned by Threshold. It returns an error if s is not a nucleic acid
+// sequence.
+func (m *PWM) Scan(s seq.Sequence, minScore float64) ([]*Hit, error) {
+	if !isNucleic(s) {
+		return nil, ErrNotNucleic
+	}
+	b := make([]int, s.Len())
+	for i := range b {
+		b[i] = baseIndex(s.At(s.Start() + i).L)
+	}
+	var hits []*Hit
+	for i := 0; i+m.Len() <= len(b); i++ {
+		for _, strand := range [...]seq.Strand{seq.Plus, seq.Minus} {
+			score, ok := m.score(b[i:], strand)
+			if !ok {
+				break
+			}
+			if score < minScore {
+				continue
+			}
+			from := s.Start() + i
+			hits = append(hits, &Hit{
+				Loc:    s,
+				From:   from,
+				To:     from + m.Len(),
+				Strand: strand,
+				Score:  score,
+				PValue: m.PValue(score),
+			})
+		}
+	}
+	return hits, nil
+}
+
+// isNucleic returns whether s is a nucleic acid sequence.
+func isNucleic(s seq.Sequence) bool {
+	m := s.Alphabet().Moltype()
+	return m == feat.DNA || m == feat.RNA
+}
+
+// baseIndex returns the index of the base l in a, c, g, t order, or -1 if l is not an
+// unambiguous base.
+func baseIndex(l alphabet.Letter) int {
+	switch l {
+	case 'a', 'A':
+		return 0
+	case 'c', 'C':
+		return 1
+	case 'g', 'G':
+		return 2
+	case 't', 'T', 'u', 'U':
+		return 3
+	}
+	return -1
+}
@@ -0,0 +1,47 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package motif_test
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/pwm/motif"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"strings"
+)
+
+func ExamplePWM_Scan() {
+	const jaspar = `>MA0004.1 Arnt
+A  [ 4 19  0  0  0  0 ]
+C  [16  0 20  0  0  0 ]
+G  [ 0  1  0 20  0 20 ]
+T  [ 0  0  0  0 20  0 ]
+`
+	motifs, err := motif.ReadJASPAR(strings.NewReader(jaspar))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m, err := motif.NewPWM(motifs[0], motif.Uniform, 0.8)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	s := linear.NewSeq("promoter", alphabet.BytesToLetters([]byte("ttgaCACGTGatcCACGcGaaa")), alphabet.DNA)
+	hits, err := m.Scan(s, m.Threshold(1e-3))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, h := range hits {
+		fmt.Printf("%s %s score=%.2f p=%.2g\n", h.Name(), s.Slice().Slice(h.From, h.To), h.Score, h.PValue)
+	}
+	// Output:
+	// promoter:4..10(+) CACGTG score=11.36 p=0.00024
+	// promoter:4..10(-) CACGTG score=11.36 p=0.00024
+	// promoter:13..19(-) CACGcG score=7.36 p=0.00073
+}
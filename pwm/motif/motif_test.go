@@ -0,0 +1,261 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package motif
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+var tata = &Motif{
+	ID:   "test",
+	Name: "TATA",
+	Counts: [][4]float64{
+		{0, 0, 0, 10},
+		{10, 0, 0, 0},
+		{0, 0, 0, 10},
+		{8, 0, 0, 2},
+	},
+}
+
+func (s *S) TestNewPWM(c *check.C) {
+	m, err := NewPWM(tata, Background{}, 1)
+	c.Assert(err, check.Equals, nil)
+	c.Check(m.Len(), check.Equals, 4)
+	c.Check(m.Background(), check.Equals, Uniform)
+	c.Check(m.Weight(0, 't'), floatWithin, math.Log2((10+0.25)/11/0.25), granularity/2)
+	c.Check(m.Weight(0, 'a'), floatWithin, math.Log2(0.25/11/0.25), granularity/2)
+	c.Check(m.Weight(3, 'A'), floatWithin, math.Log2((8+0.25)/11/0.25), granularity/2)
+	c.Check(m.Weight(0, 'u'), check.Equals, m.Weight(0, 't'))
+	c.Check(math.IsNaN(m.Weight(0, 'n')), check.Equals, true)
+
+	_, err = NewPWM(tata, Background{}, 0)
+	c.Check(err, check.ErrorMatches, "motif: zero probability for a at position 0")
+	_, err = NewPWM(tata, Background{}, -1)
+	c.Check(err, check.ErrorMatches, "motif: negative pseudo-count")
+	_, err = NewPWM(tata, Background{1, 0, 0, 1}, 1)
+	c.Check(err, check.ErrorMatches, "motif: background frequency not positive")
+	_, err = NewPWM(&Motif{}, Background{}, 1)
+	c.Check(err, check.ErrorMatches, "motif: empty motif")
+
+	m, err = NewPWM(tata, Background{3, 1, 1, 3}, 1)
+	c.Assert(err, check.Equals, nil)
+	c.Check(m.Background(), check.Equals, Background{0.375, 0.125, 0.125, 0.375})
+}
+
+func (s *S) TestPValue(c *check.C) {
+	for _, bg := range []Background{Uniform, {0.3, 0.2, 0.2, 0.3}} {
+		m, err := NewPWM(tata, bg, 0.5)
+		c.Assert(err, check.Equals, nil)
+
+		// Enumerate all 4-mers.
+		var scores, probs []float64
+		for k := 0; k < 256; k++ {
+			var (
+				score float64
+				p     = 1.
+			)
+			for i := 0; i < 4; i++ {
+				b := k >> uint(2*i) & 3
+				score += m.Weight(i, alphabet.Letter("acgt"[b]))
+				p *= m.bg[b]
+			}
+			scores = append(scores, score)
+			probs = append(probs, p)
+		}
+		for _, score := range scores {
+			var want float64
+			for j, t := range scores {
+				if t >= score-1e-9 {
+					want += probs[j]
+				}
+			}
+			c.Check(m.PValue(score), floatWithin, want, 1e-9)
+		}
+		c.Check(m.PValue(m.MaxScore()), floatWithin, bg[3]*bg[0]*bg[3]*bg[0], 1e-12)
+		c.Check(m.PValue(m.MinScore()-1), check.Equals, 1.)
+		c.Check(m.PValue(m.MaxScore()+1), check.Equals, 0.)
+
+		c.Check(m.Threshold(1), check.Equals, m.MinScore())
+		for _, p := range []float64{0.5, 0.1, 0.01, 0.005} {
+			t := m.Threshold(p)
+			c.Check(m.PValue(t) <= p, check.Equals, true)
+			if !math.IsInf(t, 1) {
+				c.Check(m.PValue(t-granularity) > p, check.Equals, true)
+			}
+		}
+		c.Check(m.Threshold(1e-6), check.Equals, math.Inf(1))
+		c.Check(m.PValue(math.Inf(1)), check.Equals, 0.)
+	}
+}
+
+func (s *S) TestScan(c *check.C) {
+	m, err := NewPWM(tata, Background{}, 1)
+	c.Assert(err, check.Equals, nil)
+
+	sq := linear.NewSeq("example", alphabet.BytesToLetters([]byte("ggTATAaccTATTngcTATAgg")), alphabet.DNA)
+	sq.Offset = 10
+	hits, err := m.Scan(sq, m.MaxScore()-3)
+	c.Assert(err, check.Equals, nil)
+	type hit struct {
+		from   int
+		strand seq.Strand
+	}
+	var got []hit
+	for _, h := range hits {
+		got = append(got, hit{h.From, h.Strand})
+		c.Check(h.To-h.From, check.Equals, 4)
+		score, err := m.Score(sq, h.From, h.Strand)
+		c.Check(err, check.Equals, nil)
+		c.Check(h.Score, check.Equals, score)
+		c.Check(h.PValue, check.Equals, m.PValue(score))
+	}
+	c.Check(got, check.DeepEquals, []hit{
+		{12, seq.Plus},
+		{12, seq.Minus},
+		{19, seq.Plus},
+		{26, seq.Plus},
+		{26, seq.Minus},
+	})
+	c.Check(hits[0].Name(), check.Equals, "example:12..16(+)")
+	c.Check(hits[1].Name(), check.Equals, "example:12..16(-)")
+
+	_, err = m.Score(sq, 21, seq.Plus)
+	c.Check(err, check.ErrorMatches, `motif: ambiguous base in 21\.\.25`)
+	_, err = m.Score(sq, 30, seq.Plus)
+	c.Check(err, check.ErrorMatches, "motif: position out of range")
+	_, err = m.Scan(linear.NewSeq("protein", nil, alphabet.Protein), 0)
+	c.Check(err, check.Equals, ErrNotNucleic)
+
+	bg, err := BackgroundOf(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(bg, check.Equals, Background{6. / 21, 3. / 21, 5. / 21, 7. / 21})
+}
+
+func (s *S) TestReadJASPAR(c *check.C) {
+	const jaspar = `>MA0004.1 Arnt
+A  [ 4 19  0  0  0  0 ]
+C  [16  0 20  0  0  0 ]
+G  [ 0  1  0 20  0 20 ]
+T  [ 0  0  0  0 20  0 ]
+
+>MA0006.1 Ahr::Arnt
+T [ 0  0  0  0  0  0 ]
+G [ 0  3  0 20  0 20 ]
+C [ 3  0 20  0  0  0 ]
+A [ 3 17  0  0 20  0 ]
+`
+	motifs, err := ReadJASPAR(strings.NewReader(jaspar))
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(motifs), check.Equals, 2)
+	c.Check(motifs[0].ID, check.Equals, "MA0004.1")
+	c.Check(motifs[0].Name, check.Equals, "Arnt")
+	c.Check(motifs[0].Counts, check.DeepEquals, [][4]float64{
+		{4, 16, 0, 0}, {19, 0, 1, 0}, {0, 20, 0, 0}, {0, 0, 20, 0}, {0, 0, 0, 20}, {0, 0, 20, 0},
+	})
+	c.Check(motifs[1].Name, check.Equals, "Ahr::Arnt")
+	c.Check(motifs[1].Counts[0], check.Equals, [4]float64{3, 3, 0, 0})
+
+	motifs, err = ReadJASPAR(strings.NewReader("1 2\n3 4\n5 6\n7 8\n"))
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(motifs), check.Equals, 1)
+	c.Check(motifs[0].Counts, check.DeepEquals, [][4]float64{{1, 3, 5, 7}, {2, 4, 6, 8}})
+
+	for _, t := range []struct {
+		in  string
+		err string
+	}{
+		{in: ">a\n1 2\n3 4\n5 6\n", err: `motif: line 4: incomplete matrix for "a"`},
+		{in: ">a\n1 2\n3 4\n5 6\n7\n", err: `motif: line 5: ragged matrix for "a"`},
+		{in: ">a\nA 1\nA 2\n", err: `motif: line 3: duplicate row for A`},
+		{in: ">a\nA [1 x]\n", err: `motif: line 2: .*invalid syntax`},
+		{in: ">a\n1\n2\n3\n4\n5\n", err: `motif: line 6: too many rows for "a"`},
+	} {
+		_, err := ReadJASPAR(strings.NewReader(t.in))
+		c.Check(err, check.ErrorMatches, t.err)
+	}
+}
+
+func (s *S) TestReadMEME(c *check.C) {
+	const meme = `MEME version 4
+
+ALPHABET= ACGT
+
+strands: + -
+
+Background letter frequencies
+A 0.303 C 0.183 G 0.209 T 0.306
+
+MOTIF crp CAP
+letter-probability matrix: alength= 4 w= 3 nsites= 10 E= 4.1e-009
+ 0.000000  0.200000  0.000000  0.800000
+ 0.500000  0.000000  0.500000  0.000000
+ 1.000000  0.000000  0.000000  0.000000
+
+MOTIF lexA
+letter-probability matrix: alength= 4 w= 2
+ 0.25 0.25 0.25 0.25
+ 0.0  0.0  1.0  0.0
+URL http://example.org/lexA
+`
+	motifs, bg, err := ReadMEME(strings.NewReader(meme))
+	c.Assert(err, check.Equals, nil)
+	for i, f := range []float64{0.303, 0.183, 0.209, 0.306} {
+		c.Check(bg[i], floatWithin, f/1.001, 1e-12)
+	}
+	c.Assert(len(motifs), check.Equals, 2)
+	c.Check(motifs[0].ID, check.Equals, "crp")
+	c.Check(motifs[0].Name, check.Equals, "CAP")
+	c.Check(motifs[0].Counts, check.DeepEquals, [][4]float64{{0, 2, 0, 8}, {5, 0, 5, 0}, {10, 0, 0, 0}})
+	c.Check(motifs[1].ID, check.Equals, "lexA")
+	c.Check(motifs[1].Counts, check.DeepEquals, [][4]float64{{5, 5, 5, 5}, {0, 0, 20, 0}})
+
+	_, bg, err = ReadMEME(strings.NewReader("MOTIF a\nletter-probability matrix: w= 1\n1 0 0 0\n"))
+	c.Check(err, check.Equals, nil)
+	c.Check(bg, check.Equals, Uniform)
+
+	for _, t := range []struct {
+		in  string
+		err string
+	}{
+		{in: "ALPHABET= ACDEFGHIKLMNPQRSTVWY\n", err: `motif: line 1: unsupported alphabet "ACDEFGHIKLMNPQRSTVWY"`},
+		{in: "MOTIF a\nletter-probability matrix: w= 2\n1 0 0 0\n", err: `motif: line 3: incomplete matrix for "a"`},
+		{in: "MOTIF a\nletter-probability matrix: w= 1\n1 0 0\n", err: `motif: line 3: expected 4 probabilities, got 3`},
+		{in: "MOTIF a\nletter-probability matrix: alength= 20 w= 1\n", err: `motif: line 2: unsupported alphabet length 20`},
+		{in: "MOTIF a\n", err: `motif: no matrix for "a"`},
+		{in: "letter-probability matrix: w= 1\n", err: `motif: line 1: matrix without motif`},
+		{in: "Background letter frequencies\nA 0.5 X 0.5\n", err: `motif: line 2: unknown letter "X"`},
+		{in: "Background letter frequencies\nA 0.5 C 0.5\n\n", err: `motif: incomplete background`},
+	} {
+		_, _, err := ReadMEME(strings.NewReader(t.in))
+		c.Check(err, check.ErrorMatches, t.err)
+	}
+}
+
+type floatWithinChecker struct {
+	*check.CheckerInfo
+}
+
+var floatWithin check.Checker = &floatWithinChecker{
+	&check.CheckerInfo{Name: "floatWithin", Params: []string{"obtained", "expected", "tolerance"}},
+}
+
+func (c *floatWithinChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	return math.Abs(params[0].(float64)-params[1].(float64)) <= params[2].(float64), ""
+}
@@ -105,8 +105,8 @@ func (s *Seq) New() *Seq {
 	return &Seq{Annotation: seq.Annotation{Alpha: s.Alpha}}
 }
 
-// RevComp reverse complements the sequence. RevComp will panic if the alphabet used by
-// the receiver is not a Complementor.
+// RevComp reverse complements the sequence in place, reversing the order of the alignment
+// columns. RevComp will panic if the alphabet used by the receiver is not a Complementor.
 func (s *Seq) RevComp() {
 	rs, comp := s.Seq, s.Alpha.(alphabet.Complementor).ComplementTable()
 	i, j := 0, len(rs)-1
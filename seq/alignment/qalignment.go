@@ -117,8 +117,9 @@ func (s *QSeq) New() *QSeq {
 	return &QSeq{Annotation: seq.Annotation{Alpha: s.Alpha}}
 }
 
-// RevComp reverse complements the sequence. RevComp will panic if the alphabet used by
-// the receiver is not a Complementor.
+// RevComp reverse complements the sequence in place, reversing the order of the alignment
+// columns and the quality scores with their letters. RevComp will panic if the alphabet
+// used by the receiver is not a Complementor.
 func (s *QSeq) RevComp() {
 	rs, comp := s.Seq, s.Alpha.(alphabet.Complementor).ComplementTable()
 	i, j := 0, len(rs)-1
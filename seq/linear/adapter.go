@@ -0,0 +1,254 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linear
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+)
+
+// An AdapterMatch is an alignment of an adapter to a sequence.
+type AdapterMatch struct {
+	// Start and End are the positions of
+	// the aligned region of the sequence.
+	Start, End int
+
+	// Overlap is the number of adapter
+	// letters included in the alignment.
+	Overlap int
+
+	// Errors is the number of mismatches,
+	// insertions and deletions.
+	Errors int
+}
+
+// AdapterTrimmer performs adapter trimming. The adapter is located by semi-global alignment
+// with unit edit costs against the sequence, allowing the adapter to extend past the end of
+// the sequence. IUPAC ambiguity codes in the adapter and sequence match any base they
+// represent. For 3' adapters, the adapter and all following letters are trimmed, and for 5'
+// adapters, the adapter and all preceding letters are trimmed.
+type AdapterTrimmer struct {
+	Adapter alphabet.Letters
+
+	// Position is the end of the sequence
+	// to which the adapter is ligated,
+	// either seq.Start or seq.End.
+	Position int
+
+	// MaxErrorRate is the maximum number
+	// of errors per aligned adapter letter.
+	MaxErrorRate float64
+
+	// MinOverlap is the minimum number of
+	// adapter letters in an alignment.
+	MinOverlap int
+}
+
+// Region returns the region of s retained by adapter trimming. Region will panic if the
+// trimmer's Position is not seq.Start or seq.End.
+func (t AdapterTrimmer) Region(s *QSeq) (start, end int) {
+	m, ok := t.Find(s)
+	if !ok {
+		return s.Start(), s.End()
+	}
+	if t.Position == seq.Start {
+		return m.End, s.End()
+	}
+	return s.Start(), m.Start
+}
+
+// Find returns the best alignment of the adapter to s, and whether an alignment satisfying
+// the error rate and overlap constraints was found. Alignments including more adapter letters
+// are preferred, then alignments with fewer errors and then alignments further from the
+// adapter end of s. Find will panic if the trimmer's Position is not seq.Start or seq.End.
+func (t AdapterTrimmer) Find(s *QSeq) (m AdapterMatch, ok bool) {
+	a := make([]alphabet.Letter, len(t.Adapter))
+	r := make([]alphabet.Letter, len(s.Seq))
+	for i, ql := range s.Seq {
+		r[i] = ql.L
+	}
+	copy(a, t.Adapter)
+	switch t.Position {
+	case seq.Start:
+		reverse(a)
+		reverse(r)
+	case seq.End:
+	default:
+		panic("linear: invalid adapter position")
+	}
+
+	m, ok = alignAdapter(a, r, t.MaxErrorRate, max(t.MinOverlap, 1))
+	if !ok {
+		return m, false
+	}
+	if t.Position == seq.Start {
+		m.Start, m.End = len(r)-m.End, len(r)-m.Start
+	}
+	m.Start += s.Offset
+	m.End += s.Offset
+	return m, true
+}
+
+// alignAdapter returns the best alignment of the 3' adapter a to r.
+func alignAdapter(a, r []alphabet.Letter, rate float64, minOverlap int) (best AdapterMatch, ok bool) {
+	// Each cell holds the edit distance of the
+	// alignment of a[:i] ending at r[j-1] and
+	// the start of the alignment in r.
+	type cell struct{ cost, start int }
+	prev := make([]cell, len(r)+1)
+	cur := make([]cell, len(r)+1)
+	for j := range prev {
+		prev[j] = cell{start: j}
+	}
+
+	better := func(m AdapterMatch) bool {
+		switch {
+		case !ok:
+			return true
+		case m.Overlap != best.Overlap:
+			return m.Overlap > best.Overlap
+		case m.Errors != best.Errors:
+			return m.Errors < best.Errors
+		}
+		return m.Start < best.Start
+	}
+	consider := func(i, j int, c cell) {
+		if i < minOverlap || float64(c.cost) > rate*float64(i) {
+			return
+		}
+		m := AdapterMatch{Start: c.start, End: j, Overlap: i, Errors: c.cost}
+		if better(m) {
+			best, ok = m, true
+		}
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = cell{cost: i}
+		for j := 1; j <= len(r); j++ {
+			c := prev[j-1]
+			if !alphabet.Match(a[i-1], r[j-1]) {
+				c.cost++
+			}
+			if d := prev[j]; d.cost+1 < c.cost {
+				c = cell{cost: d.cost + 1, start: d.start}
+			}
+			if d := cur[j-1]; d.cost+1 < c.cost {
+				c = cell{cost: d.cost + 1, start: d.start}
+			}
+			cur[j] = c
+		}
+		// Partial adapters must extend to
+		// the end of the sequence.
+		consider(i, len(r), cur[len(r)])
+		if i == len(a) {
+			for j := range cur {
+				consider(i, j, cur[j])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return best, ok
+}
+
+// PolyATrimmer performs trimming of 3' poly-A tails, or of 5' poly-T heads if PolyT is true.
+// The trimmed region is the maximum scoring terminal region where each A, or T, scores 1 and
+// each other letter scores -2.
+type PolyATrimmer struct {
+	PolyT bool
+
+	// MinLen is the minimum length of
+	// a trimmed tail or head.
+	MinLen int
+}
+
+// Region returns the region of s retained by poly-A or poly-T trimming.
+func (t PolyATrimmer) Region(s *QSeq) (start, end int) {
+	base := alphabet.Letter('a')
+	if t.PolyT {
+		base = 't'
+	}
+	n := len(s.Seq)
+	var score, best, length int
+	for k := 0; k < n; k++ {
+		i := n - 1 - k
+		if t.PolyT {
+			i = k
+		}
+		if s.Seq[i].L|('a'-'A') == base {
+			score++
+		} else {
+			score -= 2
+		}
+		if score > best {
+			best, length = score, k+1
+		}
+	}
+	if length < max(t.MinLen, 1) {
+		return s.Start(), s.End()
+	}
+	if t.PolyT {
+		return s.Start() + length, s.End()
+	}
+	return s.Start(), s.End() - length
+}
+
+// A TrimStep is the number of letters removed from each end of a sequence by a trimmer.
+type TrimStep struct {
+	Trimmer    QTrimmer
+	Start, End int
+}
+
+// A TrimReport describes the trimming of a sequence by a series of trimmers.
+type TrimReport struct {
+	// Start and End are the positions of the
+	// region retained after all trimming.
+	Start, End int
+
+	// Steps holds the letters removed by
+	// each trimmer.
+	Steps []TrimStep
+
+	// Discarded indicates that the retained
+	// region was shorter than the minimum
+	// length.
+	Discarded bool
+}
+
+// TrimAll applies the trimmers in order to s, each to the region retained by the previous
+// trimmer, and returns a view of the final retained region and a report of the trimming. The
+// returned sequence shares letter data with s, but appending to it does not alter s. If the
+// retained region is empty or shorter than minLen, TrimAll returns nil.
+func TrimAll(s *QSeq, minLen int, trimmers ...QTrimmer) (*QSeq, TrimReport) {
+	c := *s
+	rep := TrimReport{Start: s.Start(), End: s.End()}
+	for _, t := range trimmers {
+		start, end := t.Region(&c)
+		if end < start {
+			end = start
+		}
+		rep.Steps = append(rep.Steps, TrimStep{Trimmer: t, Start: start - c.Offset, End: c.End() - end})
+		c.Seq = c.Seq[start-c.Offset : end-c.Offset : end-c.Offset]
+		c.Offset = start
+		rep.Start, rep.End = start, end
+	}
+	if c.Len() < minLen || c.Len() == 0 {
+		rep.Discarded = true
+		return nil, rep
+	}
+	return &c, rep
+}
+
+func reverse(l []alphabet.Letter) {
+	for i, j := 0, len(l)-1; i < j; i, j = i+1, j-1 {
+		l[i], l[j] = l[j], l[i]
+	}
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
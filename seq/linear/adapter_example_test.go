@@ -0,0 +1,85 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linear
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+
+	"fmt"
+)
+
+func qseq(id, l string) *QSeq {
+	s := NewQSeq(id, nil, alphabet.DNA, alphabet.Sanger)
+	for _, b := range []byte(l) {
+		s.AppendQLetters(alphabet.QLetter{L: alphabet.Letter(b), Q: 30})
+	}
+	return s
+}
+
+func ExampleAdapterTrimmer() {
+	t := AdapterTrimmer{
+		Adapter:      alphabet.Letters("AGATCGGAAGAGC"),
+		Position:     seq.End,
+		MaxErrorRate: 0.1,
+		MinOverlap:   3,
+	}
+	for _, s := range []*QSeq{
+		qseq("full", "GATTACAGATTACAAGATCGGAAGAGCACACGTCT"),
+		qseq("mismatch", "GATTACAGATTACAAGATCGGTAGAGCACACGTCT"),
+		qseq("partial", "GATTACAGATTACAGATTACAAGATCG"),
+		qseq("short", "GATTACAGATTACAGATTACAAG"),
+		qseq("none", "GATTACAGATTACAGATTACA"),
+	} {
+		m, ok := t.Find(s)
+		fmt.Printf("%s %v %+v %-s\n", s.ID, ok, m, Trim(s, t, 0))
+	}
+
+	t5 := AdapterTrimmer{
+		Adapter:      alphabet.Letters("ACACTCTTTCCCTACACGACGCTCTTCCGATCT"),
+		Position:     seq.Start,
+		MaxErrorRate: 0.1,
+		MinOverlap:   5,
+	}
+	s := qseq("5'", "CGACGCTCTTCCGATCTGATTACAGATTACA")
+	fmt.Printf("%-s\n", Trim(s, t5, 0))
+	// Output:
+	// full true {Start:14 End:27 Overlap:13 Errors:0} GATTACAGATTACA
+	// mismatch true {Start:14 End:27 Overlap:13 Errors:1} GATTACAGATTACA
+	// partial true {Start:21 End:27 Overlap:6 Errors:0} GATTACAGATTACAGATTACA
+	// short false {Start:0 End:0 Overlap:0 Errors:0} GATTACAGATTACAGATTACAAG
+	// none false {Start:0 End:0 Overlap:0 Errors:0} GATTACAGATTACAGATTACA
+	// GATTACAGATTACA
+}
+
+func ExampleTrimAll() {
+	s := qseq("read", "NNGATTACAGATTACAAGATCGGAAGAGCAAAAAAAAAA")
+	for i := range s.Seq[:2] {
+		s.Seq[i].Q = 2
+	}
+
+	trimmed, rep := TrimAll(s, 10,
+		WindowTrimmer{Window: 4, Threshold: 20},
+		AdapterTrimmer{Adapter: alphabet.Letters("AGATCGGAAGAGC"), Position: seq.End, MaxErrorRate: 0.1, MinOverlap: 3},
+	)
+	fmt.Printf("%-s %d-%d\n", trimmed, rep.Start, rep.End)
+	for _, st := range rep.Steps {
+		fmt.Printf("%T 5':%d 3':%d\n", st.Trimmer, st.Start, st.End)
+	}
+
+	s = qseq("polya", "GATTACAGATTACAAAAAAAGAAAAAAAA")
+	trimmed, rep = TrimAll(s, 0, PolyATrimmer{MinLen: 3})
+	fmt.Printf("%-s %d-%d 3':%d\n", trimmed, rep.Start, rep.End, rep.Steps[0].End)
+
+	s = qseq("polyt", "TTTTTTTTTCTTTGATTACAGATTACA")
+	trimmed, rep = TrimAll(s, 20, PolyATrimmer{PolyT: true, MinLen: 3})
+	fmt.Printf("%v %d-%d %v\n", trimmed, rep.Start, rep.End, rep.Discarded)
+	// Output:
+	// GATTACAGATTACA 2-16
+	// linear.WindowTrimmer 5':2 3':0
+	// linear.AdapterTrimmer 5':0 3':23
+	// GATTACAGATTAC 0-13 3':16
+	// <nil> 13-27 true
+}
@@ -0,0 +1,138 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linear
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestAlignAdapter(c *check.C) {
+	for i, t := range []struct {
+		adapter, read string
+		rate          float64
+		minOverlap    int
+
+		match AdapterMatch
+		ok    bool
+	}{
+		// Adapter longer than the read.
+		{
+			adapter: "agatcggaag", read: "agat",
+			rate: 0.1, minOverlap: 1,
+			match: AdapterMatch{Start: 0, End: 4, Overlap: 4, Errors: 0}, ok: true,
+		},
+		{
+			adapter: "agatcggaag", read: "ggagat",
+			rate: 0.1, minOverlap: 1,
+			match: AdapterMatch{Start: 2, End: 6, Overlap: 4, Errors: 0}, ok: true,
+		},
+
+		// Partial 3' adapter.
+		{
+			adapter: "agatcggaag", read: "ttttttttagatcg",
+			rate: 0.1, minOverlap: 3,
+			match: AdapterMatch{Start: 8, End: 14, Overlap: 6, Errors: 0}, ok: true,
+		},
+		{
+			adapter: "agatcggaag", read: "ttttttttagat",
+			rate: 0.1, minOverlap: 5,
+			ok: false,
+		},
+
+		// Mismatches at the error rate threshold.
+		{
+			adapter: "agatcggaag", read: "tttttagatcgtaagtttt",
+			rate: 0.1, minOverlap: 1,
+			match: AdapterMatch{Start: 5, End: 15, Overlap: 10, Errors: 1}, ok: true,
+		},
+		{
+			adapter: "agatcggaag", read: "tttttagttcggatgtttt",
+			rate: 0.1, minOverlap: 1,
+			ok: false,
+		},
+		{
+			adapter: "agatcggaag", read: "tttttagttcggatgtttt",
+			rate: 0.2, minOverlap: 1,
+			match: AdapterMatch{Start: 5, End: 15, Overlap: 10, Errors: 2}, ok: true,
+		},
+
+		// No match.
+		{
+			adapter: "agatcggaag", read: "cccccccccccc",
+			rate: 0.1, minOverlap: 1,
+			ok: false,
+		},
+		{
+			adapter: "agatcggaag", read: "",
+			rate: 0.1, minOverlap: 1,
+			ok: false,
+		},
+	} {
+		m, ok := alignAdapter(alphabet.BytesToLetters([]byte(t.adapter)), alphabet.BytesToLetters([]byte(t.read)), t.rate, t.minOverlap)
+		c.Check(ok, check.Equals, t.ok, check.Commentf("Test %d", i))
+		if ok && t.ok {
+			c.Check(m, check.Equals, t.match, check.Commentf("Test %d", i))
+		}
+	}
+}
+
+func (s *S) TestAdapterTrimmer(c *check.C) {
+	read := func(l string) *QSeq {
+		s := NewQSeq("read", nil, alphabet.DNA, alphabet.Sanger)
+		for _, b := range []byte(l) {
+			s.AppendQLetters(alphabet.QLetter{L: alphabet.Letter(b), Q: 30})
+		}
+		s.Offset = 10
+		return s
+	}
+	for i, t := range []struct {
+		trimmer    AdapterTrimmer
+		read       string
+		start, end int
+	}{
+		{
+			trimmer: AdapterTrimmer{Adapter: alphabet.Letters("agatcggaag"), Position: seq.End, MaxErrorRate: 0.1, MinOverlap: 3},
+			read:    "ccccccagatcg",
+			start:   10, end: 16,
+		},
+		{
+			trimmer: AdapterTrimmer{Adapter: alphabet.Letters("ttgagatc"), Position: seq.Start, MaxErrorRate: 0.1, MinOverlap: 3},
+			read:    "agatcgggggg",
+			start:   15, end: 21,
+		},
+		{
+			trimmer: AdapterTrimmer{Adapter: alphabet.Letters("agatcggaag"), Position: seq.End, MaxErrorRate: 0.1, MinOverlap: 3},
+			read:    "cccccccccccc",
+			start:   10, end: 22,
+		},
+		{
+			trimmer: AdapterTrimmer{Adapter: alphabet.Letters("agatcggaag"), Position: seq.End, MaxErrorRate: 0.1, MinOverlap: 3},
+			read:    "",
+			start:   10, end: 10,
+		},
+	} {
+		start, end := t.trimmer.Region(read(t.read))
+		c.Check(start, check.Equals, t.start, check.Commentf("Test %d", i))
+		c.Check(end, check.Equals, t.end, check.Commentf("Test %d", i))
+	}
+
+	c.Check(func() { AdapterTrimmer{Adapter: alphabet.Letters("agat")}.Region(read("agat")) }, check.Panics, "linear: invalid adapter position")
+}
+
+func (s *S) TestTrimAllAppend(c *check.C) {
+	q := NewQSeq("read", nil, alphabet.DNA, alphabet.Sanger)
+	for _, b := range []byte("GATTACAAGATCGGAAGAGC") {
+		q.AppendQLetters(alphabet.QLetter{L: alphabet.Letter(b), Q: 30})
+	}
+	trimmed, _ := TrimAll(q, 0, AdapterTrimmer{Adapter: alphabet.Letters("AGATCGGAAGAGC"), Position: seq.End, MaxErrorRate: 0.1, MinOverlap: 3})
+	c.Assert(trimmed, check.NotNil)
+	c.Check(trimmed.Len(), check.Equals, 7)
+	trimmed.AppendQLetters(alphabet.QLetter{L: 'T', Q: 30})
+	c.Check(q.Seq[7].L, check.Equals, alphabet.Letter('A'))
+	c.Check(trimmed.Seq[7].L, check.Equals, alphabet.Letter('T'))
+}
@@ -135,8 +135,9 @@ func (s *QSeq) New() seq.Sequence {
 	return &QSeq{Annotation: seq.Annotation{Alpha: s.Alpha}}
 }
 
-// RevComp reverse complements the sequence. RevComp will panic if the alphabet used by
-// the receiver is not a Complementor.
+// RevComp reverse complements the sequence in place, reversing the quality scores with
+// their letters. RevComp will panic if the alphabet used by the receiver is not a
+// Complementor.
 func (s *QSeq) RevComp() {
 	l, comp := s.Seq, s.Alphabet().(alphabet.Complementor).ComplementTable()
 	i, j := 0, len(l)-1
@@ -100,8 +100,8 @@ func (s *Seq) New() seq.Sequence {
 	return &Seq{Annotation: seq.Annotation{Alpha: s.Alpha}}
 }
 
-// RevComp reverse complements the sequence. RevComp will panic if the alphabet used by
-// the receiver is not a Complementor.
+// RevComp reverse complements the sequence in place. RevComp will panic if the alphabet
+// used by the receiver is not a Complementor.
 func (s *Seq) RevComp() {
 	l, comp := s.Seq, s.Alphabet().(alphabet.Complementor).ComplementTable()
 	i, j := 0, len(l)-1
@@ -0,0 +1,135 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linear
+
+import (
+	"github.com/biogo/biogo/alphabet"
+)
+
+// A QTrimmer determines the region of a quality sequence retained by quality trimming.
+type QTrimmer interface {
+	// Region returns the start and end positions
+	// of the retained region of s in sequence
+	// coordinates. If no region is retained,
+	// start and end are equal.
+	Region(s *QSeq) (start, end int)
+}
+
+// WindowTrimmer performs sliding window quality trimming. Each end of the sequence is trimmed
+// until a window with a mean quality of at least Threshold is found, and then low quality
+// letters at the end of that window are trimmed. If Window is greater than the length of the
+// sequence, the whole sequence is used as the window.
+type WindowTrimmer struct {
+	Window    int
+	Threshold alphabet.Qphred
+}
+
+// Region returns the region of s retained by sliding window trimming. Region will panic if
+// the trimmer's Window is not positive.
+func (t WindowTrimmer) Region(s *QSeq) (start, end int) {
+	if t.Window < 1 {
+		panic("linear: window trimmer window not positive")
+	}
+	q := s.Seq
+	w := t.Window
+	if w > len(q) {
+		w = len(q)
+	}
+	if w == 0 {
+		return s.Start(), s.Start()
+	}
+	thresh := int(t.Threshold) * w
+
+	var sum int
+	for _, ql := range q[:w] {
+		sum += int(ql.Q)
+	}
+	sums := make([]int, 0, len(q)-w+1)
+	sums = append(sums, sum)
+	for i := w; i < len(q); i++ {
+		sum += int(q[i].Q) - int(q[i-w].Q)
+		sums = append(sums, sum)
+	}
+
+	first := -1
+	for i, sum := range sums {
+		if sum >= thresh {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return s.Start(), s.Start()
+	}
+	last := first
+	for i := len(sums) - 1; i > first; i-- {
+		if sums[i] >= thresh {
+			last = i
+			break
+		}
+	}
+
+	start, end = first, last+w
+	for start < end && q[start].Q < t.Threshold {
+		start++
+	}
+	for end > start && q[end-1].Q < t.Threshold {
+		end--
+	}
+	return s.Start() + start, s.Start() + end
+}
+
+// MottTrimmer performs quality trimming using the modified Mott algorithm. Each letter is
+// given a score of Limit less its probability of error, and the retained region is the
+// maximum scoring subsequence.
+type MottTrimmer struct {
+	Limit float64
+}
+
+// Region returns the region of s retained by Mott trimming.
+func (t MottTrimmer) Region(s *QSeq) (start, end int) {
+	var (
+		sum, max float64
+		from     int
+	)
+	for i, ql := range s.Seq {
+		sum += t.Limit - ql.Q.ProbE()
+		if sum <= 0 {
+			sum = 0
+			from = i + 1
+			continue
+		}
+		if sum > max {
+			max = sum
+			start, end = from, i+1
+		}
+	}
+	return s.Start() + start, s.Start() + end
+}
+
+// Trim returns a view of the region of s retained by the trimmer t. The returned sequence
+// shares letter data with s, but appending to it does not alter s. If the retained region
+// is empty or shorter than minLen, Trim returns nil.
+func Trim(s *QSeq, t QTrimmer, minLen int) *QSeq {
+	start, end := t.Region(s)
+	if end-start < minLen || end-start == 0 {
+		return nil
+	}
+	c := *s
+	c.Seq = s.Seq[start-s.Offset : end-s.Offset : end-s.Offset]
+	c.Offset = start
+	return &c
+}
+
+// TrimCopy returns a copy of the region of s retained by the trimmer t. If the retained
+// region is empty or shorter than minLen, TrimCopy returns nil.
+func TrimCopy(s *QSeq, t QTrimmer, minLen int) *QSeq {
+	c := Trim(s, t, minLen)
+	if c == nil {
+		return nil
+	}
+	c.Seq = append(alphabet.QLetters(nil), c.Seq...)
+	return c
+}
@@ -0,0 +1,54 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linear
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"fmt"
+)
+
+func trimExampleSeq() *QSeq {
+	q := []alphabet.Qphred{2, 5, 30, 12, 35, 38, 40, 40, 39, 37, 36, 38, 35, 30, 34, 12, 20, 8, 3, 2}
+	l := []alphabet.Letter("NTAGCTTGACCTAGGATCAN")
+	s := NewQSeq("read", nil, alphabet.DNA, alphabet.Sanger)
+	for i := range l {
+		s.AppendQLetters(alphabet.QLetter{L: l[i], Q: q[i]})
+	}
+	return s
+}
+
+func ExampleTrim() {
+	s := trimExampleSeq()
+	fmt.Printf("%-s\n", s)
+
+	for _, t := range []QTrimmer{
+		WindowTrimmer{Window: 4, Threshold: 30},
+		MottTrimmer{Limit: 0.05},
+	} {
+		start, end := t.Region(s)
+		fmt.Printf("%T %d-%d %-s\n", t, start, end, Trim(s, t, 10))
+	}
+
+	// Regions shorter than the minimum length are discarded.
+	fmt.Println(Trim(s, WindowTrimmer{Window: 4, Threshold: 39}, 5))
+	// Output:
+	// nTAGCTTGACCTAGGATCAn
+	// linear.WindowTrimmer 4-15 CTTGACCTAGG
+	// linear.MottTrimmer 2-17 AGCTTGACCTAGGAT
+	// <nil>
+}
+
+func ExampleTrimCopy() {
+	s := trimExampleSeq()
+
+	view := Trim(s, MottTrimmer{Limit: 0.05}, 0)
+	cp := TrimCopy(s, MottTrimmer{Limit: 0.05}, 0)
+	s.Set(2, alphabet.QLetter{L: 'g', Q: 30})
+	fmt.Printf("%-s %d\n%-s %d\n", view, view.Start(), cp, cp.Start())
+	// Output:
+	// gGCTTGACCTAGGAT 2
+	// AGCTTGACCTAGGAT 2
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linear
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"gopkg.in/check.v1"
+)
+
+func newTrimSeq(offset int, q ...alphabet.Qphred) *QSeq {
+	s := NewQSeq("read", nil, alphabet.DNA, alphabet.Sanger)
+	for i, v := range q {
+		s.AppendQLetters(alphabet.QLetter{L: alphabet.Letter("acgt"[i%4]), Q: v})
+	}
+	s.Offset = offset
+	return s
+}
+
+func (s *S) TestTrimmerRegion(c *check.C) {
+	for i, t := range []struct {
+		seq        *QSeq
+		trimmer    QTrimmer
+		start, end int
+	}{
+		// Sliding window trimming.
+		{seq: newTrimSeq(0, 10, 30, 30, 30, 30, 10), trimmer: WindowTrimmer{Window: 2, Threshold: 20}, start: 1, end: 5},
+		{seq: newTrimSeq(0, 10, 30, 40, 30, 10, 10), trimmer: WindowTrimmer{Window: 3, Threshold: 20}, start: 1, end: 4},
+		{seq: newTrimSeq(0, 30, 30, 30), trimmer: WindowTrimmer{Window: 10, Threshold: 20}, start: 0, end: 3},
+		{seq: newTrimSeq(0, 2, 5, 3, 2), trimmer: WindowTrimmer{Window: 2, Threshold: 20}, start: 0, end: 0},
+		{seq: newTrimSeq(0), trimmer: WindowTrimmer{Window: 2, Threshold: 20}, start: 0, end: 0},
+		{seq: newTrimSeq(100, 10, 30, 30, 30, 30, 10), trimmer: WindowTrimmer{Window: 2, Threshold: 20}, start: 101, end: 105},
+		{seq: newTrimSeq(100, 2, 5, 3, 2), trimmer: WindowTrimmer{Window: 2, Threshold: 20}, start: 100, end: 100},
+
+		// Mott trimming.
+		{seq: newTrimSeq(0, 2, 30, 30, 30, 30, 2), trimmer: MottTrimmer{Limit: 0.05}, start: 1, end: 5},
+		{seq: newTrimSeq(0, 30, 30, 2, 2, 2, 2, 30, 30, 30), trimmer: MottTrimmer{Limit: 0.05}, start: 6, end: 9},
+		{seq: newTrimSeq(0, 2, 5, 3, 2), trimmer: MottTrimmer{Limit: 0.05}, start: 0, end: 0},
+		{seq: newTrimSeq(0), trimmer: MottTrimmer{Limit: 0.05}, start: 0, end: 0},
+		{seq: newTrimSeq(100, 2, 30, 30, 30, 30, 2), trimmer: MottTrimmer{Limit: 0.05}, start: 101, end: 105},
+		{seq: newTrimSeq(100, 2, 5, 3, 2), trimmer: MottTrimmer{Limit: 0.05}, start: 100, end: 100},
+	} {
+		start, end := t.trimmer.Region(t.seq)
+		c.Check(start, check.Equals, t.start, check.Commentf("Test %d", i))
+		c.Check(end, check.Equals, t.end, check.Commentf("Test %d", i))
+	}
+
+	c.Check(func() { WindowTrimmer{}.Region(newTrimSeq(0, 30)) }, check.Panics, "linear: window trimmer window not positive")
+}
+
+func (s *S) TestTrim(c *check.C) {
+	t := WindowTrimmer{Window: 2, Threshold: 20}
+
+	sq := newTrimSeq(100, 10, 30, 30, 30, 30, 10)
+	v := Trim(sq, t, 0)
+	c.Assert(v, check.NotNil)
+	c.Check(v.Start(), check.Equals, 101)
+	c.Check(v.End(), check.Equals, 105)
+	c.Check(v.At(101), check.Equals, sq.At(101))
+	c.Check(Trim(sq, t, 5), check.IsNil)
+	c.Check(Trim(sq, t, 4), check.NotNil)
+
+	// Appending to a view does not overwrite the source.
+	v.AppendQLetters(alphabet.QLetter{L: 'n', Q: 0})
+	c.Check(sq.At(105), check.Equals, alphabet.QLetter{L: 'c', Q: 10})
+	c.Check(v.At(105), check.Equals, alphabet.QLetter{L: 'n', Q: 0})
+
+	// Views share letters with the source, but copies do not.
+	v = Trim(sq, t, 0)
+	cp := TrimCopy(sq, t, 0)
+	sq.Set(101, alphabet.QLetter{L: 'g', Q: 40})
+	c.Check(v.At(101).L, check.Equals, alphabet.Letter('g'))
+	c.Check(cp.At(101).L, check.Equals, alphabet.Letter('c'))
+	c.Check(cp.Start(), check.Equals, 101)
+
+	for _, sq := range []*QSeq{newTrimSeq(0), newTrimSeq(7, 2, 2, 2, 2)} {
+		c.Check(Trim(sq, t, 0), check.IsNil)
+		c.Check(TrimCopy(sq, t, 0), check.IsNil)
+		c.Check(Trim(sq, MottTrimmer{Limit: 0.05}, 0), check.IsNil)
+	}
+}
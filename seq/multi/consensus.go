@@ -0,0 +1,167 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multi
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+)
+
+// A ConsensusMode specifies how the consensus letter of an alignment column is chosen.
+type ConsensusMode int
+
+const (
+	// Majority chooses the most frequent letter.
+	Majority ConsensusMode = iota
+
+	// Ambiguity chooses the IUPAC nucleotide code representing
+	// the bases with a frequency of at least the ambiguity
+	// threshold of the bases in the column.
+	Ambiguity
+
+	// QualityWeighted chooses the letter with the greatest sum
+	// of probabilities of being correct, as given by the letter
+	// quality scores.
+	QualityWeighted
+)
+
+// DefaultAmbiguityThreshold is the ambiguity threshold used by WeightedConsensus when
+// none is specified.
+const DefaultAmbiguityThreshold = 0.25
+
+// ConsensusParams holds the parameters for WeightedConsensus.
+type ConsensusParams struct {
+	// Mode specifies how the consensus
+	// letter of each column is chosen.
+	Mode ConsensusMode
+
+	// IncludeMissing specifies that rows
+	// that do not cover a column are
+	// counted as gaps.
+	IncludeMissing bool
+
+	// AmbiguityThreshold is the minimum
+	// fraction of bases in a column for a
+	// base to be represented in an Ambiguity
+	// mode consensus letter. If zero,
+	// DefaultAmbiguityThreshold is used.
+	AmbiguityThreshold float64
+}
+
+// iupacCodes holds the IUPAC nucleotide codes indexed by a bit set
+// of the represented bases, with bits in the order a, c, g, t.
+const iupacCodes = "-acmgrsvtwyhkdbn"
+
+// WeightedConsensus returns the consensus of the alignment m using the parameters p and
+// the support for each consensus letter. The support for a letter is the fraction of the
+// column's letters, or of their weights in QualityWeighted mode, that agree with the
+// consensus letter, and the quality of each consensus letter reflects its support.
+// Columns without valid letters have the alphabet's ambiguous letter as their consensus
+// and zero support. In Ambiguity mode, if the resulting IUPAC code is not valid in the
+// alignment's alphabet, the alphabet's ambiguous letter is used. Unlike the Consensus
+// method, WeightedConsensus does not use the alignment's ColumnConsense function.
+func WeightedConsensus(m *Multi, p ConsensusParams) (*linear.QSeq, []float64) {
+	threshold := p.AmbiguityThreshold
+	if threshold == 0 {
+		threshold = DefaultAmbiguityThreshold
+	}
+	var (
+		mode    = p.Mode
+		alpha   = m.Alphabet()
+		cm      = make([]alphabet.QLetter, 0, m.Len())
+		support = make([]float64, 0, m.Len())
+		w       = make([]float64, alpha.Len())
+	)
+	for pos := m.Start(); pos < m.End(); pos++ {
+		for i := range w {
+			w[i] = 0
+		}
+		var total float64
+		for _, ql := range m.ColumnQL(pos, p.IncludeMissing) {
+			if !alpha.IsValid(ql.L) {
+				continue
+			}
+			v := 1.
+			if mode == QualityWeighted && ql.L != alpha.Gap() {
+				v = 1 - ql.Q.ProbE()
+			}
+			w[alpha.IndexOf(ql.L)] += v
+			total += v
+		}
+
+		var (
+			l = alpha.Ambiguous()
+			s float64
+		)
+		if total > 0 {
+			var max float64
+			best := -1
+			for i, v := range w {
+				if v > max {
+					max, best = v, i
+				}
+			}
+			if best >= 0 {
+				l, s = alpha.Letter(best), max
+			}
+			if mode == Ambiguity && l != alpha.Gap() {
+				l, s = ambiguityCode(alpha, w, threshold)
+			}
+			s /= total
+		}
+		cm = append(cm, alphabet.QLetter{L: l, Q: alphabet.Ephred(1 - s)})
+		support = append(support, s)
+	}
+
+	c := linear.NewQSeq("Consensus:"+m.ID, cm, m.Alpha, m.Encode)
+	c.SetOffset(m.Offset)
+
+	return c, support
+}
+
+// ambiguityCode returns the IUPAC code representing the bases with weights
+// in w of at least threshold of the total base weight, and the sum of the
+// weights of those bases.
+func ambiguityCode(alpha alphabet.Alphabet, w []float64, threshold float64) (alphabet.Letter, float64) {
+	var (
+		bases = [4]alphabet.Letter{'a', 'c', 'g', 't'}
+		bw    [4]float64
+		total float64
+	)
+	if alpha.IsValid('u') && !alpha.IsValid('t') {
+		bases[3] = 'u'
+	}
+	for i, b := range bases {
+		if alpha.IsValid(b) {
+			bw[i] = w[alpha.IndexOf(b)]
+			total += bw[i]
+		}
+	}
+	if total == 0 {
+		return alpha.Ambiguous(), 0
+	}
+
+	var (
+		set int
+		sum float64
+	)
+	for i, v := range bw {
+		if v > 0 && v >= threshold*total {
+			set |= 1 << uint(i)
+			sum += v
+		}
+	}
+	if set == 0 {
+		return alpha.Ambiguous(), 0
+	}
+	l := alphabet.Letter(iupacCodes[set])
+	if bases[3] == 'u' && l == 't' {
+		l = 'u'
+	}
+	if !alpha.IsValid(l) {
+		l = alpha.Ambiguous()
+	}
+	return l, sum
+}
@@ -144,24 +144,27 @@ func (m *Multi) Clone() seq.Rower {
 	return c
 }
 
-// RevComp reverse complements the sequence.
+// RevComp reverse complements the sequence in place. Each row is reverse complemented and
+// repositioned so that the order of the alignment columns is reversed.
 func (m *Multi) RevComp() {
-	end := m.End()
+	start, end := m.Start(), m.End()
 	for _, r := range m.Seq {
+		off := start + end - r.End()
 		r.RevComp()
-		r.SetOffset(end - m.End())
+		r.SetOffset(off)
 	}
-
-	return
+	m.Strand = -m.Strand
 }
 
 // Reverse reverses the order of letters in the the sequence without complementing them.
 func (m *Multi) Reverse() {
-	end := m.End()
+	start, end := m.Start(), m.End()
 	for _, r := range m.Seq {
+		off := start + end - r.End()
 		r.Reverse()
-		r.SetOffset(end - m.End())
+		r.SetOffset(off)
 	}
+	m.Strand = seq.None
 }
 
 // Conformation returns the sequence conformation.
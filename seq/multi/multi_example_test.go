@@ -241,3 +241,26 @@ func ExampleMulti_Truncate() {
 	//
 	// gcgccang
 }
+
+func ExampleWeightedConsensus() {
+	m, err := NewMulti("example multi",
+		[]seq.Sequence{
+			linear.NewQSeq("example DNA 1", []alphabet.QLetter{{'A', 40}, {'C', 40}, {'G', 40}, {'C', 5}, {'T', 40}}, alphabet.DNAredundant, alphabet.Sanger),
+			linear.NewQSeq("example DNA 2", []alphabet.QLetter{{'A', 40}, {'C', 40}, {'G', 40}, {'T', 30}, {'T', 40}}, alphabet.DNAredundant, alphabet.Sanger),
+			linear.NewQSeq("example DNA 3", []alphabet.QLetter{{'A', 40}, {'C', 40}, {'A', 40}, {'C', 5}, {'T', 40}}, alphabet.DNAredundant, alphabet.Sanger),
+			linear.NewQSeq("example DNA 4", []alphabet.QLetter{{'A', 40}, {'G', 40}, {'A', 40}, {'T', 30}, {'T', 40}}, alphabet.DNAredundant, alphabet.Sanger),
+		},
+		seq.DefaultConsensus)
+	if err != nil {
+		return
+	}
+
+	for _, mode := range []ConsensusMode{Majority, Ambiguity, QualityWeighted} {
+		c, support := WeightedConsensus(m, ConsensusParams{Mode: mode})
+		fmt.Printf("%-s %.2f\n", c, support)
+	}
+	// Output:
+	// acact [1.00 0.75 0.50 0.50 1.00]
+	// asryt [1.00 1.00 1.00 1.00 1.00]
+	// acatt [1.00 0.75 0.50 0.59 1.00]
+}
@@ -5,6 +5,10 @@
 package multi
 
 import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
 	"testing"
 
 	"gopkg.in/check.v1"
@@ -18,3 +22,147 @@ type S struct{}
 var _ = check.Suite(&S{})
 
 func (s *S) TestWarning(c *check.C) { c.Log("\nFIXME: Tests only in example tests.\n") }
+
+func (s *S) TestRevCompRowPositions(c *check.C) {
+	a := linear.NewSeq("a", alphabet.BytesToLetters([]byte("ACGTACGT")), alphabet.DNA)
+	a.Offset = 2
+	b := linear.NewQSeq("b", []alphabet.QLetter{{L: 'A', Q: 10}, {L: 'A', Q: 20}, {L: 'C', Q: 30}}, alphabet.DNA, alphabet.Sanger)
+	b.Offset = 2
+	d := linear.NewSeq("d", alphabet.BytesToLetters([]byte("GGG")), alphabet.DNA)
+	d.Offset = 5
+	m, err := NewMulti("m", []seq.Sequence{a, b, d}, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+
+	m.RevComp()
+	c.Check(m.Start(), check.Equals, 2)
+	c.Check(m.End(), check.Equals, 10)
+	c.Check(a.Start(), check.Equals, 2)
+	c.Check(a.String(), check.Equals, "ACGTACGT")
+	c.Check(b.Start(), check.Equals, 7)
+	c.Check(b.String(), check.Equals, "GTT")
+	c.Check([]alphabet.Qphred{b.Seq[0].Q, b.Seq[1].Q, b.Seq[2].Q}, check.DeepEquals, []alphabet.Qphred{30, 20, 10})
+	c.Check(d.Start(), check.Equals, 4)
+	c.Check(d.String(), check.Equals, "CCC")
+	c.Check([]seq.Strand{a.Strand, b.Strand, d.Strand}, check.DeepEquals, []seq.Strand{seq.Minus, seq.Minus, seq.Minus})
+
+	m.RevComp()
+	c.Check(a.Start(), check.Equals, 2)
+	c.Check(b.Start(), check.Equals, 2)
+	c.Check(b.String(), check.Equals, "AAC")
+	c.Check(d.Start(), check.Equals, 5)
+	c.Check(d.String(), check.Equals, "GGG")
+
+	m.Reverse()
+	c.Check(b.Start(), check.Equals, 7)
+	c.Check(b.String(), check.Equals, "CAA")
+	c.Check(d.Start(), check.Equals, 4)
+}
+
+func newTestMulti(c *check.C, alpha alphabet.Alphabet, rows ...string) *Multi {
+	var ss []seq.Sequence
+	for _, r := range rows {
+		ss = append(ss, linear.NewSeq("", alphabet.BytesToLetters([]byte(r)), alpha))
+	}
+	m, err := NewMulti("m", ss, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	return m
+}
+
+func (s *S) TestWeightedConsensus(c *check.C) {
+	for i, t := range []struct {
+		alpha alphabet.Alphabet
+		rows  []string
+		p     ConsensusParams
+
+		want    string
+		support []float64
+	}{
+		{
+			alpha: alphabet.DNAredundant,
+			rows:  []string{"aacg", "aact", "agct"},
+			p:     ConsensusParams{Mode: Majority},
+
+			want:    "aact",
+			support: []float64{1, 2. / 3, 1, 2. / 3},
+		},
+		{
+			alpha: alphabet.DNAredundant,
+			rows:  []string{"aaa", "aac", "cag", "gat"},
+			p:     ConsensusParams{Mode: Ambiguity},
+
+			want:    "van",
+			support: []float64{1, 1, 1},
+		},
+		{
+			alpha: alphabet.DNAredundant,
+			rows:  []string{"aaa", "aac", "cag", "gat"},
+			p:     ConsensusParams{Mode: Ambiguity, AmbiguityThreshold: 0.5},
+
+			want:    "aan",
+			support: []float64{0.5, 1, 0},
+		},
+		{
+			alpha: alphabet.DNA,
+			rows:  []string{"ac", "gc"},
+			p:     ConsensusParams{Mode: Ambiguity},
+
+			want:    "nc",
+			support: []float64{1, 1},
+		},
+		{
+			alpha: alphabet.RNAredundant,
+			rows:  []string{"uu", "cu"},
+			p:     ConsensusParams{Mode: Ambiguity},
+
+			want:    "yu",
+			support: []float64{1, 1},
+		},
+		{
+			alpha: alphabet.DNAredundant,
+			rows:  []string{"aa", "aa", "a"},
+			p:     ConsensusParams{Mode: Majority},
+
+			want:    "aa",
+			support: []float64{1, 1},
+		},
+		{
+			alpha: alphabet.DNAredundant,
+			rows:  []string{"aa", "aa", "a"},
+			p:     ConsensusParams{Mode: Majority, IncludeMissing: true},
+
+			want:    "aa",
+			support: []float64{1, 2. / 3},
+		},
+		{
+			alpha: alphabet.DNAredundant,
+			rows:  []string{"a*", "c*"},
+			p:     ConsensusParams{Mode: Majority},
+
+			want:    "an",
+			support: []float64{0.5, 0},
+		},
+	} {
+		m := newTestMulti(c, t.alpha, t.rows...)
+		got, support := WeightedConsensus(m, t.p)
+		c.Check(got.String(), check.Equals, t.want, check.Commentf("Test %d", i))
+		c.Assert(support, check.HasLen, len(t.support), check.Commentf("Test %d", i))
+		for j, v := range support {
+			c.Check(v, check.Equals, t.support[j], check.Commentf("Test %d column %d", i, j))
+			c.Check(got.Seq[j].Q, check.Equals, alphabet.Ephred(1-v), check.Commentf("Test %d column %d", i, j))
+		}
+	}
+
+	// Quality weighting can overrule a majority of low quality letters.
+	var ss []seq.Sequence
+	for _, ql := range []alphabet.QLetter{{L: 'a', Q: 40}, {L: 'c', Q: 3}, {L: 'c', Q: 3}} {
+		ss = append(ss, linear.NewQSeq("", []alphabet.QLetter{ql}, alphabet.DNAredundant, alphabet.Sanger))
+	}
+	m, err := NewMulti("m", ss, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	got, _ := WeightedConsensus(m, ConsensusParams{Mode: Majority})
+	c.Check(got.String(), check.Equals, "c")
+	got, support := WeightedConsensus(m, ConsensusParams{Mode: QualityWeighted})
+	c.Check(got.String(), check.Equals, "a")
+	wa, wc := 1-alphabet.Qphred(40).ProbE(), 1-alphabet.Qphred(3).ProbE()
+	c.Check(support, check.DeepEquals, []float64{wa / (wa + 2*wc)})
+}
@@ -0,0 +1,30 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protein
+
+// diwv holds the dipeptide instability weight values of Guruprasad et al. (1990) keyed by
+// the first and second residues of each dipeptide.
+var diwv = map[byte]map[byte]float64{
+	'A': {'A': 1.00, 'R': 1.00, 'N': 1.00, 'D': -7.49, 'C': 44.94, 'Q': 1.00, 'E': 1.00, 'G': 1.00, 'H': -7.49, 'I': 1.00, 'L': 1.00, 'K': 1.00, 'M': 1.00, 'F': 1.00, 'P': 20.26, 'S': 1.00, 'T': 1.00, 'W': 1.00, 'Y': 1.00, 'V': 1.00},
+	'R': {'A': 1.00, 'R': 58.28, 'N': 13.34, 'D': 1.00, 'C': 1.00, 'Q': 20.26, 'E': 1.00, 'G': -7.49, 'H': 20.26, 'I': 1.00, 'L': 1.00, 'K': 1.00, 'M': 1.00, 'F': 1.00, 'P': 20.26, 'S': 44.94, 'T': 1.00, 'W': 58.28, 'Y': -6.54, 'V': 1.00},
+	'N': {'A': 1.00, 'R': 1.00, 'N': 1.00, 'D': 1.00, 'C': -1.88, 'Q': -6.54, 'E': 1.00, 'G': -14.03, 'H': 1.00, 'I': 44.94, 'L': 1.00, 'K': 24.68, 'M': 1.00, 'F': -14.03, 'P': -1.88, 'S': 1.00, 'T': -7.49, 'W': -9.37, 'Y': 1.00, 'V': 1.00},
+	'D': {'A': 1.00, 'R': -6.54, 'N': 1.00, 'D': 1.00, 'C': 1.00, 'Q': 1.00, 'E': 1.00, 'G': 1.00, 'H': 1.00, 'I': 1.00, 'L': 1.00, 'K': -7.49, 'M': 1.00, 'F': -6.54, 'P': 1.00, 'S': 20.26, 'T': -14.03, 'W': 1.00, 'Y': 1.00, 'V': 1.00},
+	'C': {'A': 1.00, 'R': 1.00, 'N': 1.00, 'D': 20.26, 'C': 1.00, 'Q': -6.54, 'E': 1.00, 'G': 1.00, 'H': 33.60, 'I': 1.00, 'L': 20.26, 'K': 1.00, 'M': 33.60, 'F': 1.00, 'P': 20.26, 'S': 1.00, 'T': 33.60, 'W': 24.68, 'Y': 1.00, 'V': -6.54},
+	'Q': {'A': 1.00, 'R': 1.00, 'N': 1.00, 'D': 20.26, 'C': -6.54, 'Q': 20.26, 'E': 20.26, 'G': 1.00, 'H': 1.00, 'I': 1.00, 'L': 1.00, 'K': 1.00, 'M': 1.00, 'F': -6.54, 'P': 20.26, 'S': 44.94, 'T': 1.00, 'W': 1.00, 'Y': -6.54, 'V': -6.54},
+	'E': {'A': 1.00, 'R': 1.00, 'N': 1.00, 'D': 20.26, 'C': 44.94, 'Q': 20.26, 'E': 33.60, 'G': 1.00, 'H': -6.54, 'I': 20.26, 'L': 1.00, 'K': 1.00, 'M': 1.00, 'F': 1.00, 'P': 20.26, 'S': 20.26, 'T': 1.00, 'W': -14.03, 'Y': 1.00, 'V': 1.00},
+	'G': {'A': -7.49, 'R': 1.00, 'N': -7.49, 'D': 1.00, 'C': 1.00, 'Q': 1.00, 'E': -6.54, 'G': 13.34, 'H': 1.00, 'I': -7.49, 'L': 1.00, 'K': -7.49, 'M': 1.00, 'F': 1.00, 'P': 1.00, 'S': 1.00, 'T': -7.49, 'W': 13.34, 'Y': -7.49, 'V': 1.00},
+	'H': {'A': 1.00, 'R': 1.00, 'N': 24.68, 'D': 1.00, 'C': 1.00, 'Q': 1.00, 'E': 1.00, 'G': -9.37, 'H': 1.00, 'I': 44.94, 'L': 1.00, 'K': 24.68, 'M': 1.00, 'F': -9.37, 'P': -1.88, 'S': 1.00, 'T': -6.54, 'W': -1.88, 'Y': 44.94, 'V': 1.00},
+	'I': {'A': 1.00, 'R': 1.00, 'N': 1.00, 'D': 1.00, 'C': 1.00, 'Q': 1.00, 'E': 44.94, 'G': 1.00, 'H': 13.34, 'I': 1.00, 'L': 20.26, 'K': -7.49, 'M': 1.00, 'F': 1.00, 'P': -1.88, 'S': 1.00, 'T': 1.00, 'W': 1.00, 'Y': 1.00, 'V': -7.49},
+	'L': {'A': 1.00, 'R': 20.26, 'N': 1.00, 'D': 1.00, 'C': 1.00, 'Q': 33.60, 'E': 1.00, 'G': 1.00, 'H': 1.00, 'I': 1.00, 'L': 1.00, 'K': -7.49, 'M': 1.00, 'F': 1.00, 'P': 20.26, 'S': 1.00, 'T': 1.00, 'W': 24.68, 'Y': 1.00, 'V': 1.00},
+	'K': {'A': 1.00, 'R': 33.60, 'N': 1.00, 'D': 1.00, 'C': 1.00, 'Q': 24.64, 'E': 1.00, 'G': -7.49, 'H': 1.00, 'I': -7.49, 'L': -7.49, 'K': 1.00, 'M': 33.60, 'F': 1.00, 'P': -6.54, 'S': 1.00, 'T': 1.00, 'W': 1.00, 'Y': 1.00, 'V': -7.49},
+	'M': {'A': 13.34, 'R': -6.54, 'N': 1.00, 'D': 1.00, 'C': 1.00, 'Q': -6.54, 'E': 1.00, 'G': 1.00, 'H': 58.28, 'I': 1.00, 'L': 1.00, 'K': 1.00, 'M': -1.88, 'F': 1.00, 'P': 44.94, 'S': 44.94, 'T': -1.88, 'W': 1.00, 'Y': 24.68, 'V': 1.00},
+	'F': {'A': 1.00, 'R': 1.00, 'N': 1.00, 'D': 13.34, 'C': 1.00, 'Q': 1.00, 'E': 1.00, 'G': 1.00, 'H': 1.00, 'I': 1.00, 'L': 1.00, 'K': -14.03, 'M': 1.00, 'F': 1.00, 'P': 20.26, 'S': 1.00, 'T': 1.00, 'W': 1.00, 'Y': 33.60, 'V': 1.00},
+	'P': {'A': 20.26, 'R': -6.54, 'N': 1.00, 'D': -6.54, 'C': -6.54, 'Q': 20.26, 'E': 18.38, 'G': 1.00, 'H': 1.00, 'I': 1.00, 'L': 1.00, 'K': 1.00, 'M': -6.54, 'F': 20.26, 'P': 20.26, 'S': 20.26, 'T': 1.00, 'W': -1.88, 'Y': 1.00, 'V': 20.26},
+	'S': {'A': 1.00, 'R': 20.26, 'N': 1.00, 'D': 1.00, 'C': 33.60, 'Q': 20.26, 'E': 20.26, 'G': 1.00, 'H': 1.00, 'I': 1.00, 'L': 1.00, 'K': 1.00, 'M': 1.00, 'F': 1.00, 'P': 44.94, 'S': 20.26, 'T': 1.00, 'W': 1.00, 'Y': 1.00, 'V': 1.00},
+	'T': {'A': 1.00, 'R': 1.00, 'N': -14.03, 'D': 1.00, 'C': 1.00, 'Q': -6.54, 'E': 20.26, 'G': -7.49, 'H': 1.00, 'I': 1.00, 'L': 1.00, 'K': 1.00, 'M': 1.00, 'F': 13.34, 'P': 1.00, 'S': 1.00, 'T': 1.00, 'W': -14.03, 'Y': 1.00, 'V': 1.00},
+	'W': {'A': -14.03, 'R': 1.00, 'N': 13.34, 'D': 1.00, 'C': 1.00, 'Q': 1.00, 'E': 1.00, 'G': -9.37, 'H': 24.68, 'I': 1.00, 'L': 13.34, 'K': 1.00, 'M': 24.68, 'F': 1.00, 'P': 1.00, 'S': 1.00, 'T': -14.03, 'W': 1.00, 'Y': 1.00, 'V': -7.49},
+	'Y': {'A': 24.68, 'R': -15.91, 'N': 1.00, 'D': 24.68, 'C': 1.00, 'Q': 1.00, 'E': -6.54, 'G': -7.49, 'H': 13.34, 'I': 1.00, 'L': 1.00, 'K': 1.00, 'M': 44.94, 'F': 1.00, 'P': 13.34, 'S': 1.00, 'T': -7.49, 'W': -9.37, 'Y': 13.34, 'V': 1.00},
+	'V': {'A': 1.00, 'R': 1.00, 'N': 1.00, 'D': -14.03, 'C': 1.00, 'Q': 1.00, 'E': 1.00, 'G': -7.49, 'H': 1.00, 'I': 1.00, 'L': 1.00, 'K': -1.88, 'M': 1.00, 'F': 1.00, 'P': 20.26, 'S': 1.00, 'T': -7.49, 'W': 1.00, 'Y': -6.54, 'V': 1.00},
+}
@@ -0,0 +1,247 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package protein provides calculation of physicochemical properties of protein sequences.
+package protein
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"github.com/biogo/store/step"
+
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrNotProtein = errors.New("protein: sequence is not protein")
+
+// residues returns the upper case residues of s, omitting gaps and stop symbols. If known is
+// not nil, residues for which known returns false result in an error.
+func residues(s seq.Sequence, known func(byte) bool) ([]byte, error) {
+	if s.Alphabet().Moltype() != feat.Protein {
+		return nil, ErrNotProtein
+	}
+	gap := s.Alphabet().Gap()
+	r := make([]byte, 0, s.Len())
+	for i := s.Start(); i < s.End(); i++ {
+		l := s.At(i).L
+		if l == gap || l == '*' {
+			continue
+		}
+		b := byte(l) &^ ('a' - 'A')
+		if known != nil && !known(b) {
+			return nil, fmt.Errorf("protein: unknown residue %q at position %d", l, i)
+		}
+		r = append(r, b)
+	}
+	return r, nil
+}
+
+// Composition returns the fraction of residues of s that are each amino acid, keyed by upper
+// case letter. Gaps and stop symbols are not counted. It returns an error if s is not a
+// protein sequence.
+func Composition(s seq.Sequence) (map[alphabet.Letter]float64, error) {
+	r, err := residues(s, nil)
+	if err != nil {
+		return nil, err
+	}
+	c := make(map[alphabet.Letter]float64)
+	for _, b := range r {
+		c[alphabet.Letter(b)]++
+	}
+	for l := range c {
+		c[l] /= float64(len(r))
+	}
+	return c, nil
+}
+
+// residueMass holds the average masses of amino acid residues in Daltons.
+var residueMass = map[byte]float64{
+	'A': 71.0788, 'R': 156.1875, 'N': 114.1038, 'D': 115.0886,
+	'C': 103.1388, 'E': 129.1155, 'Q': 128.1307, 'G': 57.0519,
+	'H': 137.1411, 'I': 113.1594, 'L': 113.1594, 'K': 128.1741,
+	'M': 131.1926, 'F': 147.1766, 'P': 97.1167, 'S': 87.0782,
+	'T': 101.1051, 'W': 186.2132, 'Y': 163.1760, 'V': 99.1326,
+	'U': 150.0388, 'O': 237.3018,
+}
+
+// waterMass is the average mass of water in Daltons.
+const waterMass = 18.01524
+
+// MolecularWeight returns the average molecular weight of the unmodified polypeptide s in
+// Daltons. Gaps and stop symbols are ignored. It returns an error if s is not a protein
+// sequence or contains residues other than the 20 standard amino acids, selenocysteine (U)
+// and pyrrolysine (O).
+func MolecularWeight(s seq.Sequence) (float64, error) {
+	r, err := residues(s, func(b byte) bool { _, ok := residueMass[b]; return ok })
+	if err != nil {
+		return 0, err
+	}
+	if len(r) == 0 {
+		return 0, nil
+	}
+	m := waterMass
+	for _, b := range r {
+		m += residueMass[b]
+	}
+	return m, nil
+}
+
+// Ionisable group pK values of Bjellqvist et al. (1993) for non-terminal residues.
+const (
+	pKNTerm = 7.5
+	pKCTerm = 3.55
+)
+
+var (
+	pKPositive = map[byte]float64{'K': 10.0, 'R': 12.0, 'H': 5.98}
+	pKNegative = map[byte]float64{'D': 4.05, 'E': 4.45, 'C': 9.0, 'Y': 10.0}
+)
+
+// Charge returns the net charge of s at the given pH, calculated from the Henderson-Hasselbalch
+// equation using the pK values of:
+//
+//	The focusing positions of polypeptides in immobilized pH gradients can be predicted from their amino acid sequences.
+//	 Bengt Bjellqvist, Graham J. Hughes, Christian Pasquali, Nadine Paquet, Fancoise Ravier, Jean-Charles Sanchez,
+//	 Sylvia Frutiger and Denis Hochstrasser. Electrophoresis 14:1023–1031 (1993).
+//
+// The terminal residue specific pK values of Bjellqvist et al. are not used. It returns an
+// error if s is not a protein sequence.
+func Charge(s seq.Sequence, pH float64) (float64, error) {
+	r, err := residues(s, nil)
+	if err != nil {
+		return 0, err
+	}
+	return charge(r, pH), nil
+}
+
+func charge(r []byte, pH float64) float64 {
+	if len(r) == 0 {
+		return 0
+	}
+	pos := func(pK float64) float64 { return 1 / (1 + math.Pow(10, pH-pK)) }
+	neg := func(pK float64) float64 { return 1 / (1 + math.Pow(10, pK-pH)) }
+	c := pos(pKNTerm) - neg(pKCTerm)
+	for _, b := range r {
+		if pK, ok := pKPositive[b]; ok {
+			c += pos(pK)
+		} else if pK, ok := pKNegative[b]; ok {
+			c -= neg(pK)
+		}
+	}
+	return c
+}
+
+// IsoelectricPoint returns the pH at which the net charge of s, calculated as described for
+// Charge, is zero. It returns an error if s is not a protein sequence.
+func IsoelectricPoint(s seq.Sequence) (float64, error) {
+	r, err := residues(s, nil)
+	if err != nil {
+		return 0, err
+	}
+	lo, hi := 0., 14.
+	for hi-lo > 1e-4 {
+		mid := (lo + hi) / 2
+		if charge(r, mid) > 0 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2, nil
+}
+
+// kyteDoolittle holds the hydropathy index of each amino acid of:
+//
+//	A simple method for displaying the hydropathic character of a protein.
+//	 Jack Kyte and Russell F. Doolittle. J Mol Biol 157:105–132 (1982).
+var kyteDoolittle = map[byte]float64{
+	'A': 1.8, 'R': -4.5, 'N': -3.5, 'D': -3.5, 'C': 2.5,
+	'Q': -3.5, 'E': -3.5, 'G': -0.4, 'H': -3.2, 'I': 4.5,
+	'L': 3.8, 'K': -3.9, 'M': 1.9, 'F': 2.8, 'P': -1.6,
+	'S': -0.8, 'T': -0.7, 'W': -0.9, 'Y': -1.3, 'V': 4.2,
+}
+
+// GRAVY returns the grand average of hydropathy of s, the mean Kyte-Doolittle hydropathy index
+// of its residues. It returns an error if s is not a protein sequence, contains residues
+// other than the 20 standard amino acids or has no residues.
+func GRAVY(s seq.Sequence) (float64, error) {
+	r, err := residues(s, func(b byte) bool { _, ok := kyteDoolittle[b]; return ok })
+	if err != nil {
+		return 0, err
+	}
+	if len(r) == 0 {
+		return 0, errors.New("protein: no residues")
+	}
+	var sum float64
+	for _, b := range r {
+		sum += kyteDoolittle[b]
+	}
+	return sum / float64(len(r)), nil
+}
+
+// Hydropathy returns a step vector holding the Kyte-Doolittle hydropathy profile of s, the
+// mean hydropathy index of each window of w residues assigned to the position at the centre
+// of the window. Positions that are not the centre of a window of w standard amino acids have
+// a value of NaN. It returns an error if w is not positive, or s is empty or is not a protein
+// sequence.
+func Hydropathy(s seq.Sequence, w int) (*step.Vector, error) {
+	if w < 1 {
+		return nil, errors.New("protein: window length not positive")
+	}
+	if s.Alphabet().Moltype() != feat.Protein {
+		return nil, ErrNotProtein
+	}
+	v, err := step.New(s.Start(), s.End(), step.Float(math.NaN()))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		sum float64
+		n   int
+	)
+	for i := s.Start(); i < s.End(); i++ {
+		h, ok := kyteDoolittle[byte(s.At(i).L)&^('a'-'A')]
+		if !ok {
+			sum, n = 0, 0
+			continue
+		}
+		sum += h
+		n++
+		if n > w {
+			sum -= kyteDoolittle[byte(s.At(i-w).L)&^('a'-'A')]
+			n--
+		}
+		if n == w {
+			v.Set(i-w+1+w/2, step.Float(sum/float64(w)))
+		}
+	}
+	return v, nil
+}
+
+// InstabilityIndex returns the instability index of s described in:
+//
+//	Correlation between stability of a protein and its dipeptide composition: a novel approach for predicting in vivo stability of a protein from its primary sequence.
+//	 K. Guruprasad, B.V. Bhasker Reddy and Madhusudan W. Pandit. Protein Eng 4:155–161 (1990).
+//
+// Proteins with an instability index greater than 40 are predicted to be unstable. It returns
+// an error if s is not a protein sequence, contains residues other than the 20 standard
+// amino acids or has no residues.
+func InstabilityIndex(s seq.Sequence) (float64, error) {
+	r, err := residues(s, func(b byte) bool { _, ok := kyteDoolittle[b]; return ok })
+	if err != nil {
+		return 0, err
+	}
+	if len(r) == 0 {
+		return 0, errors.New("protein: no residues")
+	}
+	var sum float64
+	for i := 0; i+1 < len(r); i++ {
+		sum += diwv[r[i]][r[i+1]]
+	}
+	return 10 * sum / float64(len(r)), nil
+}
@@ -0,0 +1,48 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protein
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func Example() {
+	// Human insulin B chain.
+	s := linear.NewSeq("INS_B", alphabet.BytesToLetters([]byte("FVNQHLCGSHLVEALYLVCGERGFFYTPKT")), alphabet.Protein)
+
+	mw, err := MolecularWeight(s)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	pi, err := IsoelectricPoint(s)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	gravy, err := GRAVY(s)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	ii, err := InstabilityIndex(s)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	comp, err := Composition(s)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("MW:%.2f pI:%.2f GRAVY:%.3f instability:%.2f\n", mw, pi, gravy, ii)
+	fmt.Printf("L:%.3f G:%.3f W:%.3f\n", comp['L'], comp['G'], comp['W'])
+	// Output:
+	// MW:3429.96 pI:6.90 GRAVY:0.220 instability:9.85
+	// L:0.133 G:0.100 W:0.000
+}
@@ -0,0 +1,99 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protein
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"github.com/biogo/store/step"
+
+	"math"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func prot(s string) seq.Sequence {
+	return linear.NewSeq("", alphabet.BytesToLetters([]byte(s)), alphabet.Protein)
+}
+
+func (s *S) TestMolecularWeight(c *check.C) {
+	for _, t := range []struct {
+		seq string
+		mw  float64
+	}{
+		{seq: "", mw: 0},
+		{seq: "A", mw: 89.09404},
+		{seq: "G-G*", mw: 132.11904},
+		{seq: "ag", mw: 146.14594},
+	} {
+		mw, err := MolecularWeight(prot(t.seq))
+		c.Assert(err, check.Equals, nil)
+		c.Check(math.Abs(mw-t.mw) < 1e-6, check.Equals, true, check.Commentf("%q: got:%f want:%f", t.seq, mw, t.mw))
+	}
+	_, err := MolecularWeight(prot("AXA"))
+	c.Check(err, check.ErrorMatches, `protein: unknown residue 'X' at position 1`)
+	_, err = MolecularWeight(linear.NewSeq("", alphabet.BytesToLetters([]byte("acgt")), alphabet.DNA))
+	c.Check(err, check.Equals, ErrNotProtein)
+}
+
+func (s *S) TestIsoelectricPoint(c *check.C) {
+	for _, t := range []struct {
+		seq string
+		min float64
+		max float64
+	}{
+		{seq: "G", min: 5.4, max: 5.6},
+		{seq: "K", min: 8.7, max: 8.8},
+		{seq: "DDDD", min: 2, max: 4},
+		{seq: "RRRR", min: 12, max: 14},
+	} {
+		pi, err := IsoelectricPoint(prot(t.seq))
+		c.Assert(err, check.Equals, nil)
+		c.Check(pi > t.min && pi < t.max, check.Equals, true, check.Commentf("%q: got:%f", t.seq, pi))
+		q, err := Charge(prot(t.seq), pi)
+		c.Assert(err, check.Equals, nil)
+		c.Check(math.Abs(q) < 1e-3, check.Equals, true)
+	}
+}
+
+func (s *S) TestHydropathy(c *check.C) {
+	g, err := GRAVY(prot("AR"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(g, check.Equals, -1.35)
+	_, err = GRAVY(prot(""))
+	c.Check(err, check.NotNil)
+
+	v, err := Hydropathy(prot("IIIRRRXIII"), 3)
+	c.Assert(err, check.Equals, nil)
+	var got []float64
+	for i := 0; i < 10; i++ {
+		h, err := v.At(i)
+		c.Assert(err, check.Equals, nil)
+		got = append(got, float64(h.(step.Float)))
+	}
+	want := []float64{math.NaN(), 4.5, 1.5, -1.5, -4.5, math.NaN(), math.NaN(), math.NaN(), 4.5, math.NaN()}
+	for i := range want {
+		c.Check(math.Abs(got[i]-want[i]) < 1e-12 || (math.IsNaN(got[i]) && math.IsNaN(want[i])), check.Equals, true,
+			check.Commentf("position %d: got:%f want:%f", i, got[i], want[i]))
+	}
+}
+
+func (s *S) TestInstabilityIndex(c *check.C) {
+	ii, err := InstabilityIndex(prot("AC"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(math.Abs(ii-224.7) < 1e-9, check.Equals, true)
+	_, err = InstabilityIndex(prot("AB"))
+	c.Check(err, check.NotNil)
+}
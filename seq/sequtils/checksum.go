@@ -0,0 +1,69 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/seq"
+
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+	"hash/crc64"
+	"strings"
+)
+
+// normalised returns the letters of s in upper case with gap letters removed. Gap letters
+// are the gap letter of the alphabet of s, '-' and '.'.
+func normalised(s seq.Sequence) []byte {
+	gap := s.Alphabet().Gap()
+	b := make([]byte, 0, s.Len())
+	for i := s.Start(); i < s.End(); i++ {
+		l := s.At(i).L
+		if l == gap || l == '-' || l == '.' {
+			continue
+		}
+		b = append(b, byte(upper(l)))
+	}
+	return b
+}
+
+var crcISO = crc64.MakeTable(crc64.ISO)
+
+// CRC64 returns the CRC-64 checksum of s using the ISO 3309 polynomial with a zero initial
+// value and no final inversion, as used by SWISS-PROT and UniProt. Checksums of sequences are
+// calculated case-insensitively and ignore gaps.
+func CRC64(s seq.Sequence) uint64 {
+	// crc64.Update inverts the checksum before
+	// and after processing the data.
+	return ^crc64.Update(^uint64(0), crcISO, normalised(s))
+}
+
+// GCGChecksum returns the GCG checksum of s, as used in GCG, MSF and PIR formatted files.
+// Checksums of sequences are calculated case-insensitively and ignore gaps.
+func GCGChecksum(s seq.Sequence) int {
+	var sum int
+	for i, b := range normalised(s) {
+		sum += (i%57 + 1) * int(b)
+	}
+	return sum % 10000
+}
+
+// MD5 returns the MD5 digest of s. Digests of sequences are calculated case-insensitively
+// and ignore gaps.
+func MD5(s seq.Sequence) [md5.Size]byte {
+	return md5.Sum(normalised(s))
+}
+
+// SEGUID returns the sequence globally unique identifier of s described in:
+//
+//	SEGUID: a database-independent identifier for protein sequences.
+//	 Babnigg G. and Giometti C.S. Proteomics 6:4514–4522 (2006).
+//
+// The SEGUID is the base64 encoded SHA-1 digest of the upper case sequence with trailing
+// padding removed. Gaps are ignored.
+func SEGUID(s seq.Sequence) string {
+	h := sha1.Sum(normalised(s))
+	return strings.TrimRight(base64.StdEncoding.EncodeToString(h[:]), "=")
+}
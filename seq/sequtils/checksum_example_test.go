@@ -0,0 +1,24 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleSEGUID() {
+	a := linear.NewSeq("a", alphabet.BytesToLetters([]byte("ACGTACGTACGT")), alphabet.DNA)
+	b := linear.NewSeq("b", alphabet.BytesToLetters([]byte("acgtac--gtacgt")), alphabet.DNAgapped)
+
+	for _, s := range []*linear.Seq{a, b} {
+		fmt.Printf("%s CRC-%016X %d %x %s\n", s.ID, CRC64(s), GCGChecksum(s), MD5(s), SEGUID(s))
+	}
+	// Output:
+	// a CRC-C4FBB762C4A87EBD 5688 31e91beccf6059ff57c696827c0c6a4b If6HIvcnRSQDVNiAoefAzySc6i4
+	// b CRC-C4FBB762C4A87EBD 5688 31e91beccf6059ff57c696827c0c6a4b If6HIvcnRSQDVNiAoefAzySc6i4
+}
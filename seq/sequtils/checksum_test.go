@@ -0,0 +1,47 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestChecksums(c *check.C) {
+	for _, t := range []struct {
+		seq    string
+		alpha  alphabet.Alphabet
+		crc    uint64
+		gcg    int
+		md5    string
+		seguid string
+	}{
+		{
+			seq: "ACGTACGTACGT", alpha: alphabet.DNA,
+			crc: 0xC4FBB762C4A87EBD, gcg: 5688,
+			md5: "31e91beccf6059ff57c696827c0c6a4b", seguid: "If6HIvcnRSQDVNiAoefAzySc6i4",
+		},
+		{
+			seq: "acgt-ACG.tacgt", alpha: alphabet.DNAgapped,
+			crc: 0xC4FBB762C4A87EBD, gcg: 5688,
+			md5: "31e91beccf6059ff57c696827c0c6a4b", seguid: "If6HIvcnRSQDVNiAoefAzySc6i4",
+		},
+		{
+			seq: "MKVLAAGIVGLLLAQ", alpha: alphabet.Protein,
+			crc: 0x0C16827C19FE9AF9, gcg: 8930,
+			md5: "6f45cc2ad373f0a7a28907f7e6798313", seguid: "vvWEdq8zx2v745OE7X1VO7svnyQ",
+		},
+	} {
+		sq := linear.NewSeq("", alphabet.BytesToLetters([]byte(t.seq)), t.alpha)
+		c.Check(CRC64(sq), check.Equals, t.crc, check.Commentf("%q", t.seq))
+		c.Check(GCGChecksum(sq), check.Equals, t.gcg, check.Commentf("%q", t.seq))
+		c.Check(fmt.Sprintf("%x", MD5(sq)), check.Equals, t.md5, check.Commentf("%q", t.seq))
+		c.Check(SEGUID(sq), check.Equals, t.seguid, check.Commentf("%q", t.seq))
+	}
+}
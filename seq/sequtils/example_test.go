@@ -7,8 +7,10 @@ package sequtils
 import (
 	"fmt"
 
+	"github.com/biogo/biogo/alphabet"
 	"github.com/biogo/biogo/feat"
 	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
 )
 
 func ExampleTruncate_a() {
@@ -50,6 +52,22 @@ func ExampleTruncate_b() {
 	// Error: sequtils: start position greater than end position for linear sequence
 }
 
+func ExampleTruncate_c() {
+	src := linear.NewSeq("plasmid", alphabet.BytesToLetters([]byte("ACGCTGACTTGGTGCACGT")), alphabet.DNA)
+	src.Conform = feat.Circular
+
+	// A feature spanning the origin, such as an ORF,
+	// may be given with an end beyond the end of src.
+	dst := &linear.Seq{}
+	if err := Truncate(dst, src, 15, 23); err == nil {
+		fmt.Printf("%-s %d\n", dst, dst.Start())
+	} else {
+		fmt.Println("Error:", err)
+	}
+	// Output:
+	// ACGTACGC 15
+}
+
 func ExampleJoin() {
 	var s1, s2 *offSlice
 
@@ -0,0 +1,111 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"github.com/biogo/store/step"
+
+	"errors"
+	"math"
+)
+
+// gcWindow holds base counts for a window of a sequence.
+type gcWindow struct {
+	start, end int
+	g, c, at   int
+}
+
+// gcWindows returns the base counts for consecutive windows of s of length w. The last
+// window may be shorter than w.
+func gcWindows(s seq.Sequence, w int) ([]gcWindow, error) {
+	if w < 1 {
+		return nil, errors.New("sequtils: window length not positive")
+	}
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, errors.New("sequtils: sequence is not nucleic acid")
+	}
+	if s.Len() == 0 {
+		return nil, errors.New("sequtils: empty sequence")
+	}
+	var ws []gcWindow
+	for start := s.Start(); start < s.End(); start += w {
+		win := gcWindow{start: start, end: min(start+w, s.End())}
+		for i := win.start; i < win.end; i++ {
+			switch normaliseBase(byte(s.At(i).L)) {
+			case 'g':
+				win.g++
+			case 'c':
+				win.c++
+			case 'a', 't':
+				win.at++
+			}
+		}
+		ws = append(ws, win)
+	}
+	return ws, nil
+}
+
+// gcTrack returns a step vector holding the values returned by fn for consecutive windows
+// of s of length w.
+func gcTrack(s seq.Sequence, w int, fn func(gcWindow) float64) (*step.Vector, error) {
+	ws, err := gcWindows(s, w)
+	if err != nil {
+		return nil, err
+	}
+	v, err := step.New(s.Start(), s.End(), step.Float(math.NaN()))
+	if err != nil {
+		return nil, err
+	}
+	for _, win := range ws {
+		v.SetRange(win.start, win.end, step.Float(fn(win)))
+	}
+	return v, nil
+}
+
+// GCContent returns a step vector holding the GC content of consecutive windows of s of
+// length w, as the fraction of unambiguous bases that are G or C. The last window may be
+// shorter than w. Windows without unambiguous bases have a value of NaN. It returns an
+// error if w is not positive, or s is empty or is not a nucleic acid sequence.
+func GCContent(s seq.Sequence, w int) (*step.Vector, error) {
+	return gcTrack(s, w, func(win gcWindow) float64 {
+		n := win.g + win.c + win.at
+		if n == 0 {
+			return math.NaN()
+		}
+		return float64(win.g+win.c) / float64(n)
+	})
+}
+
+// GCSkew returns a step vector holding the GC skew, (G-C)/(G+C), of consecutive windows of
+// s of length w. The last window may be shorter than w. Windows without G or C bases have a
+// value of zero. It returns an error if w is not positive, or s is empty or is not a nucleic
+// acid sequence.
+func GCSkew(s seq.Sequence, w int) (*step.Vector, error) {
+	return gcTrack(s, w, skew)
+}
+
+// CumulativeGCSkew returns a step vector holding the cumulative GC skew of consecutive
+// windows of s of length w, the sum of the GC skew of each window and all preceding windows.
+// The minimum and maximum of the cumulative skew of a bacterial chromosome indicate the
+// positions of the origin and terminus of replication. It returns an error if w is not
+// positive, or s is empty or is not a nucleic acid sequence.
+func CumulativeGCSkew(s seq.Sequence, w int) (*step.Vector, error) {
+	var sum float64
+	return gcTrack(s, w, func(win gcWindow) float64 {
+		sum += skew(win)
+		return sum
+	})
+}
+
+// skew returns the GC skew of the window.
+func skew(win gcWindow) float64 {
+	if win.g+win.c == 0 {
+		return 0
+	}
+	return float64(win.g-win.c) / float64(win.g+win.c)
+}
@@ -0,0 +1,46 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"github.com/biogo/store/step"
+
+	"fmt"
+	"strings"
+)
+
+func ExampleGCSkew() {
+	s := linear.NewSeq("example", alphabet.BytesToLetters([]byte("GGGCATATGCCCCCGAATTGGGGNNNN")), alphabet.DNA)
+
+	gc, err := GCContent(s, 5)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	skew, err := GCSkew(s, 5)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	cum, err := CumulativeGCSkew(s, 5)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, v := range []*step.Vector{gc, skew, cum} {
+		var steps []string
+		v.Do(func(start, end int, e step.Equaler) {
+			steps = append(steps, fmt.Sprintf("%d-%d:%.2f", start, end, e))
+		})
+		fmt.Println(strings.Join(steps, " "))
+	}
+	// Output:
+	// 0-5:0.80 5-10:0.40 10-15:1.00 15-20:0.20 20-25:1.00 25-27:NaN
+	// 0-5:0.50 5-10:0.00 10-15:-0.60 15-25:1.00 25-27:0.00
+	// 0-10:0.50 10-15:-0.10 15-20:0.90 20-27:1.90
+}
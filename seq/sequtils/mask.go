@@ -0,0 +1,235 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// A MaskMode specifies how masked letters are represented in a sequence.
+type MaskMode int
+
+const (
+	SoftMask MaskMode = iota // Masked letters are lower case.
+	HardMask                 // Masked letters are replaced by the upper case ambiguous letter of the alphabet.
+)
+
+// A MaskInterval is a masked interval of a sequence.
+type MaskInterval struct {
+	// Loc is the masked sequence.
+	Loc feat.Feature
+
+	// From and To are the positions of the
+	// interval in sequence coordinates.
+	From, To int
+}
+
+func (i *MaskInterval) Name() string {
+	if i == nil {
+		return "<nil>"
+	}
+	if i.Loc == nil {
+		return fmt.Sprintf("%d..%d", i.From, i.To)
+	}
+	return fmt.Sprintf("%s:%d..%d", i.Loc.Name(), i.From, i.To)
+}
+
+// Description returns the string "mask".
+func (i *MaskInterval) Description() string    { return "mask" }
+func (i *MaskInterval) Start() int             { return i.From }
+func (i *MaskInterval) End() int               { return i.To }
+func (i *MaskInterval) Len() int               { return i.To - i.From }
+func (i *MaskInterval) Location() feat.Feature { return i.Loc }
+
+// A Mask is a set of masked intervals of a sequence sorted by position. The intervals of a
+// Mask returned by the functions and methods of this package do not overlap or abut.
+type Mask []*MaskInterval
+
+// NewMask returns a Mask of the sequence loc covering the features in fs. Overlapping and
+// abutting features are merged.
+func NewMask(loc feat.Feature, fs ...feat.Feature) Mask {
+	ivs := make(Mask, 0, len(fs))
+	for _, f := range fs {
+		if f.End() > f.Start() {
+			ivs = append(ivs, &MaskInterval{Loc: loc, From: f.Start(), To: f.End()})
+		}
+	}
+	sort.Sort(masksByPos(ivs))
+
+	var m Mask
+	for _, iv := range ivs {
+		if n := len(m); n != 0 && iv.From <= m[n-1].To {
+			if iv.To > m[n-1].To {
+				m[n-1].To = iv.To
+			}
+			continue
+		}
+		m = append(m, iv)
+	}
+	return m
+}
+
+// Features returns the intervals of the mask as features, allowing a Mask to be used as a
+// feat.Set.
+func (m Mask) Features() []feat.Feature {
+	fs := make([]feat.Feature, len(m))
+	for i, iv := range m {
+		fs[i] = iv
+	}
+	return fs
+}
+
+// Masked returns the number of positions covered by the mask.
+func (m Mask) Masked() int {
+	var n int
+	for _, iv := range m {
+		n += iv.Len()
+	}
+	return n
+}
+
+// Fraction returns the fraction of positions from start to end that are covered by the mask.
+func (m Mask) Fraction(start, end int) float64 {
+	if end <= start {
+		return 0
+	}
+	var n int
+	for _, iv := range m {
+		n += max(0, min(iv.To, end)-max(iv.From, start))
+	}
+	return float64(n) / float64(end-start)
+}
+
+// Invert returns a Mask covering the positions from start to end that are not covered by m.
+// The Loc of the returned intervals is taken from the first interval of m.
+func (m Mask) Invert(start, end int) Mask {
+	var (
+		inv Mask
+		loc feat.Feature
+		pos = start
+	)
+	if len(m) != 0 {
+		loc = m[0].Loc
+	}
+	for _, iv := range m {
+		if iv.From > pos {
+			inv = append(inv, &MaskInterval{Loc: loc, From: pos, To: min(iv.From, end)})
+		}
+		pos = max(pos, iv.To)
+		if pos >= end {
+			break
+		}
+	}
+	if pos < end {
+		inv = append(inv, &MaskInterval{Loc: loc, From: pos, To: end})
+	}
+	return inv
+}
+
+// Apply applies the mask to s using the masking mode. Mask intervals are clipped to the
+// extent of s. It returns an error if s does not have an alphabet. Lower case letters may
+// not be valid in case-sensitive alphabets.
+func (m Mask) Apply(s seq.Sequence, mode MaskMode) error {
+	alpha := s.Alphabet()
+	if alpha == nil {
+		return errors.New("sequtils: no alphabet")
+	}
+	var (
+		gap  = alpha.Gap()
+		ambi = upper(alpha.Ambiguous())
+	)
+	for _, iv := range m {
+		for i := max(iv.From, s.Start()); i < min(iv.To, s.End()); i++ {
+			ql := s.At(i)
+			if ql.L == gap {
+				continue
+			}
+			switch mode {
+			case SoftMask:
+				ql.L = lower(ql.L)
+			case HardMask:
+				ql.L = ambi
+			default:
+				return fmt.Errorf("sequtils: unknown mask mode %d", mode)
+			}
+			s.Set(i, ql)
+		}
+	}
+	return nil
+}
+
+// MaskOf returns the Mask of the positions of s that are masked according to the masking
+// mode. For SoftMask, positions holding lower case letters are masked and for HardMask,
+// positions holding the ambiguous letter of the alphabet in either case are masked.
+func MaskOf(s seq.Sequence, mode MaskMode) Mask {
+	var ambi alphabet.Letter
+	if alpha := s.Alphabet(); alpha != nil {
+		ambi = upper(alpha.Ambiguous())
+	}
+	var (
+		m    Mask
+		from = -1
+	)
+	for i := s.Start(); i <= s.End(); i++ {
+		var masked bool
+		if i < s.End() {
+			l := s.At(i).L
+			switch mode {
+			case SoftMask:
+				masked = 'a' <= l && l <= 'z'
+			case HardMask:
+				masked = upper(l) == ambi
+			}
+		}
+		switch {
+		case masked && from < 0:
+			from = i
+		case !masked && from >= 0:
+			m = append(m, &MaskInterval{Loc: s, From: from, To: i})
+			from = -1
+		}
+	}
+	return m
+}
+
+// MaskedFraction returns the fraction of letters of s that are masked according to the
+// masking mode.
+func MaskedFraction(s seq.Sequence, mode MaskMode) float64 {
+	return MaskOf(s, mode).Fraction(s.Start(), s.End())
+}
+
+// lower returns the lower case form of the ASCII letter l.
+func lower(l alphabet.Letter) alphabet.Letter {
+	if 'A' <= l && l <= 'Z' {
+		return l | ('a' - 'A')
+	}
+	return l
+}
+
+// upper returns the upper case form of the ASCII letter l.
+func upper(l alphabet.Letter) alphabet.Letter {
+	if 'a' <= l && l <= 'z' {
+		return l &^ ('a' - 'A')
+	}
+	return l
+}
+
+// masksByPos sorts mask intervals by start and then end.
+type masksByPos Mask
+
+func (m masksByPos) Len() int { return len(m) }
+func (m masksByPos) Less(i, j int) bool {
+	if m[i].From != m[j].From {
+		return m[i].From < m[j].From
+	}
+	return m[i].To < m[j].To
+}
+func (m masksByPos) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
@@ -0,0 +1,45 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+type maskRegion struct {
+	feat.Feature
+	start, end int
+}
+
+func (r maskRegion) Start() int { return r.start }
+func (r maskRegion) End() int   { return r.end }
+
+func ExampleMask_Apply() {
+	s := linear.NewSeq("example", alphabet.BytesToLetters([]byte("ACGTACGTACGTACGTACGT")), alphabet.DNA)
+
+	m := NewMask(s, maskRegion{start: 2, end: 6}, maskRegion{start: 4, end: 8}, maskRegion{start: 15, end: 18})
+	for _, iv := range m {
+		fmt.Println(iv.Name())
+	}
+	fmt.Printf("%.2f\n", m.Fraction(s.Start(), s.End()))
+
+	soft := s.Clone().(*linear.Seq)
+	m.Apply(soft, SoftMask)
+	fmt.Printf("%-s %.2f\n", soft, MaskedFraction(soft, SoftMask))
+
+	hard := s.Clone().(*linear.Seq)
+	m.Invert(s.Start(), s.End()).Apply(hard, HardMask)
+	fmt.Printf("%-s %.2f\n", hard, MaskedFraction(hard, HardMask))
+	// Output:
+	// example:2..8
+	// example:15..18
+	// 0.45
+	// ACgtacgtACGTACGtacGT 0.45
+	// NNGTACGTNNNNNNNTACNN 0.55
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestMask(c *check.C) {
+	type iv struct{ from, to int }
+	ivs := func(m Mask) []iv {
+		var r []iv
+		for _, i := range m {
+			r = append(r, iv{i.From, i.To})
+		}
+		return r
+	}
+
+	m := NewMask(nil,
+		maskRegion{start: 10, end: 12},
+		maskRegion{start: 2, end: 4},
+		maskRegion{start: 4, end: 6},
+		maskRegion{start: 7, end: 7},
+		maskRegion{start: 3, end: 5},
+	)
+	c.Check(ivs(m), check.DeepEquals, []iv{{2, 6}, {10, 12}})
+	c.Check(m.Masked(), check.Equals, 6)
+	c.Check(m.Fraction(0, 12), check.Equals, 0.5)
+	c.Check(m.Fraction(5, 11), check.Equals, 2/6.)
+	c.Check(m.Fraction(3, 3), check.Equals, 0.)
+
+	c.Check(ivs(m.Invert(0, 15)), check.DeepEquals, []iv{{0, 2}, {6, 10}, {12, 15}})
+	c.Check(ivs(m.Invert(3, 11)), check.DeepEquals, []iv{{6, 10}})
+	c.Check(ivs(m.Invert(2, 6)), check.IsNil)
+	c.Check(ivs(Mask(nil).Invert(1, 4)), check.DeepEquals, []iv{{1, 4}})
+	c.Check(ivs(m.Invert(0, 15).Invert(0, 15)), check.DeepEquals, ivs(m))
+
+	// Masks are clipped to the sequence and
+	// do not alter gaps.
+	sq := linear.NewSeq("", alphabet.BytesToLetters([]byte("ACG-TACG")), alphabet.DNAgapped)
+	sq.Offset = 3
+	c.Check(m.Apply(sq, SoftMask), check.Equals, nil)
+	c.Check(sq.String(), check.Equals, "acg-TACg")
+	c.Check(ivs(MaskOf(sq, SoftMask)), check.DeepEquals, []iv{{3, 6}, {10, 11}})
+	c.Check(m.Apply(sq, HardMask), check.Equals, nil)
+	c.Check(sq.String(), check.Equals, "NNN-TACN")
+	c.Check(ivs(MaskOf(sq, HardMask)), check.DeepEquals, []iv{{3, 6}, {10, 11}})
+	c.Check(MaskedFraction(sq, HardMask), check.Equals, 0.5)
+	c.Check(m.Apply(sq, MaskMode(2)), check.ErrorMatches, "sequtils: unknown mask mode 2")
+}
@@ -0,0 +1,262 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// An ORF is an open reading frame, from the first base of a start codon to the last base
+// of an in-frame stop codon.
+type ORF struct {
+	// Loc is the sequence holding the ORF.
+	Loc feat.Feature
+
+	// From and To are the positions of the
+	// ORF on the forward strand. For ORFs
+	// spanning the origin of a circular
+	// sequence, To is greater than the end
+	// of the sequence and the ORF continues
+	// from the start of the sequence.
+	From, To int
+
+	// Strand is the strand of the ORF.
+	Strand seq.Strand
+
+	// Frame is the reading frame of the ORF,
+	// 1, 2 or 3 for the plus strand and -1,
+	// -2 or -3 for the minus strand, counted
+	// from the start of the strand.
+	Frame int
+}
+
+func (o *ORF) Name() string {
+	if o == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s:%d..%d(%+d)", o.Loc.Name(), o.From, o.To, o.Frame)
+}
+
+// Description returns the string "orf".
+func (o *ORF) Description() string    { return "orf" }
+func (o *ORF) Start() int             { return o.From }
+func (o *ORF) End() int               { return o.To }
+func (o *ORF) Len() int               { return o.To - o.From }
+func (o *ORF) Location() feat.Feature { return o.Loc }
+
+// Orientation returns the orientation of the ORF relative to its location.
+func (o *ORF) Orientation() feat.Orientation { return feat.Orientation(o.Strand) }
+
+// A GeneticCode provides the lower case start and stop codons of a genetic code. The
+// *translate.GeneticCode type satisfies GeneticCode.
+type GeneticCode interface {
+	Starts() []string
+	Stops() []string
+}
+
+// codons is a GeneticCode holding explicit start and stop codons.
+type codons struct{ starts, stops []string }
+
+func (c codons) Starts() []string { return c.starts }
+func (c codons) Stops() []string  { return c.stops }
+
+// standardCode holds the start and stop codons of the standard genetic code.
+var standardCode = codons{
+	starts: []string{"ttg", "ctg", "atg"},
+	stops:  []string{"taa", "tag", "tga"},
+}
+
+// An ORFFinder finds open reading frames.
+type ORFFinder struct {
+	// MinLen is the minimum length of a reported
+	// ORF, including the stop codon.
+	MinLen int
+
+	// Code is the genetic code defining the
+	// start and stop codons. If nil, the
+	// standard genetic code is used.
+	Code GeneticCode
+}
+
+// Find returns the ORFs of s in all six frames, sorted by start position. For each stop
+// codon, the longest ORF ending at the stop is reported, beginning at the first start codon
+// following the previous in-frame stop codon. If s is circular, ORFs may span the origin,
+// but are not longer than s. Letters are compared case-insensitively with 'u' read as 't'.
+// It returns an error if s is not a nucleic acid sequence.
+func (f ORFFinder) Find(s seq.Sequence) ([]*ORF, error) {
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, errors.New("sequtils: sequence is not nucleic acid")
+	}
+	code := f.Code
+	if code == nil {
+		code = standardCode
+	}
+	starts := codonSet(code.Starts())
+	stops := codonSet(code.Stops())
+
+	var (
+		n        = s.Len()
+		offset   = s.Start()
+		circular bool
+		fwd      = make([]byte, n)
+		rev      = make([]byte, n)
+	)
+	if c, ok := s.(seq.Conformationer); ok {
+		circular = c.Conformation() == feat.Circular
+	}
+	for i := 0; i < n; i++ {
+		b := normaliseBase(byte(s.At(offset + i).L))
+		fwd[i] = b
+		rev[n-1-i] = complementBase(b)
+	}
+
+	var orfs []*ORF
+	for _, strand := range []seq.Strand{seq.Plus, seq.Minus} {
+		l := fwd
+		if strand == seq.Minus {
+			l = rev
+		}
+		for _, o := range findORFs(l, circular, starts, stops) {
+			if o[1]-o[0] < f.MinLen {
+				continue
+			}
+			orf := &ORF{Loc: s, Strand: strand, From: o[0], To: o[1]}
+			orf.Frame = o[0]%3 + 1
+			if strand == seq.Minus {
+				orf.From, orf.To = n-o[1], n-o[0]
+				if orf.From < 0 {
+					orf.From += n
+					orf.To += n
+				}
+				orf.Frame = -orf.Frame
+			}
+			orf.From += offset
+			orf.To += offset
+			orfs = append(orfs, orf)
+		}
+	}
+	sort.Sort(orfsByPos(orfs))
+
+	return orfs, nil
+}
+
+// findORFs returns the start and end positions of the ORFs of l read in the forward
+// direction. If circular is true, l is treated as a circular sequence and the returned
+// ORFs may end beyond len(l).
+func findORFs(l []byte, circular bool, starts, stops map[string]bool) [][2]int {
+	n := len(l)
+	if n < 3 {
+		return nil
+	}
+	codon := func(i int) string {
+		if circular {
+			return string([]byte{l[i%n], l[(i+1)%n], l[(i+2)%n]})
+		}
+		return string(l[i : i+3])
+	}
+
+	// For circular sequences, each stop codon is considered
+	// where it falls in the middle copy of three tandem copies
+	// of the sequence, allowing upstream scans across the origin
+	// in all three frames.
+	var (
+		end    = n
+		stopLo = 0
+		stopHi = n
+		orfs   [][2]int
+	)
+	if circular {
+		end = 3 * n
+		stopLo, stopHi = n, 2*n
+	}
+	for frame := 0; frame < 3; frame++ {
+		start := -1
+		for i := frame; i+3 <= end; i += 3 {
+			c := codon(i)
+			if starts[c] && start < 0 {
+				start = i
+			}
+			if !stops[c] {
+				continue
+			}
+			if i >= stopLo && i < stopHi && start >= 0 {
+				firstStart := start
+				if circular && i+3-firstStart > n {
+					// Find the first start within one
+					// length of the sequence of the stop.
+					firstStart = -1
+					for j := start; j < i; j += 3 {
+						if j >= i+3-n && starts[codon(j)] {
+							firstStart = j
+							break
+						}
+					}
+				}
+				if firstStart >= 0 {
+					from := firstStart
+					if circular {
+						from %= n
+					}
+					orfs = append(orfs, [2]int{from, from + i + 3 - firstStart})
+				}
+			}
+			start = -1
+		}
+	}
+	return orfs
+}
+
+// codonSet returns a set of the codons in codons.
+func codonSet(codons []string) map[string]bool {
+	set := make(map[string]bool, len(codons))
+	for _, c := range codons {
+		set[c] = true
+	}
+	return set
+}
+
+// normaliseBase returns the lower case form of b with 'u' read as 't'.
+func normaliseBase(b byte) byte {
+	b |= 'a' - 'A'
+	if b == 'u' {
+		b = 't'
+	}
+	return b
+}
+
+// complementBase returns the complement of the normalised base b.
+func complementBase(b byte) byte {
+	switch b {
+	case 'a':
+		return 't'
+	case 'c':
+		return 'g'
+	case 'g':
+		return 'c'
+	case 't':
+		return 'a'
+	}
+	return 'n'
+}
+
+// orfsByPos sorts ORFs by start, end and then frame.
+type orfsByPos []*ORF
+
+func (o orfsByPos) Len() int { return len(o) }
+func (o orfsByPos) Less(i, j int) bool {
+	if o[i].From != o[j].From {
+		return o[i].From < o[j].From
+	}
+	if o[i].To != o[j].To {
+		return o[i].To < o[j].To
+	}
+	return o[i].Frame > o[j].Frame
+}
+func (o orfsByPos) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
@@ -0,0 +1,43 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleORFFinder_Find() {
+	s := linear.NewSeq("example", alphabet.BytesToLetters([]byte("ccATGAAACCCTAGggTTAGGGTTTCATcc")), alphabet.DNA)
+
+	orfs, err := ORFFinder{MinLen: 9}.Find(s)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, o := range orfs {
+		fmt.Println(o.Name())
+	}
+
+	// Make the sequence circular and search
+	// for ORFs spanning the origin.
+	c := linear.NewSeq("circular", alphabet.BytesToLetters([]byte("AAACCCTAGggggATGAAA")), alphabet.DNA)
+	c.Conform = feat.Circular
+	orfs, err = ORFFinder{MinLen: 9}.Find(c)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, o := range orfs {
+		fmt.Println(o.Name())
+	}
+	// Output:
+	// example:2..14(+3)
+	// example:16..28(-3)
+	// circular:13..28(+2)
+}
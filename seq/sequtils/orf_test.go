@@ -0,0 +1,98 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/translate"
+
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestORFFinderCircularRotation(c *check.C) {
+	bacterial, err := translate.Table(11)
+	c.Assert(err, check.Equals, nil)
+	rnd := rand.New(rand.NewSource(1))
+	b := make([]byte, 1000)
+	for i := range b {
+		b[i] = "acgt"[rnd.Intn(4)]
+	}
+
+	type orf struct {
+		from, len int
+		strand    seq.Strand
+	}
+	find := func(b []byte, rot int) map[orf]bool {
+		s := linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNA)
+		s.Conform = feat.Circular
+		orfs, err := ORFFinder{MinLen: 30, Code: bacterial}.Find(s)
+		c.Assert(err, check.Equals, nil)
+		m := make(map[orf]bool)
+		for _, o := range orfs {
+			c.Check(o.From >= 0 && o.From < len(b), check.Equals, true)
+			c.Check(o.Len() <= len(b), check.Equals, true)
+			m[orf{from: (o.From + rot) % len(b), len: o.Len(), strand: o.Strand}] = true
+		}
+		return m
+	}
+
+	want := find(b, 0)
+	c.Check(len(want) > 0, check.Equals, true)
+	for _, rot := range []int{1, 2, 3, 500, 999} {
+		r := append(append([]byte(nil), b[len(b)-rot:]...), b[:len(b)-rot]...)
+		c.Check(find(r, len(b)-rot), check.DeepEquals, want, check.Commentf("rotation %d", rot))
+	}
+}
+
+func (s *S) TestORFFinderLinear(c *check.C) {
+	for _, t := range []struct {
+		seq  string
+		orfs []ORF
+	}{
+		{seq: "atgtaa", orfs: []ORF{{From: 0, To: 6, Strand: seq.Plus, Frame: 1}}},
+		{seq: "ttacat", orfs: []ORF{{From: 0, To: 6, Strand: seq.Minus, Frame: -1}}},
+		{seq: "atgatgtgaatgtag", orfs: []ORF{
+			{From: 0, To: 9, Strand: seq.Plus, Frame: 1},
+			{From: 9, To: 15, Strand: seq.Plus, Frame: 1},
+		}},
+		{seq: "aatgaaaa"},
+		{seq: "AUGUAA", orfs: []ORF{{From: 0, To: 6, Strand: seq.Plus, Frame: 1}}},
+	} {
+		sq := linear.NewSeq("", alphabet.BytesToLetters([]byte(t.seq)), alphabet.DNA)
+		orfs, err := ORFFinder{}.Find(sq)
+		c.Assert(err, check.Equals, nil)
+		c.Assert(orfs, check.HasLen, len(t.orfs), check.Commentf("%s", t.seq))
+		for i, o := range orfs {
+			o.Loc = nil
+			c.Check(*o, check.Equals, t.orfs[i])
+		}
+	}
+
+	// Start and stop codons are taken from the genetic code.
+	mito, err := translate.Table(2)
+	c.Assert(err, check.Equals, nil)
+	sq := linear.NewSeq("", alphabet.BytesToLetters([]byte("attgggaga")), alphabet.DNA)
+	orfs, err := ORFFinder{}.Find(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(orfs, check.HasLen, 0)
+	orfs, err = ORFFinder{Code: mito}.Find(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(orfs, check.HasLen, 1)
+	orfs[0].Loc = nil
+	c.Check(*orfs[0], check.Equals, ORF{From: 0, To: 9, Strand: seq.Plus, Frame: 1})
+
+	_, err = ORFFinder{}.Find(linear.NewSeq("", nil, alphabet.Protein))
+	c.Check(err, check.Not(check.Equals), nil)
+}
+
+func (s *S) TestORFFinderStandardCode(c *check.C) {
+	c.Check(codonSet(standardCode.Starts()), check.DeepEquals, codonSet(translate.Standard.Starts()))
+	c.Check(codonSet(standardCode.Stops()), check.DeepEquals, codonSet(translate.Standard.Stops()))
+}
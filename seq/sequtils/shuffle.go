@@ -0,0 +1,140 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"math/rand"
+)
+
+// Shuffle randomly permutes the letters of s in place, preserving the count of every k-let,
+// the subsequences of length k, of the original sequence. A k of 1 preserves the letter
+// composition and a k of 2 preserves the dinucleotide composition. For k greater than one,
+// the first and last k-1 letters of s are also preserved and the shuffled sequence is drawn
+// uniformly from all sequences with the same k-let counts using the Euler path method of:
+//
+//	Probabilistic estimation of the significance of local sequence alignment scores.
+//	 Stephen F. Altschul and Bruce W. Erickson. Mol Biol Evol 2:526–538 (1985).
+//
+// and:
+//
+//	Shuffling biological sequences.
+//	 D. Kandel, Y. Matias, R. Unger and P. Winkler. Discrete Appl Math 71:171–185 (1996).
+//
+// Quality scores are kept with the letter at the end of each k-let. Letters are compared
+// case-sensitively. If rnd is nil, the default source of math/rand is used. It returns an error
+// if k is not positive.
+func Shuffle(s seq.Sequence, k int, rnd *rand.Rand) error {
+	if k < 1 {
+		return errors.New("sequtils: k-let length not positive")
+	}
+	intn := rand.Intn
+	if rnd != nil {
+		intn = rnd.Intn
+	}
+	n := s.Len()
+	l := make([]alphabet.QLetter, n)
+	for i := range l {
+		l[i] = s.At(s.Start() + i)
+	}
+
+	if k == 1 {
+		for i := n - 1; i > 0; i-- {
+			j := intn(i + 1)
+			l[i], l[j] = l[j], l[i]
+		}
+		return set(s, l)
+	}
+	if n <= k {
+		return nil
+	}
+
+	// Build the multigraph of (k-1)-lets with an
+	// edge for each k-let. Each edge is labelled
+	// with the index of the final letter of its
+	// k-let.
+	type edge struct {
+		to, pos int
+	}
+	var (
+		ids   = make(map[string]int)
+		edges [][]edge
+		key   = make([]byte, k-1)
+	)
+	vertex := func(i int) int {
+		for j := range key {
+			key[j] = byte(l[i+j].L)
+		}
+		id, ok := ids[string(key)]
+		if !ok {
+			id = len(edges)
+			ids[string(key)] = id
+			edges = append(edges, nil)
+		}
+		return id
+	}
+	start := vertex(0)
+	from := start
+	for i := 1; i+k-1 <= n; i++ {
+		to := vertex(i)
+		edges[from] = append(edges[from], edge{to: to, pos: i + k - 2})
+		from = to
+	}
+	end := from
+
+	// Choose a random spanning arborescence of last
+	// exit edges rooted at the end vertex using
+	// Wilson's loop-erased random walk algorithm.
+	var (
+		inTree = make([]bool, len(edges))
+		last   = make([]int, len(edges))
+	)
+	inTree[end] = true
+	for v := range edges {
+		for u := v; !inTree[u]; u = edges[u][last[u]].to {
+			last[u] = intn(len(edges[u]))
+		}
+		for u := v; !inTree[u]; u = edges[u][last[u]].to {
+			inTree[u] = true
+		}
+	}
+
+	// Randomly order the remaining edges of each
+	// vertex and walk the resulting Euler path.
+	for v, e := range edges {
+		if v != end {
+			e[last[v]], e[len(e)-1] = e[len(e)-1], e[last[v]]
+			e = e[:len(e)-1]
+		}
+		for i := len(e) - 1; i > 0; i-- {
+			j := intn(i + 1)
+			e[i], e[j] = e[j], e[i]
+		}
+	}
+	shuffled := make([]alphabet.QLetter, n)
+	copy(shuffled, l[:k-1])
+	next := make([]int, len(edges))
+	for i, v := k-1, start; i < n; i++ {
+		e := edges[v][next[v]]
+		next[v]++
+		shuffled[i] = l[e.pos]
+		v = e.to
+	}
+	return set(s, shuffled)
+}
+
+// set sets the letters of s to l.
+func set(s seq.Sequence, l []alphabet.QLetter) error {
+	for i, ql := range l {
+		err := s.Set(s.Start()+i, ql)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
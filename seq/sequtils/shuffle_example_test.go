@@ -0,0 +1,32 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"math/rand"
+)
+
+func ExampleShuffle() {
+	s := linear.NewSeq("example", alphabet.BytesToLetters([]byte("ACGTTGCAACGTACGGGATCCATG")), alphabet.DNA)
+	rnd := rand.New(rand.NewSource(1))
+
+	for k := 1; k <= 3; k++ {
+		sh := s.Clone().(*linear.Seq)
+		err := Shuffle(sh, k, rnd)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("k=%d %-s\n", k, sh)
+	}
+	// Output:
+	// k=1 AGGGCGTTAGCCCTATGAACGCTA
+	// k=2 AATACACCACGGATTGGTCGTGCG
+	// k=3 ACGGGATCCATGCAACGTACGTTG
+}
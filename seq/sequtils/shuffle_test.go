@@ -0,0 +1,68 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func kletCounts(s *linear.Seq, k int) map[string]int {
+	m := make(map[string]int)
+	for i := 0; i+k <= len(s.Seq); i++ {
+		m[alphabet.Letters(s.Seq[i:i+k]).String()]++
+	}
+	return m
+}
+
+func (s *S) TestShuffle(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	b := make([]byte, 200)
+	for i := range b {
+		b[i] = "acgt"[rnd.Intn(4)]
+	}
+	orig := linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNA)
+
+	for k := 1; k <= 4; k++ {
+		seen := make(map[string]bool)
+		for i := 0; i < 20; i++ {
+			sh := orig.Clone().(*linear.Seq)
+			c.Assert(Shuffle(sh, k, rnd), check.Equals, nil)
+			c.Check(kletCounts(sh, k), check.DeepEquals, kletCounts(orig, k), check.Commentf("k=%d", k))
+			if k > 1 {
+				c.Check(sh.Seq[:k-1], check.DeepEquals, orig.Seq[:k-1])
+				c.Check(sh.Seq[len(b)-k+1:], check.DeepEquals, orig.Seq[len(b)-k+1:])
+			}
+			seen[sh.Seq.String()] = true
+		}
+		c.Check(len(seen) > 1, check.Equals, true, check.Commentf("k=%d", k))
+	}
+
+	// Short sequences are unchanged.
+	sh := linear.NewSeq("", alphabet.BytesToLetters([]byte("acg")), alphabet.DNA)
+	c.Check(Shuffle(sh, 3, rnd), check.Equals, nil)
+	c.Check(sh.Seq.String(), check.Equals, "acg")
+
+	c.Check(Shuffle(sh, 0, rnd), check.NotNil)
+}
+
+func (s *S) TestShuffleUniform(c *check.C) {
+	// The sequences with the same dinucleotide counts, first and
+	// last letter as "aabab" are "aabab" and "abaab".
+	rnd := rand.New(rand.NewSource(1))
+	counts := make(map[string]int)
+	const n = 2000
+	for i := 0; i < n; i++ {
+		sh := linear.NewSeq("", alphabet.BytesToLetters([]byte("aabab")), alphabet.DNA)
+		c.Assert(Shuffle(sh, 2, rnd), check.Equals, nil)
+		counts[sh.Seq.String()]++
+	}
+	c.Check(counts, check.HasLen, 2)
+	c.Check(counts["aabab"] > n/3 && counts["abaab"] > n/3, check.Equals, true, check.Commentf("%v", counts))
+}
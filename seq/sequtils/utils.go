@@ -59,16 +59,26 @@ type Sliceable interface {
 // Truncate performs a truncation on src from start to end and places the result in dst.
 // The conformation of dst is set to linear and the offset is set to start. If dst and src
 // are not equal, a copy of the truncation is allocated. Only circular sequences can be
-// truncated with start > end.
+// truncated with start > end. A truncation of a circular sequence that spans the origin
+// may also be specified with end greater than the end of src, as used by features that
+// span the origin, provided it is no longer than src; end is then read modulo the length
+// of src, so a truncation of the full length of src returns src rotated to start at start.
 func Truncate(dst, src Sliceable, start, end int) error {
 	var (
 		sl     = src.Slice()
 		offset = src.Start()
+		wrap   bool
 	)
+	if c, ok := src.(seq.Conformationer); ok && c.Conformation() == feat.Circular {
+		if end > src.End() && start >= offset && end-start <= sl.Len() {
+			end -= sl.Len()
+			wrap = true
+		}
+	}
 	if start < offset || end > src.End() {
 		return errors.New("sequtils: index out of range")
 	}
-	if start <= end {
+	if start <= end && !wrap {
 		if dst == src {
 			dst.SetSlice(sl.Slice(start-offset, end-offset))
 		} else {
@@ -115,6 +115,36 @@ func (s *S) TestTruncate(c *check.C) {
 			end:    6,
 			expect: stringToSlice("aliqua.Lorem"),
 		},
+		{
+			in:     &conformRangeOffSlice{slice: lorem, conf: feat.Circular},
+			start:  117,
+			end:    129,
+			expect: stringToSlice("aliqua.Lorem"),
+		},
+		{
+			in:     &conformRangeOffSlice{slice: lorem, conf: feat.Circular, offset: 1},
+			start:  118,
+			end:    130,
+			expect: stringToSlice("aliqua.Lorem"),
+		},
+		{
+			in:     &conformRangeOffSlice{slice: lorem[:12], conf: feat.Circular},
+			start:  6,
+			end:    18,
+			expect: stringToSlice("ipsum Lorem "),
+		},
+		{
+			in:     &conformRangeOffSlice{slice: lorem, conf: feat.Linear},
+			start:  117,
+			end:    128,
+			expect: "sequtils: index out of range",
+		},
+		{
+			in:     &conformRangeOffSlice{slice: lorem[:12], conf: feat.Circular},
+			start:  6,
+			end:    19,
+			expect: "sequtils: index out of range",
+		},
 		{
 			in:     &conformRangeOffSlice{slice: lorem, conf: feat.Linear},
 			start:  5,
@@ -0,0 +1,311 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package simulate provides random mutation of sequences and simulation of sequencing reads
+// for testing aligners and parsers.
+package simulate
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// A MutationType is the type of a simulated mutation.
+type MutationType int
+
+const (
+	Substitution MutationType = iota // A single letter is replaced by another.
+	Insertion                        // Letters are inserted before Pos.
+	Deletion                         // Letters are deleted from Pos.
+)
+
+func (t MutationType) String() string {
+	switch t {
+	case Substitution:
+		return "substitution"
+	case Insertion:
+		return "insertion"
+	case Deletion:
+		return "deletion"
+	}
+	return fmt.Sprintf("MutationType(%d)", int(t))
+}
+
+// A Mutation is a simulated change to a sequence.
+type Mutation struct {
+	Type MutationType
+
+	// Pos is the position of the mutation
+	// in the original sequence.
+	Pos int
+
+	// Ref and Alt are the original and
+	// mutated letters.
+	Ref, Alt alphabet.Letters
+}
+
+// A Mutator introduces random substitutions and insertions and deletions into sequences.
+type Mutator struct {
+	// Substitution, Insertion and Deletion
+	// are the per-letter rates of each type
+	// of mutation.
+	Substitution, Insertion, Deletion float64
+
+	// IndelExtend is the probability of
+	// extending an insertion or deletion
+	// by another letter, giving geometrically
+	// distributed indel lengths.
+	IndelExtend float64
+
+	// Rand is the source of randomness. If
+	// Rand is nil, the default source of
+	// math/rand is used.
+	Rand *rand.Rand
+}
+
+// Mutate returns a mutated copy of s and the mutations that were introduced, in order of
+// their position. Substituted and inserted letters are drawn uniformly from the letters of
+// the alphabet of s, excluding its gap and ambiguous letters, and substituted letters differ
+// from the original letter. Substituted letters take the case of the original letter and
+// inserted letters take the case of the letter following the insertion. Letters are compared
+// case-insensitively. It returns an error if any rate is outside [0, 1] or the rates sum to
+// more than 1, or the alphabet of s has fewer than two distinct valid letters.
+func (m Mutator) Mutate(s *linear.Seq) (*linear.Seq, []Mutation, error) {
+	for _, r := range []float64{m.Substitution, m.Insertion, m.Deletion, m.IndelExtend} {
+		if r < 0 || r > 1 {
+			return nil, nil, errors.New("simulate: rate out of range")
+		}
+	}
+	if m.Substitution+m.Insertion+m.Deletion > 1 {
+		return nil, nil, errors.New("simulate: total mutation rate greater than one")
+	}
+	letters := alphabetLetters(s.Alpha)
+	if len(letters) < 2 {
+		return nil, nil, errors.New("simulate: too few letters in alphabet")
+	}
+	rnd := source(m.Rand)
+
+	var (
+		mut  = make(alphabet.Letters, 0, len(s.Seq))
+		muts []Mutation
+	)
+	for i := 0; i < len(s.Seq); i++ {
+		l := s.Seq[i]
+		switch p := rnd.Float64(); {
+		case p < m.Substitution:
+			alt := lower(l)
+			for alt == lower(l) {
+				alt = letters[rnd.Intn(len(letters))]
+			}
+			alt = withCase(alt, l)
+			mut = append(mut, alt)
+			muts = append(muts, Mutation{Type: Substitution, Pos: s.Offset + i, Ref: alphabet.Letters{l}, Alt: alphabet.Letters{alt}})
+		case p < m.Substitution+m.Insertion:
+			var ins alphabet.Letters
+			for {
+				ins = append(ins, withCase(letters[rnd.Intn(len(letters))], l))
+				if rnd.Float64() >= m.IndelExtend {
+					break
+				}
+			}
+			mut = append(mut, ins...)
+			mut = append(mut, l)
+			muts = append(muts, Mutation{Type: Insertion, Pos: s.Offset + i, Alt: ins})
+		case p < m.Substitution+m.Insertion+m.Deletion:
+			n := 1
+			for i+n < len(s.Seq) && rnd.Float64() < m.IndelExtend {
+				n++
+			}
+			muts = append(muts, Mutation{Type: Deletion, Pos: s.Offset + i, Ref: append(alphabet.Letters(nil), s.Seq[i:i+n]...)})
+			i += n - 1
+		default:
+			mut = append(mut, l)
+		}
+	}
+
+	c := linear.NewSeq(s.ID, mut, s.Alpha)
+	c.Desc = s.Desc
+	c.Annotation.Offset = s.Offset
+	return c, muts, nil
+}
+
+// A Read is a simulated sequencing read.
+type Read struct {
+	// Seq is the read sequence.
+	Seq *linear.QSeq
+
+	// Loc is the reference sequence.
+	Loc feat.Feature
+
+	// From and To are the positions of
+	// the read's source on the reference.
+	From, To int
+
+	// Strand is the reference strand
+	// the read was taken from.
+	Strand seq.Strand
+
+	// Errors is the number of
+	// simulated sequencing errors.
+	Errors int
+}
+
+// A ReadSimulator generates simulated single-end sequencing reads from a nucleic acid reference
+// sequence. Sequencing errors are simulated as substitutions with a probability that changes
+// linearly along the read from StartError to EndError, and the quality of each letter is the
+// Phred score of its error probability.
+type ReadSimulator struct {
+	// Length is the length of the reads.
+	Length int
+
+	// StartError and EndError are the
+	// error probabilities of the first
+	// and last letters of a read.
+	StartError, EndError float64
+
+	// BothStrands specifies that reads
+	// may be taken from the minus strand.
+	BothStrands bool
+
+	// Rand is the source of randomness. If
+	// Rand is nil, the default source of
+	// math/rand is used.
+	Rand *rand.Rand
+}
+
+// Reads returns n reads simulated from ref. Reads are uniformly distributed along ref and
+// named "id:from..to(strand)/i". It returns an error if ref is not a nucleic acid sequence or
+// is shorter than the read length, the read length is not positive, an error probability
+// is outside [0, 1] or the alphabet of ref has fewer than two distinct valid letters.
+func (r ReadSimulator) Reads(ref *linear.Seq, n int) ([]Read, error) {
+	if m := ref.Alpha.Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, errors.New("simulate: sequence is not nucleic acid")
+	}
+	if r.Length < 1 {
+		return nil, errors.New("simulate: read length not positive")
+	}
+	if r.Length > len(ref.Seq) {
+		return nil, errors.New("simulate: reference shorter than read length")
+	}
+	if r.StartError < 0 || r.StartError > 1 || r.EndError < 0 || r.EndError > 1 {
+		return nil, errors.New("simulate: error probability out of range")
+	}
+	letters := alphabetLetters(ref.Alpha)
+	if len(letters) < 2 {
+		return nil, errors.New("simulate: too few letters in alphabet")
+	}
+	rnd := source(r.Rand)
+
+	reads := make([]Read, 0, n)
+	for i := 0; i < n; i++ {
+		from := ref.Offset + rnd.Intn(len(ref.Seq)-r.Length+1)
+		to := from + r.Length
+		src := linear.NewSeq("", append(alphabet.Letters(nil), ref.Seq[from-ref.Offset:to-ref.Offset]...), ref.Alpha)
+		strand := seq.Plus
+		if r.BothStrands && rnd.Intn(2) == 1 {
+			strand = seq.Minus
+			src.RevComp()
+		}
+
+		q := make([]alphabet.QLetter, r.Length)
+		var errs int
+		for j, l := range src.Seq {
+			pe := r.StartError
+			if r.Length > 1 {
+				pe += (r.EndError - r.StartError) * float64(j) / float64(r.Length-1)
+			}
+			if rnd.Float64() < pe {
+				alt := lower(l)
+				for alt == lower(l) {
+					alt = letters[rnd.Intn(len(letters))]
+				}
+				l = withCase(alt, l)
+				errs++
+			}
+			q[j] = alphabet.QLetter{L: l, Q: phred(pe)}
+		}
+		id := fmt.Sprintf("%s:%d..%d(%v)/%d", ref.ID, from, to, strand, i)
+		reads = append(reads, Read{
+			Seq:    linear.NewQSeq(id, q, ref.Alpha, alphabet.Sanger),
+			Loc:    ref,
+			From:   from,
+			To:     to,
+			Strand: strand,
+			Errors: errs,
+		})
+	}
+	return reads, nil
+}
+
+// phred returns the Phred quality score of the error probability p, limited to the range of
+// the Sanger encoding.
+func phred(p float64) alphabet.Qphred {
+	if p <= 0 {
+		return 93
+	}
+	return alphabet.Qphred(math.Min(93, math.Floor(-10*math.Log10(p)+0.5)))
+}
+
+// alphabetLetters returns the distinct lower case letters of a, excluding its gap and
+// ambiguous letters.
+func alphabetLetters(a alphabet.Alphabet) []alphabet.Letter {
+	var (
+		l    []alphabet.Letter
+		seen [256]bool
+	)
+	for i := 0; i < a.Len(); i++ {
+		v := a.Letter(i)
+		if v == a.Gap() || v == a.Ambiguous() {
+			continue
+		}
+		v = lower(v)
+		if !seen[v] {
+			seen[v] = true
+			l = append(l, v)
+		}
+	}
+	return l
+}
+
+// lower returns the lower case of l.
+func lower(l alphabet.Letter) alphabet.Letter {
+	if 'A' <= l && l <= 'Z' {
+		return l | ('a' - 'A')
+	}
+	return l
+}
+
+// withCase returns the letter l in the same case as the letter like.
+func withCase(l, like alphabet.Letter) alphabet.Letter {
+	if 'A' <= like && like <= 'Z' && 'a' <= l && l <= 'z' {
+		return l &^ ('a' - 'A')
+	}
+	return lower(l)
+}
+
+// randSource is the subset of the *rand.Rand methods used by the simulators.
+type randSource interface {
+	Float64() float64
+	Intn(int) int
+}
+
+// defaultSource uses the default source of math/rand.
+type defaultSource struct{}
+
+func (defaultSource) Float64() float64 { return rand.Float64() }
+func (defaultSource) Intn(n int) int   { return rand.Intn(n) }
+
+func source(r *rand.Rand) randSource {
+	if r == nil {
+		return defaultSource{}
+	}
+	return r
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package simulate
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"math/rand"
+)
+
+func Example() {
+	rnd := rand.New(rand.NewSource(1))
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("GATTACAGATTACACCGGTTAACCGGTTAAGATTACAGATTACA")), alphabet.DNA)
+
+	mut, muts, err := Mutator{Substitution: 0.05, Insertion: 0.02, Deletion: 0.02, IndelExtend: 0.3, Rand: rnd}.Mutate(ref)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%-s\n", mut)
+	for _, m := range muts {
+		fmt.Printf("%v %d %q %q\n", m.Type, m.Pos, m.Ref, m.Alt)
+	}
+
+	reads, err := ReadSimulator{Length: 12, StartError: 0.001, EndError: 0.05, BothStrands: true, Rand: rnd}.Reads(mut, 3)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, r := range reads {
+		fmt.Printf("%q\n", r.Seq)
+	}
+	// Output:
+	// GATTACAAAGATTACACCGGTTAACCGGTCCAGATTACAGATTACA
+	// insertion 6 "" "AA"
+	// substitution 27 "T" "C"
+	// deletion 28 "A" ""
+	// insertion 29 "" "C"
+	// @ref:15..27(-)/0
+	// CGGTTAACCGGT
+	// +
+	// ?8532110//..
+	// @ref:33..45(+)/1
+	// ATTACAGATTAC
+	// +
+	// ?8532110//..
+	// @ref:7..19(-)/2
+	// CGGTGTAATCTT
+	// +
+	// ?8532110//..
+}
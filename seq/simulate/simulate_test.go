@@ -0,0 +1,151 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package simulate
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func randomSeq(rnd *rand.Rand, n int) *linear.Seq {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = "acgt"[rnd.Intn(4)]
+	}
+	return linear.NewSeq("ref", alphabet.BytesToLetters(b), alphabet.DNA)
+}
+
+func (s *S) TestMutate(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	ref := randomSeq(rnd, 1000)
+
+	m := Mutator{Substitution: 0.05, Insertion: 0.01, Deletion: 0.01, IndelExtend: 0.5, Rand: rnd}
+	mut, muts, err := m.Mutate(ref)
+	c.Assert(err, check.Equals, nil)
+	c.Check(len(muts) > 0, check.Equals, true)
+
+	// Replaying the mutations on the reference
+	// must reproduce the mutated sequence.
+	var (
+		replay alphabet.Letters
+		pos    int
+	)
+	for _, v := range muts {
+		c.Assert(v.Pos >= pos, check.Equals, true)
+		replay = append(replay, ref.Seq[pos:v.Pos]...)
+		pos = v.Pos
+		switch v.Type {
+		case Substitution:
+			c.Check(v.Ref, check.DeepEquals, alphabet.Letters{ref.Seq[v.Pos]})
+			c.Check(v.Alt[0], check.Not(check.Equals), v.Ref[0])
+			replay = append(replay, v.Alt...)
+			pos++
+		case Insertion:
+			replay = append(replay, v.Alt...)
+		case Deletion:
+			c.Check(v.Ref, check.DeepEquals, ref.Seq[v.Pos:v.Pos+len(v.Ref)])
+			pos += len(v.Ref)
+		}
+	}
+	replay = append(replay, ref.Seq[pos:]...)
+	c.Check(mut.Seq, check.DeepEquals, replay)
+
+	same, muts, err := Mutator{Rand: rnd}.Mutate(ref)
+	c.Assert(err, check.Equals, nil)
+	c.Check(muts, check.HasLen, 0)
+	c.Check(same.Seq, check.DeepEquals, ref.Seq)
+
+	for _, m := range []Mutator{
+		{Substitution: -0.1},
+		{Substitution: 0.5, Insertion: 0.3, Deletion: 0.3},
+		{IndelExtend: 1.5},
+	} {
+		_, _, err = m.Mutate(ref)
+		c.Check(err, check.NotNil)
+	}
+}
+
+func (s *S) TestReads(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	ref := randomSeq(rnd, 500)
+	ref.Offset = 10
+
+	reads, err := ReadSimulator{Length: 50, BothStrands: true, Rand: rnd}.Reads(ref, 100)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(reads, check.HasLen, 100)
+	var minus int
+	for _, r := range reads {
+		c.Check(r.Errors, check.Equals, 0)
+		c.Check(r.To-r.From, check.Equals, 50)
+		c.Check(r.From >= ref.Start() && r.To <= ref.End(), check.Equals, true)
+		src := linear.NewSeq("", append(alphabet.Letters(nil), ref.Seq[r.From-ref.Offset:r.To-ref.Offset]...), alphabet.DNA)
+		if r.Strand == seq.Minus {
+			minus++
+			src.RevComp()
+		}
+		for i, ql := range r.Seq.Seq {
+			c.Check(ql.L, check.Equals, src.Seq[i])
+			c.Check(ql.Q, check.Equals, alphabet.Qphred(93))
+		}
+	}
+	c.Check(minus > 0 && minus < 100, check.Equals, true)
+
+	reads, err = ReadSimulator{Length: 100, StartError: 0.001, EndError: 0.1, Rand: rnd}.Reads(ref, 200)
+	c.Assert(err, check.Equals, nil)
+	var errs int
+	for _, r := range reads {
+		c.Check(r.Strand, check.Equals, seq.Plus)
+		c.Check(r.Seq.Seq[0].Q, check.Equals, alphabet.Qphred(30))
+		c.Check(r.Seq.Seq[99].Q, check.Equals, alphabet.Qphred(10))
+		errs += r.Errors
+	}
+	// The expected number of errors is 200*100*0.0505 = 1010.
+	c.Check(errs > 900 && errs < 1120, check.Equals, true, check.Commentf("errors=%d", errs))
+
+	for _, r := range []ReadSimulator{
+		{Length: 0},
+		{Length: 501},
+		{Length: 10, EndError: 2},
+	} {
+		_, err = r.Reads(ref, 1)
+		c.Check(err, check.NotNil)
+	}
+}
+
+func (s *S) TestCasedAlphabet(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+
+	// Letters differing only by case are
+	// not distinct substitution choices.
+	one := alphabet.Must(alphabet.NewAlphabet("-aAn", feat.DNA, '-', 'n', true))
+	ref := linear.NewSeq("ref", alphabet.Letters("aAaA"), one)
+	_, _, err := Mutator{Substitution: 1, Rand: rnd}.Mutate(ref)
+	c.Check(err, check.ErrorMatches, "simulate: too few letters in alphabet")
+	_, err = ReadSimulator{Length: 2, EndError: 1, Rand: rnd}.Reads(ref, 1)
+	c.Check(err, check.ErrorMatches, "simulate: too few letters in alphabet")
+
+	two := alphabet.Must(alphabet.NewAlphabet("-aAcCn", feat.DNA, '-', 'n', true))
+	ref = linear.NewSeq("ref", alphabet.Letters("aAcC"), two)
+	mut, _, err := Mutator{Substitution: 1, Rand: rnd}.Mutate(ref)
+	c.Assert(err, check.Equals, nil)
+	c.Check(mut.Seq, check.DeepEquals, alphabet.Letters("cCaA"))
+	reads, err := ReadSimulator{Length: 4, StartError: 1, EndError: 1, Rand: rnd}.Reads(ref, 1)
+	c.Assert(err, check.Equals, nil)
+	c.Check(reads[0].Seq.Seq, check.DeepEquals, alphabet.QLetters{{L: 'c', Q: 0}, {L: 'C', Q: 0}, {L: 'a', Q: 0}, {L: 'A', Q: 0}})
+}
@@ -0,0 +1,148 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package translate
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+// ambiguousResidues holds the amino acids represented by ambiguous amino acid codes.
+var ambiguousResidues = map[byte]string{
+	'B': "DN",
+	'Z': "EQ",
+	'J': "IL",
+}
+
+// codonsOf returns the codon table indices of the codons encoding the upper case amino acid
+// aa in c, including the codons of the amino acids represented by the ambiguous codes B, Z
+// and J.
+func (c *GeneticCode) codonsOf(aa byte) []int {
+	set, ok := ambiguousResidues[aa]
+	if !ok {
+		set = string(aa)
+	}
+	var idx []int
+	for i, a := range c.aas {
+		for j := 0; j < len(set); j++ {
+			if a == set[j] {
+				idx = append(idx, i)
+			}
+		}
+	}
+	return idx
+}
+
+// baseBit returns the IUPAC bit value of the normalised base b. The union of the bit values
+// of a set of bases is the index of its IUPAC code in alphabet.DNAredundant.
+func baseBit(b byte) int {
+	switch b {
+	case 'a':
+		return 1
+	case 'c':
+		return 2
+	case 'g':
+		return 4
+	case 't':
+		return 8
+	}
+	return 0
+}
+
+// BackTranslate returns the degenerate nucleic acid sequence encoding the protein s using
+// the genetic code c. Each residue is represented by the IUPAC codes of the union of the bases
+// at each position of its codons, so the degenerate codons of amino acids with codons that
+// differ at more than one position, such as serine, also represent codons of other amino
+// acids. Stop symbols are back-translated using the stop codons of c, X using NNN and gaps
+// using three gaps. The ambiguous codes B, Z and J represent the codons of both amino acids
+// they represent. If c is nil, the standard genetic code is used. The returned sequence uses
+// the alphabet.DNAredundant alphabet. It returns an error if s is not a protein sequence or
+// contains a residue not encoded by c.
+func BackTranslate(s seq.Sequence, c *GeneticCode) (*linear.Seq, error) {
+	if c == nil {
+		c = Standard
+	}
+	return backTranslate(s, func(aa byte) ([]alphabet.Letter, bool) {
+		idx := c.codonsOf(aa)
+		if len(idx) == 0 {
+			return nil, false
+		}
+		var bits [3]int
+		for _, i := range idx {
+			codon := codonOf(i)
+			for j := range bits {
+				bits[j] |= baseBit(codon[j])
+			}
+		}
+		codon := make([]alphabet.Letter, 3)
+		for j, b := range bits {
+			codon[j] = upper(alphabet.DNAredundant.Letter(b))
+		}
+		return codon, true
+	})
+}
+
+// BackTranslate returns the most likely nucleic acid sequence encoding the protein s given
+// the codon usage u. Each residue is represented by its most frequently seen codon in u, with
+// ties resolved by the TCAG order of the codon table. The ambiguous codes B, Z and J are
+// represented by the most frequently seen codon of either amino acid they represent. X is
+// back-translated as NNN and gaps as three gaps. The returned sequence uses the
+// alphabet.DNAredundant alphabet. It returns an error if s is not a protein sequence or
+// contains a residue not encoded by the genetic code of u.
+func (u *CodonUsage) BackTranslate(s seq.Sequence) (*linear.Seq, error) {
+	return backTranslate(s, func(aa byte) ([]alphabet.Letter, bool) {
+		idx := u.Code.codonsOf(aa)
+		if len(idx) == 0 {
+			return nil, false
+		}
+		best := idx[0]
+		for _, i := range idx[1:] {
+			if u.counts[i] > u.counts[best] {
+				best = i
+			}
+		}
+		codon := alphabet.BytesToLetters([]byte(codonOf(best)))
+		for j, l := range codon {
+			codon[j] = upper(l)
+		}
+		return codon, true
+	})
+}
+
+func backTranslate(s seq.Sequence, codon func(aa byte) ([]alphabet.Letter, bool)) (*linear.Seq, error) {
+	if s.Alphabet().Moltype() != feat.Protein {
+		return nil, fmt.Errorf("translate: sequence is not protein")
+	}
+	gap := s.Alphabet().Gap()
+	b := make([]alphabet.Letter, 0, 3*s.Len())
+	for i := s.Start(); i < s.End(); i++ {
+		l := s.At(i).L
+		switch aa := byte(upper(l)); {
+		case l == gap:
+			b = append(b, '-', '-', '-')
+		case aa == 'X':
+			b = append(b, 'N', 'N', 'N')
+		default:
+			c, ok := codon(aa)
+			if !ok {
+				return nil, fmt.Errorf("translate: no codon for residue %q at position %d", l, i)
+			}
+			b = append(b, c...)
+		}
+	}
+	return linear.NewSeq(s.Name(), b, alphabet.DNAredundant), nil
+}
+
+// upper returns the upper case form of the letter l.
+func upper(l alphabet.Letter) alphabet.Letter {
+	if 'a' <= l && l <= 'z' {
+		return l &^ ('a' - 'A')
+	}
+	return l
+}
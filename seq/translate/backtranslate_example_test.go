@@ -0,0 +1,38 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package translate
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleBackTranslate() {
+	p := linear.NewSeq("peptide", alphabet.BytesToLetters([]byte("MKWSLX-B*")), alphabet.Protein)
+
+	d, err := BackTranslate(p, nil)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%-s\n", d)
+
+	u, err := NewCodonUsage(nil, linear.NewSeq("", alphabet.BytesToLetters([]byte("ATGAAGAAGAAATGGAGCCTGCTGTTAGACTGA")), alphabet.DNA))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	ml, err := u.BackTranslate(p)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%-s\n", ml)
+	// Output:
+	// ATGAARTGGWSNYTNNNN---RAYTRR
+	// ATGAAGTGGAGCCTGNNN---GACTGA
+}
@@ -0,0 +1,219 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package translate
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"fmt"
+)
+
+// A GeneticCode is a translation table mapping codons to amino acids.
+type GeneticCode struct {
+	// ID is the NCBI translation table number
+	// of the code, or zero for user-defined codes.
+	ID int
+
+	// Name is the name of the code.
+	Name string
+
+	aas    [64]byte
+	starts [64]bool
+}
+
+// NewGeneticCode returns a genetic code defined in the format used by the NCBI genetic code
+// tables. Both aas and starts hold one letter for each of the 64 codons ordered by the first,
+// second and third bases using the order TCAG. The letters of aas are the encoded amino
+// acids, with '*' for stop codons. Codons marked with 'M' in starts are start codons. It
+// returns an error if either string is not 64 letters long.
+func NewGeneticCode(id int, name, aas, starts string) (*GeneticCode, error) {
+	if len(aas) != 64 {
+		return nil, fmt.Errorf("translate: amino acid table length %d != 64", len(aas))
+	}
+	if len(starts) != 64 {
+		return nil, fmt.Errorf("translate: start table length %d != 64", len(starts))
+	}
+	c := &GeneticCode{ID: id, Name: name}
+	for i := 0; i < 64; i++ {
+		c.aas[i] = aas[i]
+		c.starts[i] = starts[i] == 'M'
+	}
+	return c, nil
+}
+
+func mustGeneticCode(id int, name, aas, starts string) *GeneticCode {
+	c, err := NewGeneticCode(id, name, aas, starts)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Table returns the genetic code with the NCBI translation table number id. It returns an
+// error if the table is not known.
+func Table(id int) (*GeneticCode, error) {
+	c, ok := tables[id]
+	if !ok {
+		return nil, fmt.Errorf("translate: unknown genetic code table %d", id)
+	}
+	return c, nil
+}
+
+// Translate returns the amino acid encoded by codon. Letters are compared case-insensitively
+// with 'u' read as 't'. Codons that are not three letters long or that include letters other
+// than a, c, g, t or u are translated as 'X'. Translate may be used as the Translate field
+// of an align.Codon aligner.
+func (c *GeneticCode) Translate(codon []alphabet.Letter) alphabet.Letter {
+	idx := letterCodonIndex(codon)
+	if idx < 0 {
+		return 'X'
+	}
+	return alphabet.Letter(c.aas[idx])
+}
+
+// IsStart returns whether codon is a start codon of the code.
+func (c *GeneticCode) IsStart(codon []alphabet.Letter) bool {
+	idx := letterCodonIndex(codon)
+	return idx >= 0 && c.starts[idx]
+}
+
+// IsStop returns whether codon is a stop codon of the code.
+func (c *GeneticCode) IsStop(codon []alphabet.Letter) bool {
+	idx := letterCodonIndex(codon)
+	return idx >= 0 && c.aas[idx] == '*'
+}
+
+// Starts returns the lower case start codons of the code.
+func (c *GeneticCode) Starts() []string {
+	var codons []string
+	for i, ok := range c.starts {
+		if ok {
+			codons = append(codons, codonOf(i))
+		}
+	}
+	return codons
+}
+
+// Stops returns the lower case stop codons of the code.
+func (c *GeneticCode) Stops() []string {
+	var codons []string
+	for i, aa := range c.aas {
+		if aa == '*' {
+			codons = append(codons, codonOf(i))
+		}
+	}
+	return codons
+}
+
+// translate returns the amino acid encoded by the normalised codon.
+func (c *GeneticCode) translate(codon []byte) byte {
+	idx := codonIndex(codon)
+	if idx < 0 {
+		return 'X'
+	}
+	return c.aas[idx]
+}
+
+// letterCodonIndex returns the codon table index of codon, or -1 if codon is not a valid
+// codon.
+func letterCodonIndex(codon []alphabet.Letter) int {
+	if len(codon) != 3 {
+		return -1
+	}
+	var b [3]byte
+	for i, l := range codon {
+		b[i] = normaliseBase(byte(l))
+	}
+	return codonIndex(b[:])
+}
+
+// codonOf returns the codon at index i of a codon table.
+func codonOf(i int) string {
+	const bases = "tcag"
+	return string([]byte{bases[i>>4], bases[i>>2&3], bases[i&3]})
+}
+
+// Standard is the standard genetic code, NCBI translation table 1.
+var Standard = tables[1]
+
+// tables holds the NCBI genetic codes indexed by translation table number.
+var tables = map[int]*GeneticCode{
+	1: mustGeneticCode(1, "Standard",
+		"FFLLSSSSYY**CC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"---M---------------M---------------M----------------------------",
+	),
+	2: mustGeneticCode(2, "Vertebrate Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNKKSS**VVVVAAAADDEEGGGG",
+		"--------------------------------MMMM---------------M------------",
+	),
+	3: mustGeneticCode(3, "Yeast Mitochondrial",
+		"FFLLSSSSYY**CCWWTTTTPPPPHHQQRRRRIIMMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"----------------------------------MM----------------------------",
+	),
+	4: mustGeneticCode(4, "Mold, Protozoan, and Coelenterate Mitochondrial and Mycoplasma/Spiroplasma",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"--MM---------------M------------MMMM---------------M------------",
+	),
+	5: mustGeneticCode(5, "Invertebrate Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNKKSSSSVVVVAAAADDEEGGGG",
+		"---M----------------------------MMMM---------------M------------",
+	),
+	6: mustGeneticCode(6, "Ciliate, Dasycladacean and Hexamita Nuclear",
+		"FFLLSSSSYYQQCC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"-----------------------------------M----------------------------",
+	),
+	9: mustGeneticCode(9, "Echinoderm and Flatworm Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNNKSSSSVVVVAAAADDEEGGGG",
+		"-----------------------------------M---------------M------------",
+	),
+	10: mustGeneticCode(10, "Euplotid Nuclear",
+		"FFLLSSSSYY**CCCWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"-----------------------------------M----------------------------",
+	),
+	11: mustGeneticCode(11, "Bacterial, Archaeal and Plant Plastid",
+		"FFLLSSSSYY**CC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"---M---------------M------------MMMM---------------M------------",
+	),
+	12: mustGeneticCode(12, "Alternative Yeast Nuclear",
+		"FFLLSSSSYY**CC*WLLLSPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"-------------------M---------------M----------------------------",
+	),
+	13: mustGeneticCode(13, "Ascidian Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNKKSSGGVVVVAAAADDEEGGGG",
+		"---M------------------------------MM---------------M------------",
+	),
+	14: mustGeneticCode(14, "Alternative Flatworm Mitochondrial",
+		"FFLLSSSSYYY*CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNNKSSSSVVVVAAAADDEEGGGG",
+		"-----------------------------------M----------------------------",
+	),
+	16: mustGeneticCode(16, "Chlorophycean Mitochondrial",
+		"FFLLSSSSYY*LCC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"-----------------------------------M----------------------------",
+	),
+	21: mustGeneticCode(21, "Trematode Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNNKSSSSVVVVAAAADDEEGGGG",
+		"-----------------------------------M---------------M------------",
+	),
+	22: mustGeneticCode(22, "Scenedesmus obliquus Mitochondrial",
+		"FFLLSS*SYY*LCC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"-----------------------------------M----------------------------",
+	),
+	23: mustGeneticCode(23, "Thraustochytrium Mitochondrial",
+		"FF*LSSSSYY**CC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"--------------------------------M--M---------------M------------",
+	),
+	24: mustGeneticCode(24, "Rhabdopleuridae Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSSKVVVVAAAADDEEGGGG",
+		"---M---------------M---------------M---------------M------------",
+	),
+	25: mustGeneticCode(25, "Candidate Division SR1 and Gracilibacteria",
+		"FFLLSSSSYY**CCGWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"---M-------------------------------M---------------M------------",
+	),
+	26: mustGeneticCode(26, "Pachysolen tannophilus Nuclear",
+		"FFLLSSSSYY**CC*WLLLAPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"-------------------M---------------M----------------------------",
+	),
+}
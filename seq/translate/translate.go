@@ -0,0 +1,168 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package translate provides conceptual translation of nucleic acid sequences.
+package translate
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"fmt"
+)
+
+// A Translation is a conceptual translation of a region of a nucleic acid sequence.
+type Translation struct {
+	// Protein is the translated sequence.
+	Protein *linear.Seq
+
+	// Loc is the translated nucleic acid sequence.
+	Loc feat.Feature
+
+	// From and To are the positions of the
+	// translated codons on the forward strand.
+	From, To int
+
+	// Strand is the strand that was translated.
+	Strand seq.Strand
+
+	// Frame is the reading frame of the translation,
+	// 1, 2 or 3 for the plus strand and -1, -2 or -3
+	// for the minus strand, counted from the start of
+	// the strand.
+	Frame int
+}
+
+// TranslateFrames returns the conceptual translations of s in all six reading frames, in
+// the order +1, +2, +3, -1, -2 and -3, using the standard genetic code. Stop codons are
+// translated as '*' and codons including ambiguous bases are translated as 'X'. Incomplete
+// codons at the end of a frame are not translated. If split is true, each frame is split at
+// its stop codons, the stop codons are not included and the non-empty peptides between them
+// are returned in order. The returned proteins use the alphabet.Protein alphabet and are
+// named "name:from..to(frame)". It returns an error if s is not a nucleic acid sequence.
+func TranslateFrames(s seq.Sequence, split bool) ([]*Translation, error) {
+	return Standard.TranslateFrames(s, split)
+}
+
+// TranslateFrames returns the conceptual translations of s in all six reading frames using
+// the genetic code c. The translations are returned as described for the TranslateFrames
+// function.
+func (c *GeneticCode) TranslateFrames(s seq.Sequence, split bool) ([]*Translation, error) {
+	return translateFrames(s, split, c.translate)
+}
+
+func translateFrames(s seq.Sequence, split bool, translate func(codon []byte) byte) ([]*Translation, error) {
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, errors.New("translate: sequence is not nucleic acid")
+	}
+
+	var (
+		n      = s.Len()
+		offset = s.Start()
+		fwd    = make([]byte, n)
+		rev    = make([]byte, n)
+	)
+	for i := 0; i < n; i++ {
+		b := normaliseBase(byte(s.At(offset + i).L))
+		fwd[i] = b
+		rev[n-1-i] = complementBase(b)
+	}
+
+	var trans []*Translation
+	for _, strand := range []seq.Strand{seq.Plus, seq.Minus} {
+		l := fwd
+		if strand == seq.Minus {
+			l = rev
+		}
+		for frame := 0; frame < 3; frame++ {
+			var (
+				p     []alphabet.Letter
+				start = frame
+				i     = frame
+			)
+			emit := func() {
+				if split && len(p) == 0 {
+					return
+				}
+				t := &Translation{Loc: s, Strand: strand, From: start, To: start + 3*len(p), Frame: frame + 1}
+				if strand == seq.Minus {
+					t.From, t.To = n-t.To, n-t.From
+					t.Frame = -t.Frame
+				}
+				t.From += offset
+				t.To += offset
+				t.Protein = linear.NewSeq(
+					fmt.Sprintf("%s:%d..%d(%+d)", s.Name(), t.From, t.To, t.Frame),
+					p,
+					alphabet.Protein,
+				)
+				trans = append(trans, t)
+			}
+			for ; i+3 <= n; i += 3 {
+				aa := translate(l[i : i+3])
+				if split && aa == '*' {
+					emit()
+					p = nil
+					start = i + 3
+					continue
+				}
+				p = append(p, alphabet.Letter(aa))
+			}
+			emit()
+		}
+	}
+
+	return trans, nil
+}
+
+// codonIndex returns the index of the normalised codon in a table ordered by the first,
+// second and third bases using the order TCAG, or -1 if the codon includes a base other
+// than a, c, g or t.
+func codonIndex(codon []byte) int {
+	idx := 0
+	for _, b := range codon {
+		var v int
+		switch b {
+		case 't':
+			v = 0
+		case 'c':
+			v = 1
+		case 'a':
+			v = 2
+		case 'g':
+			v = 3
+		default:
+			return -1
+		}
+		idx = idx<<2 | v
+	}
+	return idx
+}
+
+// normaliseBase returns the lower case form of b with 'u' read as 't'.
+func normaliseBase(b byte) byte {
+	b |= 'a' - 'A'
+	if b == 'u' {
+		b = 't'
+	}
+	return b
+}
+
+// complementBase returns the complement of the normalised base b.
+func complementBase(b byte) byte {
+	switch b {
+	case 'a':
+		return 't'
+	case 'c':
+		return 'g'
+	case 'g':
+		return 'c'
+	case 't':
+		return 'a'
+	}
+	return 'n'
+}
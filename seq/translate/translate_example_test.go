@@ -0,0 +1,73 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package translate
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleTranslateFrames() {
+	s := linear.NewSeq("example", alphabet.BytesToLetters([]byte("ATGGCCTAAGGGTGA")), alphabet.DNA)
+
+	trans, err := TranslateFrames(s, false)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, t := range trans {
+		fmt.Println(t.Protein)
+	}
+
+	// Split the translations at stop codons.
+	fmt.Println()
+	trans, err = TranslateFrames(s, true)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, t := range trans {
+		fmt.Println(t.Protein)
+	}
+	// Output:
+	// "example:0..15(+1)" MA*G*
+	// "example:1..13(+2)" WPKG
+	// "example:2..14(+3)" GLRV
+	// "example:0..15(-1)" SPLGH
+	// "example:2..14(-2)" HP*A
+	// "example:1..13(-3)" TLRP
+	//
+	// "example:0..6(+1)" MA
+	// "example:9..12(+1)" G
+	// "example:1..13(+2)" WPKG
+	// "example:2..14(+3)" GLRV
+	// "example:0..15(-1)" SPLGH
+	// "example:8..14(-2)" HP
+	// "example:2..5(-2)" A
+	// "example:1..13(-3)" TLRP
+}
+
+func ExampleGeneticCode_TranslateFrames() {
+	s := linear.NewSeq("mito", alphabet.BytesToLetters([]byte("ATAAAATGAAGA")), alphabet.DNA)
+
+	for _, id := range []int{1, 2} {
+		code, err := Table(id)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		trans, err := code.TranslateFrames(s, false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%s: %-v stops:%v\n", code.Name, trans[0].Protein, code.Stops())
+	}
+	// Output:
+	// Standard: IK*R stops:[taa tag tga]
+	// Vertebrate Mitochondrial: MKW* stops:[taa tag aga agg]
+}
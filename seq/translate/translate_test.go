@@ -0,0 +1,165 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package translate
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Helpers
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestGeneticCodes(c *check.C) {
+	for _, t := range []struct {
+		id     int
+		codon  string
+		aa     alphabet.Letter
+		starts []string
+		stops  []string
+	}{
+		{id: 1, codon: "TGA", aa: '*', starts: []string{"ttg", "ctg", "atg"}, stops: []string{"taa", "tag", "tga"}},
+		{id: 2, codon: "uga", aa: 'W', starts: []string{"att", "atc", "ata", "atg", "gtg"}, stops: []string{"taa", "tag", "aga", "agg"}},
+		{id: 4, codon: "TGA", aa: 'W', starts: []string{"tta", "ttg", "ctg", "att", "atc", "ata", "atg", "gtg"}, stops: []string{"taa", "tag"}},
+		{id: 6, codon: "TAA", aa: 'Q', starts: []string{"atg"}, stops: []string{"tga"}},
+		{id: 11, codon: "ATG", aa: 'M', starts: []string{"ttg", "ctg", "att", "atc", "ata", "atg", "gtg"}, stops: []string{"taa", "tag", "tga"}},
+	} {
+		code, err := Table(t.id)
+		c.Assert(err, check.Equals, nil)
+		c.Check(code.ID, check.Equals, t.id)
+		c.Check(code.Translate(alphabet.BytesToLetters([]byte(t.codon))), check.Equals, t.aa)
+		c.Check(code.Starts(), check.DeepEquals, t.starts)
+		c.Check(code.Stops(), check.DeepEquals, t.stops)
+		for _, s := range t.stops {
+			c.Check(code.IsStop(alphabet.BytesToLetters([]byte(strings.ToUpper(s)))), check.Equals, true)
+		}
+		for _, s := range t.starts {
+			c.Check(code.IsStart(alphabet.BytesToLetters([]byte(s))), check.Equals, true)
+		}
+	}
+	c.Check(Standard.Translate(alphabet.BytesToLetters([]byte("anG"))), check.Equals, alphabet.Letter('X'))
+	c.Check(Standard.Translate(alphabet.BytesToLetters([]byte("at"))), check.Equals, alphabet.Letter('X'))
+
+	_, err := Table(7)
+	c.Check(err, check.ErrorMatches, "translate: unknown genetic code table 7")
+	_, err = NewGeneticCode(0, "short", "FFLL", "----")
+	c.Check(err, check.ErrorMatches, "translate: amino acid table length 4 != 64")
+}
+
+func (s *S) TestTranslateFramesSplit(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	b := make([]byte, 301)
+	for i := range b {
+		b[i] = "acgt"[rnd.Intn(4)]
+	}
+	for _, code := range []*GeneticCode{Standard, tables[2]} {
+		sq := linear.NewSeq("test", alphabet.BytesToLetters(b), alphabet.DNA)
+		sq.Offset = 10
+		whole, err := code.TranslateFrames(sq, false)
+		c.Assert(err, check.Equals, nil)
+		c.Assert(len(whole), check.Equals, 6)
+		split, err := code.TranslateFrames(sq, true)
+		c.Assert(err, check.Equals, nil)
+
+		for i, t := range whole {
+			c.Check(t.Frame, check.Equals, [...]int{1, 2, 3, -1, -2, -3}[i])
+			c.Check((t.To-t.From)%3, check.Equals, 0)
+			c.Check(t.Protein.Len(), check.Equals, (t.To-t.From)/3)
+			c.Check(t.From >= sq.Start() && t.To <= sq.End(), check.Equals, true)
+
+			// The split peptides of a frame are the
+			// translation of the frame with stops removed.
+			var (
+				peptides []string
+				last     = -1
+			)
+			for _, p := range split {
+				if p.Frame != t.Frame {
+					continue
+				}
+				c.Check(p.Protein.Len(), check.Not(check.Equals), 0)
+				c.Check(strings.Contains(p.Protein.String(), "*"), check.Equals, false)
+				if t.Strand == seq.Plus {
+					c.Check(p.From > last, check.Equals, true)
+					last = p.From
+				}
+				peptides = append(peptides, p.Protein.String())
+			}
+			want := strings.FieldsFunc(t.Protein.String(), func(r rune) bool { return r == '*' })
+			c.Check(peptides, check.DeepEquals, want)
+		}
+	}
+
+	p := linear.NewSeq("protein", alphabet.BytesToLetters([]byte("MKV")), alphabet.Protein)
+	_, err := TranslateFrames(p, false)
+	c.Check(err, check.ErrorMatches, "translate: sequence is not nucleic acid")
+}
+
+func (s *S) TestCodonUsage(c *check.C) {
+	dna := func(s string) seq.Sequence {
+		return linear.NewSeq("", alphabet.BytesToLetters([]byte(s)), alphabet.DNA)
+	}
+	u, err := NewCodonUsage(tables[2], dna("AUGAGAtggNNNTGATG"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(u.Total(), check.Equals, 4)
+	c.Check(u.Count("atg"), check.Equals, 1)
+	c.Check(u.Count("TGA"), check.Equals, 1)
+	c.Check(u.Count("at"), check.Equals, 0)
+
+	// In the vertebrate mitochondrial code,
+	// TGA and TGG both encode W, and AGA is
+	// a stop codon with AGG, TAA and TAG.
+	c.Check(u.RSCU("tgg"), check.Equals, 1.)
+	c.Check(u.RSCU("aga"), check.Equals, 4.)
+	c.Check(math.IsNaN(u.RSCU("ccc")), check.Equals, true)
+	c.Check(math.IsNaN(u.RSCU("ccn")), check.Equals, true)
+
+	_, err = u.CAI(dna("AGATAG"))
+	c.Check(err, check.ErrorMatches, "translate: no scorable codons")
+	cai, err := u.CAI(dna("TGGTGA"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(cai, check.Equals, 1.)
+
+	_, err = NewCodonUsage(nil, linear.NewSeq("", nil, alphabet.Protein))
+	c.Check(err, check.ErrorMatches, "translate: sequence is not nucleic acid")
+}
+
+func (s *S) TestBackTranslate(c *check.C) {
+	// Back-translated degenerate codons must
+	// represent every codon of the residue.
+	for _, code := range []int{1, 2, 11} {
+		gc, err := Table(code)
+		c.Assert(err, check.Equals, nil)
+		for i := 0; i < 64; i++ {
+			codon := codonOf(i)
+			aa := gc.aas[i]
+			p := linear.NewSeq("", []alphabet.Letter{alphabet.Letter(aa)}, alphabet.Protein)
+			d, err := BackTranslate(p, gc)
+			c.Assert(err, check.Equals, nil)
+			c.Assert(d.Seq, check.HasLen, 3)
+			for j, l := range d.Seq {
+				c.Check(alphabet.Match(l, alphabet.Letter(codon[j])), check.Equals, true,
+					check.Commentf("table %d codon %s residue %c: %v", code, codon, aa, d.Seq))
+			}
+		}
+	}
+
+	_, err := BackTranslate(linear.NewSeq("", alphabet.BytesToLetters([]byte("MOK")), alphabet.Protein), nil)
+	c.Check(err, check.ErrorMatches, `translate: no codon for residue 'O' at position 1`)
+	_, err = BackTranslate(linear.NewSeq("", alphabet.BytesToLetters([]byte("acgt")), alphabet.DNA), nil)
+	c.Check(err, check.NotNil)
+}
@@ -0,0 +1,194 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package translate
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"math"
+)
+
+// A CodonUsage holds codon counts for a set of coding sequences.
+type CodonUsage struct {
+	// Code is the genetic code used to
+	// group synonymous codons.
+	Code *GeneticCode
+
+	counts [64]int
+	total  int
+}
+
+// NewCodonUsage returns a CodonUsage holding the codon counts of the coding sequences cds
+// using the genetic code c. If c is nil, the standard genetic code is used. It returns an
+// error if a sequence is not a nucleic acid sequence.
+func NewCodonUsage(c *GeneticCode, cds ...seq.Sequence) (*CodonUsage, error) {
+	if c == nil {
+		c = Standard
+	}
+	u := &CodonUsage{Code: c}
+	for _, s := range cds {
+		err := u.Add(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
+
+// Add adds the codons of the coding sequence s to the usage counts. Codons are read in
+// frame from the start of s, and incomplete codons and codons including ambiguous bases
+// are ignored. It returns an error if s is not a nucleic acid sequence.
+func (u *CodonUsage) Add(s seq.Sequence) error {
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return errors.New("translate: sequence is not nucleic acid")
+	}
+	var codon [3]byte
+	for i := s.Start(); i+3 <= s.End(); i += 3 {
+		for j := range codon {
+			codon[j] = normaliseBase(byte(s.At(i + j).L))
+		}
+		if idx := codonIndex(codon[:]); idx >= 0 {
+			u.counts[idx]++
+			u.total++
+		}
+	}
+	return nil
+}
+
+// Count returns the number of times codon has been seen. Letters are compared
+// case-insensitively with 'u' read as 't'.
+func (u *CodonUsage) Count(codon string) int {
+	idx := stringCodonIndex(codon)
+	if idx < 0 {
+		return 0
+	}
+	return u.counts[idx]
+}
+
+// Total returns the total number of codons that have been counted.
+func (u *CodonUsage) Total() int { return u.total }
+
+// Frequency returns the frequency of codon among all counted codons.
+func (u *CodonUsage) Frequency(codon string) float64 {
+	if u.total == 0 {
+		return 0
+	}
+	return float64(u.Count(codon)) / float64(u.total)
+}
+
+// RSCU returns the relative synonymous codon usage of codon, the number of times the codon
+// has been seen divided by the mean count of the codons encoding the same amino acid. RSCU
+// returns NaN if no codon encoding the amino acid has been seen or codon is not valid.
+func (u *CodonUsage) RSCU(codon string) float64 {
+	idx := stringCodonIndex(codon)
+	if idx < 0 {
+		return math.NaN()
+	}
+	sum, n := u.family(idx)
+	if sum == 0 {
+		return math.NaN()
+	}
+	return float64(u.counts[idx]) * float64(n) / float64(sum)
+}
+
+// CAI returns the codon adaptation index of the coding sequence s calculated using u as the
+// reference codon usage table:
+//
+//	The codon adaptation index — a measure of directional synonymous codon usage bias, and its potential applications.
+//	 Paul M. Sharp and Wen-Hsiung Li. Nucleic Acids Res 15:1281–1295 (1987).
+//
+// Stop codons and codons of amino acids encoded by a single codon are not included, and
+// reference codons that have not been seen are given a count of 0.5. It returns an error if
+// s is not a nucleic acid sequence or contains no codons that can be scored.
+func (u *CodonUsage) CAI(s seq.Sequence) (float64, error) {
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return 0, errors.New("translate: sequence is not nucleic acid")
+	}
+	var w [64]float64
+	for i := range w {
+		w[i] = u.adaptiveness(i)
+	}
+
+	var (
+		sum   float64
+		n     int
+		codon [3]byte
+	)
+	for i := s.Start(); i+3 <= s.End(); i += 3 {
+		for j := range codon {
+			codon[j] = normaliseBase(byte(s.At(i + j).L))
+		}
+		idx := codonIndex(codon[:])
+		if idx < 0 || math.IsNaN(w[idx]) {
+			continue
+		}
+		sum += math.Log(w[idx])
+		n++
+	}
+	if n == 0 {
+		return 0, errors.New("translate: no scorable codons")
+	}
+	return math.Exp(sum / float64(n)), nil
+}
+
+// adaptiveness returns the relative adaptiveness of the codon at index idx, the count of
+// the codon divided by the count of the most frequent synonymous codon. It returns NaN for
+// stop codons and codons of amino acids encoded by a single codon.
+func (u *CodonUsage) adaptiveness(idx int) float64 {
+	aa := u.Code.aas[idx]
+	if aa == '*' {
+		return math.NaN()
+	}
+	var (
+		max float64
+		n   int
+	)
+	for i, a := range u.Code.aas {
+		if a == aa {
+			n++
+			max = math.Max(max, adjusted(u.counts[i]))
+		}
+	}
+	if n < 2 {
+		return math.NaN()
+	}
+	return adjusted(u.counts[idx]) / max
+}
+
+// adjusted returns n, or 0.5 if n is zero.
+func adjusted(n int) float64 {
+	if n == 0 {
+		return 0.5
+	}
+	return float64(n)
+}
+
+// family returns the sum of the counts and the number of codons encoding the same amino
+// acid as the codon at index idx.
+func (u *CodonUsage) family(idx int) (sum, n int) {
+	aa := u.Code.aas[idx]
+	for i, a := range u.Code.aas {
+		if a == aa {
+			sum += u.counts[i]
+			n++
+		}
+	}
+	return sum, n
+}
+
+// stringCodonIndex returns the codon table index of codon, or -1 if codon is not a valid
+// codon.
+func stringCodonIndex(codon string) int {
+	if len(codon) != 3 {
+		return -1
+	}
+	var b [3]byte
+	for i := range b {
+		b[i] = normaliseBase(codon[i])
+	}
+	return codonIndex(b[:])
+}
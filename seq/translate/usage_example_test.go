@@ -0,0 +1,49 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package translate
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleCodonUsage_CAI() {
+	var ref []seq.Sequence
+	for _, cds := range []string{
+		"ATGCTGCTGAAAGGCCTGTAA",
+		"ATGCTGAAAAAAGGCGGCTAA",
+		"ATGTTAAAGGGCCTGCTGTGA",
+	} {
+		ref = append(ref, linear.NewSeq("", alphabet.BytesToLetters([]byte(cds)), alphabet.DNA))
+	}
+	u, err := NewCodonUsage(nil, ref...)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, codon := range []string{"CTG", "TTA", "CTT", "AAA", "AAG"} {
+		fmt.Printf("%s count:%d freq:%.3f rscu:%.2f\n", codon, u.Count(codon), u.Frequency(codon), u.RSCU(codon))
+	}
+
+	for _, cds := range []string{"ATGCTGAAAGGCTAA", "ATGTTAAAGGGATAA"} {
+		cai, err := u.CAI(linear.NewSeq("", alphabet.BytesToLetters([]byte(cds)), alphabet.DNA))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%s CAI:%.3f\n", cds, cai)
+	}
+	// Output:
+	// CTG count:6 freq:0.286 rscu:5.14
+	// TTA count:1 freq:0.048 rscu:0.86
+	// CTT count:0 freq:0.000 rscu:0.00
+	// AAA count:3 freq:0.143 rscu:1.50
+	// AAG count:1 freq:0.048 rscu:0.50
+	// ATGCTGAAAGGCTAA CAI:1.000
+	// ATGTTAAAGGGATAA CAI:0.191
+}